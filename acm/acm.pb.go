@@ -54,10 +54,13 @@ type Account struct {
 	// The metadata is stored in the deployed account. When the deployed account creates new account
 	// (from Solidity/EVM), they point to the original deployed account where the metadata is stored.
 	// This original account is called the forebear.
-	Forebear             *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,10,opt,name=Forebear,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Forebear,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+	Forebear *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,10,opt,name=Forebear,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Forebear,omitempty"`
+	// If set the account is a multi-signature account whose inputs must be signed by a threshold of these members
+	// rather than by PublicKey
+	MultiSig             *crypto.MultiSig `protobuf:"bytes,12,opt,name=MultiSig,proto3" json:",omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Account) Reset()      { *m = Account{} }
@@ -130,6 +133,13 @@ func (m *Account) GetContractMeta() []*ContractMeta {
 	return nil
 }
 
+func (m *Account) GetMultiSig() *crypto.MultiSig {
+	if m != nil {
+		return m.MultiSig
+	}
+	return nil
+}
+
 func (*Account) XXX_MessageName() string {
 	return "acm.Account"
 }
@@ -194,39 +204,40 @@ func init() { proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
 func init() { golang_proto.RegisterFile("acm.proto", fileDescriptor_49ed775bc0a6adf6) }
 
 var fileDescriptor_49ed775bc0a6adf6 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
+	// 520 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0xed, 0x36, 0x69, 0xe3, 0x6c, 0xa2, 0x4f, 0xfd, 0x56, 0x1c, 0x56, 0x39, 0xd8, 0xa6, 0xa7,
-	0x08, 0xb5, 0x0e, 0x02, 0x72, 0x09, 0xa7, 0xb8, 0xa2, 0xaa, 0x04, 0x8d, 0x8a, 0x2b, 0x15, 0xc1,
-	0x6d, 0xbd, 0x1e, 0x25, 0x96, 0x62, 0xaf, 0x59, 0xaf, 0x01, 0xff, 0x04, 0xfe, 0x01, 0x47, 0x7e,
-	0x0a, 0xc7, 0x1c, 0x39, 0x56, 0x1c, 0x22, 0x94, 0xde, 0xfa, 0x2b, 0x90, 0x37, 0x8e, 0x71, 0x7a,
-	0xa8, 0x04, 0xdc, 0x32, 0x79, 0x6f, 0xde, 0x7b, 0x33, 0x3b, 0xc6, 0x6d, 0xc6, 0x23, 0x27, 0x91,
-	0x42, 0x09, 0xd2, 0x60, 0x3c, 0xea, 0x3d, 0x98, 0x8a, 0xa9, 0xd0, 0xf5, 0xa0, 0xf8, 0xb5, 0x86,
-	0x7a, 0x07, 0x09, 0xc8, 0x28, 0x4c, 0xd3, 0x50, 0xc4, 0xe5, 0x3f, 0x5d, 0x2e, 0xf3, 0x44, 0x95,
-	0xf8, 0xe1, 0xe7, 0x3d, 0xdc, 0x1a, 0x73, 0x2e, 0xb2, 0x58, 0x91, 0x09, 0x6e, 0x8d, 0x83, 0x40,
-	0x42, 0x9a, 0x52, 0x64, 0xa3, 0x7e, 0xd7, 0x7d, 0xb6, 0x58, 0x5a, 0x3b, 0x3f, 0x96, 0xd6, 0xd1,
-	0x34, 0x54, 0xb3, 0xcc, 0x77, 0xb8, 0x88, 0x06, 0xb3, 0x3c, 0x01, 0x39, 0x87, 0x60, 0x0a, 0x72,
-	0xe0, 0x67, 0x52, 0x8a, 0x8f, 0x83, 0x52, 0xb0, 0xec, 0xf5, 0x36, 0x22, 0x64, 0x88, 0xdb, 0x17,
-	0x99, 0x3f, 0x0f, 0xf9, 0x4b, 0xc8, 0xe9, 0xae, 0x8d, 0xfa, 0x9d, 0x27, 0xff, 0x3b, 0x25, 0xb9,
-	0x02, 0xdc, 0x66, 0x61, 0xe2, 0xfd, 0x66, 0x92, 0x1e, 0x36, 0x2e, 0xe1, 0x7d, 0x06, 0x31, 0x07,
-	0xda, 0xb0, 0x51, 0xbf, 0xe9, 0x55, 0x35, 0xa1, 0xb8, 0xe5, 0xb2, 0x39, 0x2b, 0xa0, 0xa6, 0x86,
-	0x36, 0x25, 0x79, 0x84, 0x5b, 0x2f, 0xae, 0xce, 0x4f, 0x44, 0x00, 0x74, 0x4f, 0x87, 0x3f, 0x28,
-	0xc3, 0x1b, 0x6e, 0xae, 0x80, 0x8b, 0x00, 0xbc, 0x0d, 0x81, 0x9c, 0xe2, 0xce, 0x45, 0xb5, 0x96,
-	0x94, 0xee, 0xeb, 0x68, 0xa6, 0x53, 0x5b, 0x55, 0xb9, 0x92, 0x1a, 0xab, 0xcc, 0x59, 0x6f, 0x24,
-	0x23, 0x6c, 0xbc, 0x19, 0x5f, 0xae, 0x4d, 0x5b, 0xda, 0xd4, 0xbc, 0x6b, 0x7a, 0xbb, 0xb4, 0xf0,
-	0x91, 0x88, 0x42, 0x05, 0x51, 0xa2, 0x72, 0xaf, 0xe2, 0x13, 0x07, 0xe3, 0x09, 0x53, 0xe1, 0x07,
-	0x98, 0xb0, 0x08, 0x68, 0xc7, 0x46, 0xfd, 0xb6, 0xfb, 0xdf, 0x1d, 0x76, 0x8d, 0x41, 0xae, 0xb0,
-	0x51, 0xf4, 0x9d, 0xb1, 0x74, 0x46, 0x0d, 0xed, 0x35, 0x2a, 0xbd, 0x8e, 0xef, 0x7f, 0x1d, 0x3f,
-	0x8c, 0x99, 0xcc, 0x9d, 0x33, 0xf8, 0x54, 0x64, 0x4a, 0x6f, 0x97, 0x16, 0x3a, 0xf6, 0x2a, 0x2d,
-	0x32, 0xc4, 0xdd, 0x13, 0x11, 0x2b, 0xc9, 0xb8, 0x3a, 0x07, 0xc5, 0x68, 0xdb, 0x6e, 0xe8, 0x77,
-	0x2a, 0xae, 0xab, 0x0e, 0x78, 0x5b, 0x34, 0xf2, 0x0a, 0x1b, 0xa7, 0x42, 0x82, 0x0f, 0x4c, 0x52,
-	0xac, 0xe3, 0x3c, 0xfe, 0xe3, 0x43, 0xa9, 0x14, 0x46, 0xcd, 0x2f, 0x5f, 0xad, 0x9d, 0xc3, 0x6b,
-	0xb4, 0x9d, 0x85, 0xbc, 0xae, 0xcd, 0xbc, 0xbe, 0xc8, 0xe1, 0x5f, 0xcd, 0x5c, 0x1b, 0xf7, 0x2d,
-	0xee, 0x16, 0xd2, 0x01, 0x53, 0x4c, 0xcb, 0xee, 0xfe, 0x8b, 0xec, 0x96, 0x54, 0x71, 0xb7, 0x9b,
-	0x5a, 0xdf, 0x6d, 0xdb, 0xab, 0x6a, 0xf7, 0xf9, 0x62, 0x65, 0xa2, 0xef, 0x2b, 0x13, 0x5d, 0xaf,
-	0x4c, 0xf4, 0x73, 0x65, 0xa2, 0x6f, 0x37, 0x26, 0x5a, 0xdc, 0x98, 0xe8, 0xdd, 0xc3, 0xfb, 0x2d,
-	0x19, 0x8f, 0xfc, 0x7d, 0xfd, 0xa9, 0x3e, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0x16, 0x20, 0x3b,
-	0x5c, 0xf2, 0x03, 0x00, 0x00,
+	0x10, 0xed, 0x36, 0x69, 0xe3, 0x6c, 0xa2, 0x4f, 0xf9, 0x56, 0x1c, 0x56, 0x39, 0xd8, 0xa6, 0xa7,
+	0x08, 0xb5, 0x0e, 0x02, 0x72, 0x09, 0x5c, 0xe2, 0x8a, 0xaa, 0x12, 0x24, 0x2a, 0x8e, 0x54, 0x04,
+	0xb7, 0xf5, 0x7a, 0xe5, 0x58, 0x8a, 0xbd, 0x66, 0xbd, 0x06, 0xfc, 0x4f, 0x38, 0xf2, 0x53, 0xb8,
+	0x91, 0x23, 0xc7, 0x8a, 0x43, 0x84, 0xd2, 0x5b, 0x7f, 0x05, 0xf2, 0xc6, 0x36, 0x4e, 0x91, 0x2a,
+	0x01, 0xb7, 0xcc, 0xce, 0x9b, 0xf7, 0x26, 0x6f, 0x9e, 0x61, 0x9b, 0xd0, 0xd0, 0x8a, 0x05, 0x97,
+	0x1c, 0x35, 0x08, 0x0d, 0xfb, 0xf7, 0x7c, 0xee, 0x73, 0x55, 0x0f, 0xf3, 0x5f, 0xdb, 0x56, 0xbf,
+	0x17, 0x33, 0x11, 0x06, 0x49, 0x12, 0xf0, 0xa8, 0x78, 0xe9, 0x52, 0x91, 0xc5, 0xb2, 0xe8, 0x1f,
+	0x7d, 0x3d, 0x80, 0xad, 0x09, 0xa5, 0x3c, 0x8d, 0x24, 0x9a, 0xc1, 0xd6, 0xc4, 0xf3, 0x04, 0x4b,
+	0x12, 0x0c, 0x4c, 0x30, 0xe8, 0xda, 0x4f, 0x56, 0x6b, 0x63, 0xef, 0xfb, 0xda, 0x38, 0xf6, 0x03,
+	0xb9, 0x48, 0x5d, 0x8b, 0xf2, 0x70, 0xb8, 0xc8, 0x62, 0x26, 0x96, 0xcc, 0xf3, 0x99, 0x18, 0xba,
+	0xa9, 0x10, 0xfc, 0xc3, 0xb0, 0x20, 0x2c, 0x66, 0x9d, 0x92, 0x04, 0x8d, 0x60, 0xfb, 0x22, 0x75,
+	0x97, 0x01, 0x7d, 0xc1, 0x32, 0xbc, 0x6f, 0x82, 0x41, 0xe7, 0xd1, 0xff, 0x56, 0x01, 0xae, 0x1a,
+	0x76, 0x33, 0x17, 0x71, 0x7e, 0x21, 0x51, 0x1f, 0x6a, 0x73, 0xf6, 0x2e, 0x65, 0x11, 0x65, 0xb8,
+	0x61, 0x82, 0x41, 0xd3, 0xa9, 0x6a, 0x84, 0x61, 0xcb, 0x26, 0x4b, 0x92, 0xb7, 0x9a, 0xaa, 0x55,
+	0x96, 0xe8, 0x01, 0x6c, 0x3d, 0xbf, 0x9c, 0x9e, 0x72, 0x8f, 0xe1, 0x03, 0xb5, 0x7c, 0xaf, 0x58,
+	0x5e, 0xb3, 0x33, 0xc9, 0x28, 0xf7, 0x98, 0x53, 0x02, 0xd0, 0x19, 0xec, 0x5c, 0x54, 0xb6, 0x24,
+	0xf8, 0x50, 0xad, 0xa6, 0x5b, 0x35, 0xab, 0x0a, 0x4b, 0x6a, 0xa8, 0x62, 0xcf, 0xfa, 0x20, 0x1a,
+	0x43, 0xed, 0xf5, 0x64, 0xbe, 0x15, 0x6d, 0x29, 0x51, 0xfd, 0xb6, 0xe8, 0xcd, 0xda, 0x80, 0xc7,
+	0x3c, 0x0c, 0x24, 0x0b, 0x63, 0x99, 0x39, 0x15, 0x1e, 0x59, 0x10, 0xce, 0x88, 0x0c, 0xde, 0xb3,
+	0x19, 0x09, 0x19, 0xee, 0x98, 0x60, 0xd0, 0xb6, 0xff, 0xbb, 0x85, 0xae, 0x21, 0xd0, 0x25, 0xd4,
+	0xf2, 0xb9, 0x73, 0x92, 0x2c, 0xb0, 0xa6, 0xb4, 0xc6, 0x85, 0xd6, 0xc9, 0xdd, 0xd7, 0x71, 0x83,
+	0x88, 0x88, 0xcc, 0x3a, 0x67, 0x1f, 0xf3, 0x9d, 0x92, 0x9b, 0xb5, 0x01, 0x4e, 0x9c, 0x8a, 0x0b,
+	0x8d, 0x60, 0xf7, 0x94, 0x47, 0x52, 0x10, 0x2a, 0xa7, 0x4c, 0x12, 0xdc, 0x36, 0x1b, 0xea, 0x4e,
+	0x79, 0xba, 0xea, 0x0d, 0x67, 0x07, 0x86, 0x5e, 0x42, 0xed, 0x8c, 0x0b, 0xe6, 0x32, 0x22, 0x30,
+	0x54, 0xeb, 0x3c, 0xfc, 0xe3, 0xa0, 0x54, 0x0c, 0xe8, 0x19, 0xd4, 0xa6, 0xe9, 0x52, 0x06, 0xf3,
+	0xc0, 0xc7, 0x5d, 0x75, 0x8d, 0x5e, 0x19, 0x94, 0xf2, 0xfd, 0x37, 0x73, 0xaa, 0x89, 0x71, 0xf3,
+	0xd3, 0x67, 0x63, 0xef, 0xe8, 0x0a, 0xec, 0xfe, 0x13, 0xf4, 0xaa, 0xe6, 0xd8, 0x36, 0xcf, 0xa3,
+	0xbf, 0x72, 0xac, 0x66, 0xd6, 0x1b, 0xd8, 0xcd, 0xa9, 0x3d, 0x22, 0x89, 0xa2, 0xdd, 0xff, 0x17,
+	0xda, 0x1d, 0xaa, 0x3c, 0xf5, 0x65, 0xad, 0x52, 0xdf, 0x76, 0xaa, 0xda, 0x7e, 0xba, 0xda, 0xe8,
+	0xe0, 0xdb, 0x46, 0x07, 0x57, 0x1b, 0x1d, 0xfc, 0xd8, 0xe8, 0xe0, 0xcb, 0xb5, 0x0e, 0x56, 0xd7,
+	0x3a, 0x78, 0x7b, 0xff, 0x6e, 0x49, 0x42, 0x43, 0xf7, 0x50, 0x7d, 0xe8, 0x8f, 0x7f, 0x0e, 0x00,
+	0xa9, 0xf1, 0x19, 0x80, 0x30, 0x04, 0x00, 0x00,
 }
 
 func (m *Account) Marshal() (dAtA []byte, err error) {
@@ -253,6 +264,18 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MultiSig != nil {
+		{
+			size, err := m.MultiSig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAcm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.NativeName) > 0 {
 		i -= len(m.NativeName)
 		copy(dAtA[i:], m.NativeName)
@@ -462,6 +485,10 @@ func (m *Account) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAcm(uint64(l))
 	}
+	if m.MultiSig != nil {
+		l = m.MultiSig.Size()
+		n += 1 + l + sovAcm(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -860,6 +887,42 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			}
 			m.NativeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiSig == nil {
+				m.MultiSig = &crypto.MultiSig{}
+			}
+			if err := m.MultiSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAcm(dAtA[iNdEx:])
@@ -129,6 +129,11 @@ type AccountStatsGetter interface {
 	GetAccountStats() AccountStats
 }
 
+type RoleCounter interface {
+	// Returns the number of accounts holding role
+	CountRole(role string) (uint64, error)
+}
+
 // Compositions
 
 // Read-only account and storage state
@@ -165,6 +170,12 @@ type ReaderWriter interface {
 	Writer
 }
 
+// Read and write account and storage state and count the accounts holding a role
+type RoleCountingReaderWriter interface {
+	ReaderWriter
+	RoleCounter
+}
+
 type MetadataReaderWriter interface {
 	MetadataReader
 	MetadataWriter
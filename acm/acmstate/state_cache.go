@@ -123,6 +123,43 @@ func (cache *Cache) IterateCachedAccount(consumer func(*acm.Account) (stop bool)
 	return false, nil
 }
 
+// Returns the number of accounts holding role in the backend adjusted by any accounts updated or removed in the cache.
+// The backend must be a RoleCounter.
+func (cache *Cache) CountRole(role string) (uint64, error) {
+	counter, ok := cache.backend.(RoleCounter)
+	if !ok {
+		return 0, fmt.Errorf("cannot count accounts holding role '%s' since cache backend %T is not a RoleCounter",
+			role, cache.backend)
+	}
+	count, err := counter.CountRole(role)
+	if err != nil {
+		return 0, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	for address, accInfo := range cache.accounts {
+		accInfo.RLock()
+		if !accInfo.updated && !accInfo.removed {
+			accInfo.RUnlock()
+			continue
+		}
+		holds := !accInfo.removed && accInfo.account.Permissions.HasRole(role)
+		accInfo.RUnlock()
+		prev, err := cache.backend.GetAccount(address)
+		if err != nil {
+			return 0, err
+		}
+		held := prev != nil && prev.Permissions.HasRole(role)
+		switch {
+		case holds && !held:
+			count++
+		case held && !holds:
+			count--
+		}
+	}
+	return count, nil
+}
+
 func (cache *Cache) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
 	accInfo, err := cache.get(address)
 	if err != nil {
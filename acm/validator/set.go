@@ -95,6 +95,15 @@ func (vs *Set) Power(id crypto.Address) (*big.Int, error) {
 	return vs.GetPower(id), nil
 }
 
+// A Set is its own next set
+func (vs *Set) NextPower(id crypto.Address) (*big.Int, *crypto.PublicKey, error) {
+	if vs.publicKeys[id] == nil {
+		return new(big.Int), nil, nil
+	}
+	publicKey := vs.publicKeys[id].GetPublicKey()
+	return vs.GetPower(id), &publicKey, nil
+}
+
 // Error free version of Power
 func (vs *Set) GetPower(id crypto.Address) *big.Int {
 	if vs.powers[id] == nil {
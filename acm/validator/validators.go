@@ -14,6 +14,17 @@ type Reader interface {
 	Power(id crypto.Address) (*big.Int, error)
 }
 
+// Reads the validator set being formed by the current block
+type NextReader interface {
+	// Returns the power of the validator including changes made in the current block and its public key, if known
+	NextPower(id crypto.Address) (*big.Int, *crypto.PublicKey, error)
+}
+
+type NextReaderWriter interface {
+	ReaderWriter
+	NextReader
+}
+
 type Iterable interface {
 	IterateValidators(func(id crypto.Addressable, power *big.Int) error) error
 }
@@ -68,12 +68,18 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 		restoreDumpOpt := cmd.StringOpt("restore-dump", "", "Including AppHash for restored file")
 
+		gethOpt := cmd.BoolOpt("geth", false, "The file passed to --restore-dump is the output of geth dump or "+
+			"a geth genesis file")
+
+		weiPerUnitOpt := cmd.StringOpt("wei-per-unit", dump.DefaultWeiPerUnit.String(), "Number of wei in a "+
+			"Burrow native unit, balances restored from geth are divided by this")
+
 		pool := cmd.BoolOpt("pool", false, "Write config files for all the validators called burrowNNN.toml")
 
 		cmd.Spec = "[--keys-url=<keys URL> | --keys-dir=<keys directory>] [--curve-type=<name>]" +
 			"[ --config-template-in=<text template> --config-out=<output file>]... " +
 			"[--genesis-spec=<GenesisSpec file>] [--separate-genesis-doc=<genesis JSON file>] " +
-			"[--chain-name=<chain name>] [--restore-dump=<dump file>] [--json] [--debug] [--pool] " +
+			"[--chain-name=<chain name>] [--restore-dump=<dump file> [--geth [--wei-per-unit=<wei>]]] [--json] [--debug] [--pool] " +
 			"[--logging=<logging program>] [--describe-logging] [--empty-blocks=<'always','never',duration>]"
 
 		// no sourcing logs
@@ -182,7 +188,16 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("on restore, validators must be provided in GenesisDoc or GenesisSpec")
 				}
 
-				reader, err := dump.NewFileReader(*restoreDumpOpt)
+				var reader dump.Source
+				if *gethOpt {
+					weiPerUnit, err := parseWeiPerUnit(*weiPerUnitOpt)
+					if err != nil {
+						output.Fatalf("failed to read restore dump: %v", err)
+					}
+					reader, err = dump.NewGethFileReader(*restoreDumpOpt, weiPerUnit)
+				} else {
+					reader, err = dump.NewFileReader(*restoreDumpOpt)
+				}
 				if err != nil {
 					output.Fatalf("failed to read restore dump: %v", err)
 				}
@@ -298,6 +313,8 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					conf.RPC.GRPC.ListenPort = fmt.Sprint(10997 + i)
 					conf.RPC.Metrics.ListenHost = rpc.LocalHost
 					conf.RPC.Metrics.ListenPort = fmt.Sprint(9102 + i)
+					conf.RPC.Gateway.ListenHost = rpc.LocalHost
+					conf.RPC.Gateway.ListenPort = fmt.Sprint(26961 + i)
 					conf.Logging.RootSink.Output.OutputType = "file"
 					conf.Logging.RootSink.Output.FileConfig = &logconfig.FileConfig{Path: fmt.Sprintf("burrow%03d.log", i)}
 
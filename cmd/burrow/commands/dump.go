@@ -3,12 +3,14 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"time"
 
 	"github.com/hyperledger/burrow/encoding"
 
 	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/dump"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
@@ -18,8 +20,14 @@ import (
 
 type dumpOptions struct {
 	height            *int
+	startHeight       *int
+	accountQuery      *string
+	addresses         *[]string
+	nameQuery         *string
+	eventAddresses    *[]string
 	filename          *string
 	useBinaryEncoding *bool
+	archive           *bool
 }
 
 func maybeOutput(verbose *bool, output Output, format string, args ...interface{}) {
@@ -29,18 +37,64 @@ func maybeOutput(verbose *bool, output Output, format string, args ...interface{
 }
 
 func addDumpOptions(cmd *cli.Cmd, specOptions ...string) *dumpOptions {
-	cmd.Spec += "[--height=<state height to dump at>] [--binary]"
+	cmd.Spec += "[--height=<state height to dump at>] [--start-height=<first height to dump events from>] " +
+		"[--account-query=<query>] [--address=<address>...] [--name-query=<query>] " +
+		"[--event-address=<address>...] [--binary | --archive]"
 	for _, spec := range specOptions {
 		cmd.Spec += " " + spec
 	}
 	cmd.Spec += "[FILE]"
 	return &dumpOptions{
-		height:            cmd.IntOpt("h height", 0, "Block height to dump to, defaults to latest block height"),
+		height:      cmd.IntOpt("h height", 0, "Block height to dump to, defaults to latest block height"),
+		startHeight: cmd.IntOpt("start-height", 0, "Block height to dump events from"),
+		accountQuery: cmd.StringOpt("account-query", "", "Only dump accounts (and their storage) matching this "+
+			"query, e.g. \"Balance > 0\""),
+		addresses: cmd.StringsOpt("address", nil, "Only dump these accounts (and their storage), "+
+			"may be given more than once"),
+		nameQuery: cmd.StringOpt("name-query", "", "Only dump names matching this query, e.g. \"Owner = '...'\""),
+		eventAddresses: cmd.StringsOpt("event-address", nil, "Only dump events emitted by these addresses, "+
+			"may be given more than once"),
 		useBinaryEncoding: cmd.BoolOpt("b binary", false, "Output in binary encoding (default is JSON)"),
-		filename:          cmd.StringArg("FILE", "", "Location to output dump, if no argument is given then this streams to STDOUT"),
+		archive: cmd.BoolOpt("a archive", false, "Output a gzip-compressed archive with a manifest recording "+
+			"the chain, heights, AppHash, and checksums of its contents (restore verifies these)"),
+		filename: cmd.StringArg("FILE", "", "Location to output dump, if no argument is given then this streams to STDOUT"),
 	}
 }
 
+// Returns the filter described by the options, or nil if they describe none
+func (opts *dumpOptions) filter() (*dump.Filter, error) {
+	filter := &dump.Filter{
+		AccountQuery: *opts.accountQuery,
+		NameQuery:    *opts.nameQuery,
+	}
+	var err error
+	filter.Addresses, err = parseAddresses(*opts.addresses)
+	if err != nil {
+		return nil, err
+	}
+	filter.EventAddresses, err = parseAddresses(*opts.eventAddresses)
+	if err != nil {
+		return nil, err
+	}
+	if filter.AccountQuery == "" && filter.NameQuery == "" && len(filter.Addresses) == 0 &&
+		len(filter.EventAddresses) == 0 {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+func parseAddresses(hexAddresses []string) ([]crypto.Address, error) {
+	var addresses []crypto.Address
+	for _, hexAddress := range hexAddresses {
+		address, err := crypto.AddressFromHexString(hexAddress)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse address %s: %v", hexAddress, err)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
 // Dump saves the state from a remote chain
 func Dump(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
@@ -60,7 +114,7 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("could not obtain config: %v", err)
 				}
 
-				kern, err := core.NewKernel(conf.BurrowDir)
+				kern, err := core.NewKernel(conf.BurrowDir, conf.Storage)
 				if err != nil {
 					output.Fatalf("could not create burrow kernel: %v", err)
 				}
@@ -76,10 +130,23 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("could not make logger: %v", err)
 				}
 
-				source := dump.NewDumper(kern.State, kern.Blockchain).WithLogger(logger).
-					Source(0, uint64(*dumpOpts.height), dump.All)
+				filter, err := dumpOpts.filter()
+				if err != nil {
+					output.Fatalf("could not build dump filter: %v", err)
+				}
+
+				dumper := dump.NewDumper(kern.State, kern.Blockchain).WithLogger(logger)
+				startHeight, endHeight := uint64(*dumpOpts.startHeight), uint64(*dumpOpts.height)
+				var manifest *dump.Manifest
+				if *dumpOpts.archive {
+					manifest, err = dumper.Manifest(startHeight, endHeight, dump.All, filter)
+					if err != nil {
+						output.Fatalf("could not build dump manifest: %v", err)
+					}
+				}
+				source := dumper.Source(startHeight, endHeight, dump.All, filter)
 
-				err = dumpToFile(*dumpOpts.filename, source, *dumpOpts.useBinaryEncoding)
+				err = dumpToFile(*dumpOpts.filename, source, *dumpOpts.useBinaryEncoding, manifest)
 				if err != nil {
 					output.Fatalf("could not dump to file %s': %v", *dumpOpts.filename, err)
 				}
@@ -118,13 +185,32 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 				}
 				maybeOutput(verbose, output, "dumping from chain: %s", string(stat))
 
+				filter, err := dumpOpts.filter()
+				if err != nil {
+					output.Fatalf("could not build dump filter: %v", err)
+				}
+
 				dc := rpcdump.NewDumpClient(conn)
-				receiver, err := dc.GetDump(ctx, &rpcdump.GetDumpParam{Height: uint64(*dumpOpts.height)})
+				param := &rpcdump.GetDumpParam{
+					Height:      uint64(*dumpOpts.height),
+					StartHeight: uint64(*dumpOpts.startHeight),
+					Filter:      filter,
+				}
+				var manifest *dump.Manifest
+				if *dumpOpts.archive {
+					manifest, err = dc.GetManifest(ctx, param)
+					if err != nil {
+						output.Fatalf("failed to retrieve dump manifest: %v", err)
+					}
+					// Make sure the dump is taken at the height the manifest describes
+					param.Height = manifest.EndHeight
+				}
+				receiver, err := dc.GetDump(ctx, param)
 				if err != nil {
 					output.Fatalf("failed to retrieve dump: %v", err)
 				}
 
-				err = dumpToFile(*dumpOpts.filename, receiver, *dumpOpts.useBinaryEncoding)
+				err = dumpToFile(*dumpOpts.filename, receiver, *dumpOpts.useBinaryEncoding, manifest)
 				if err != nil {
 					output.Fatalf("could not dump to file %s': %v", *dumpOpts.filename, err)
 				}
@@ -135,7 +221,8 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 	}
 }
 
-func dumpToFile(filename string, source dump.Source, useBinaryEncoding bool) error {
+// Writes an archive if manifest is given
+func dumpToFile(filename string, source dump.Source, useBinaryEncoding bool, manifest *dump.Manifest) error {
 	var file *os.File
 	var err error
 	if filename == "" {
@@ -148,7 +235,11 @@ func dumpToFile(filename string, source dump.Source, useBinaryEncoding bool) err
 	}
 
 	// Receive
-	err = dump.Write(file, source, useBinaryEncoding, dump.All)
+	if manifest != nil {
+		err = dump.WriteArchive(file, source, manifest)
+	} else {
+		err = dump.Write(file, source, useBinaryEncoding, dump.All)
+	}
 	if err != nil {
 		return err
 	}
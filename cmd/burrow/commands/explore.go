@@ -6,7 +6,9 @@ import (
 	"os"
 
 	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/forensics"
+	"github.com/hyperledger/burrow/storage"
 
 	"github.com/hyperledger/burrow/bcm"
 
@@ -21,6 +23,7 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 		configOpts := addConfigOptions(cmd)
 		var conf *config.BurrowConfig
 		var explorer *bcm.BlockStore
+		var backend dbm.BackendType
 		var err error
 
 		cmd.Before = func() {
@@ -37,7 +40,8 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("genesis doc is required")
 			}
 
-			explorer, err = bcm.NewBlockExplorer(dbm.BackendType(tmConf.DBBackend), tmConf.DBDir())
+			backend = dbm.BackendType(tmConf.DBBackend)
+			explorer, err = bcm.NewBlockExplorer(backend, tmConf.DBDir())
 			if err != nil {
 				output.Fatalf("could not create BlockExplorer: %w", err)
 			}
@@ -55,7 +59,7 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 
 			cmd.Action = func() {
-				replay := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir)
+				replay := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir, backend)
 				height := uint64(*heightOpt)
 				if height == 0 {
 					height, err = replay.LatestHeight()
@@ -72,6 +76,39 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("diff", "print the accounts, storage, names, and validators changed by a block as JSON",
+			func(cmd *cli.Cmd) {
+				heightOpt := cmd.IntOpt("height", 0, "The height of the block, defaults to latest")
+				stateDir := cmd.StringArg("STATE", "", "Directory containing burrow state")
+				cmd.Spec = "[--height] [STATE]"
+
+				cmd.Before = func() {
+					if err := isDir(*stateDir); err != nil {
+						output.Fatalf("could not obtain state: %v", err)
+					}
+				}
+
+				cmd.Action = func() {
+					src := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir, backend)
+					height := uint64(*heightOpt)
+					if height == 0 {
+						height, err = src.LatestHeight()
+						if err != nil {
+							output.Fatalf("could not read latest height: %v", err)
+						}
+					}
+					diff, err := src.StateDiff(height)
+					if err != nil {
+						output.Fatalf("could not diff state: %v", err)
+					}
+					bs, err := json.Marshal(diff)
+					if err != nil {
+						output.Fatalf("could not serialise state diff: %v", err)
+					}
+					output.Printf(string(bs))
+				}
+			})
+
 		cmd.Command("compare", "diff the state of two .burrow directories", func(cmd *cli.Cmd) {
 			goodDir := cmd.StringArg("GOOD", "", "Directory containing expected state")
 			badDir := cmd.StringArg("BAD", "", "Directory containing invalid state")
@@ -89,11 +126,11 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 
 			cmd.Action = func() {
 				replay1 := forensics.NewReplay(
-					forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir),
+					forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir, backend),
 					forensics.NewSourceFromGenesis(conf.GenesisDoc),
 				)
 				replay2 := forensics.NewReplay(
-					forensics.NewSourceFromDir(conf.GenesisDoc, *badDir),
+					forensics.NewSourceFromDir(conf.GenesisDoc, *badDir, backend),
 					forensics.NewSourceFromGenesis(conf.GenesisDoc),
 				)
 
@@ -138,6 +175,167 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("bisect", "find the first block after which the state of two .burrow directories differs",
+			func(cmd *cli.Cmd) {
+				goodDir := cmd.StringArg("GOOD", "", "Directory containing expected state")
+				badDir := cmd.StringArg("BAD", "", "Directory containing invalid state, if omitted the blocks of "+
+					"GOOD are replayed from genesis and compared with its state")
+				heightOpt := cmd.IntOpt("height", 0, "The last height to search, defaults to the latest height of both")
+				cmd.Spec = "[--height] GOOD [BAD]"
+
+				cmd.Before = func() {
+					if err := isDir(*goodDir); err != nil {
+						output.Fatalf("could not obtain state: %v", err)
+					}
+					if *badDir != "" {
+						if err := isDir(*badDir); err != nil {
+							output.Fatalf("could not obtain state: %v", err)
+						}
+					}
+				}
+
+				cmd.Action = func() {
+					good := forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir, backend)
+					height, err := good.LatestHeight()
+					if err != nil {
+						output.Fatalf("could not get height of good state: %v", err)
+					}
+					if *heightOpt != 0 {
+						height = uint64(*heightOpt)
+					}
+
+					var bad *forensics.Source
+					if *badDir != "" {
+						bad = forensics.NewSourceFromDir(conf.GenesisDoc, *badDir, backend)
+						h, err := bad.LatestHeight()
+						if err != nil {
+							output.Fatalf("could not get height of bad state: %v", err)
+						}
+						if h < height {
+							height = h
+						}
+					} else {
+						var replayed uint64
+						bad, replayed, err = forensics.ReplayFromGenesis(good, conf.GenesisDoc, height)
+						if err != nil {
+							output.Printf("Replay from genesis stopped: %v", err)
+							height = replayed
+						}
+					}
+
+					output.Printf("Searching for divergent state up to height %d", height)
+					div, err := forensics.Bisect(good, bad, height)
+					if err != nil {
+						output.Fatalf("could not bisect state: %v", err)
+					}
+					if div == nil {
+						output.Printf("States match up to height %d", height)
+						return
+					}
+					output.Printf("%v", div)
+				}
+			})
+
+		cmd.Command("replay", "replay blocks of a .burrow directory under the execution config given",
+			func(cmd *cli.Cmd) {
+				stateDir := cmd.StringArg("STATE", "", "Directory containing burrow state")
+				rangeArg := cmd.StringArg("RANGE", "", "Range as START_HEIGHT:END_HEIGHT where omitting "+
+					"either endpoint implicitly describes the start/end and a negative index counts back from the last block")
+				diffOpt := cmd.BoolOpt("diff", false, "Report transactions and state whose replay differs "+
+					"from their recorded execution rather than printing every replayed block")
+				cmd.Spec = "[--diff] STATE [RANGE]"
+
+				cmd.Before = func() {
+					if err := isDir(*stateDir); err != nil {
+						output.Fatalf("could not obtain state: %v", err)
+					}
+				}
+
+				cmd.Action = func() {
+					start, end, err := parseRange(*rangeArg)
+					if err != nil {
+						output.Fatalf("could not parse range '%s': %v", *rangeArg, err)
+					}
+					src := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir, backend)
+					latest, err := src.LatestHeight()
+					if err != nil {
+						output.Fatalf("could not get height of state: %v", err)
+					}
+					if start <= 0 {
+						start = 1
+					}
+					if end < 0 {
+						end = int64(latest) + end + 1
+					}
+					if end < start || uint64(end) > latest {
+						output.Fatalf("range %d:%d is not within the stored heights 1:%d", start, end, latest)
+					}
+
+					exeOptions, err := conf.Execution.ExecutionOptions()
+					if err != nil {
+						output.Fatalf("could not build execution options: %v", err)
+					}
+					re := forensics.NewReplay(src, src, forensics.WithExecutionOptions(exeOptions...))
+
+					if !*diffOpt {
+						for height := uint64(start); height <= uint64(end); height++ {
+							recap, err := re.Block(height)
+							if err != nil {
+								output.Fatalf("could not replay block %d: %v", height, err)
+							}
+							output.Printf("%v", recap)
+						}
+						return
+					}
+
+					diffs, err := re.DiffBlocks(uint64(start), uint64(end))
+					if err != nil {
+						output.Fatalf("could not replay blocks: %v", err)
+					}
+					for _, diff := range diffs {
+						output.Printf("%v", diff)
+					}
+					if len(diffs) > 0 {
+						output.Fatalf("Replay of %d block(s) differs from recorded execution", len(diffs))
+					}
+					output.Printf("Replay of blocks %d to %d matches recorded execution", start, end)
+				}
+			})
+
+		cmd.Command("fsck", "check the consistency of burrow state with itself and with the Tendermint block store",
+			func(cmd *cli.Cmd) {
+				repairOpt := cmd.BoolOpt("repair", false, "Delete dangling TxHash index entries")
+				cmd.Spec = "[--repair]"
+
+				cmd.Action = func() {
+					if !storage.DBExists(core.BurrowDBName, backend, conf.BurrowDir) {
+						output.Fatalf("no %s burrow state found in %s", backend, conf.BurrowDir)
+					}
+					db, err := storage.NewDB(core.BurrowDBName, backend, conf.BurrowDir)
+					if err != nil {
+						output.Fatalf("could not open burrow state: %v", err)
+					}
+					defer db.Close()
+
+					report, err := forensics.Fsck(db, explorer, conf.GenesisDoc, *repairOpt)
+					if err != nil {
+						output.Fatalf("could not check state: %v", err)
+					}
+					output.Printf("Checked %d state trees and %d TxHash index entries at height %d with AppHash %v",
+						report.Trees, report.TxHashEntries, report.Height, report.AppHash)
+					for _, problem := range report.Problems {
+						output.Printf("%s", problem)
+					}
+					if report.Repaired > 0 {
+						output.Printf("Deleted %d dangling TxHash index entries", report.Repaired)
+					}
+					if len(report.Problems) > report.Repaired {
+						output.Fatalf("Found %d problems", len(report.Problems)-report.Repaired)
+					}
+					output.Printf("State is consistent")
+				}
+			})
+
 		cmd.Command("blocks", "dump blocks to stdout", func(cmd *cli.Cmd) {
 			rangeArg := cmd.StringArg("RANGE", "", "Range as START_HEIGHT:END_HEIGHT where omitting "+
 				"either endpoint implicitly describes the start/end and a negative index counts back from the last block")
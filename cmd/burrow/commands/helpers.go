@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"math/big"
 	"os"
 	"os/signal"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/dump"
 	"github.com/hyperledger/burrow/genesis"
 	logging_config "github.com/hyperledger/burrow/logging/logconfig"
 )
@@ -103,6 +105,18 @@ func parseRange(rangeString string) (start int64, end int64, err error) {
 	return 0, 0, fmt.Errorf("could not parse range from %s", rangeString)
 }
 
+// Parses the number of wei in a Burrow native unit used to scale balances imported from geth
+func parseWeiPerUnit(weiPerUnit string) (*big.Int, error) {
+	if weiPerUnit == "" {
+		return dump.DefaultWeiPerUnit, nil
+	}
+	n, ok := new(big.Int).SetString(weiPerUnit, 10)
+	if !ok || n.Sign() <= 0 {
+		return nil, fmt.Errorf("wei per unit must be a positive integer but got '%s'", weiPerUnit)
+	}
+	return n, nil
+}
+
 func handleTerm() {
 	c := make(chan os.Signal)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
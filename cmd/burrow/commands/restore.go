@@ -2,6 +2,7 @@ package commands
 
 import (
 	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/dump"
 	cli "github.com/jawher/mow.cli"
 )
 
@@ -10,8 +11,12 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		configOpts := addConfigOptions(cmd)
 		silentOpt := cmd.BoolOpt("s silent", false, "If state already exists don't throw error")
+		gethOpt := cmd.BoolOpt("geth", false, "Restore from the output of geth dump or a geth genesis file "+
+			"rather than a Burrow dump")
+		weiPerUnitOpt := cmd.StringOpt("wei-per-unit", dump.DefaultWeiPerUnit.String(), "Number of wei in a "+
+			"Burrow native unit, geth balances are divided by this")
 		filename := cmd.StringArg("FILE", "", "Restore from this dump")
-		cmd.Spec += "[--silent] [FILE]"
+		cmd.Spec += "[--silent] [--geth [--wei-per-unit=<wei>]] [FILE]"
 
 		cmd.Action = func() {
 			conf, err := configOpts.obtainBurrowConfig()
@@ -25,7 +30,7 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 
 			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
 
-			kern, err := core.NewKernel(conf.BurrowDir)
+			kern, err := core.NewKernel(conf.BurrowDir, conf.Storage)
 			if err != nil {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
@@ -34,7 +39,16 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
 
-			if err = kern.LoadDump(conf.GenesisDoc, *filename, *silentOpt); err != nil {
+			if *gethOpt {
+				weiPerUnit, err := parseWeiPerUnit(*weiPerUnitOpt)
+				if err != nil {
+					output.Fatalf("could not restore geth dump: %v", err)
+				}
+				err = kern.LoadGethDump(conf.GenesisDoc, *filename, weiPerUnit, *silentOpt)
+			} else {
+				err = kern.LoadDump(conf.GenesisDoc, *filename, *silentOpt)
+			}
+			if err != nil {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
 
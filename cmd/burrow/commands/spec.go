@@ -26,6 +26,16 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		livenessWindowOpt := cmd.IntOpt("param-livenesswindow", 0,
+			"Number of recent blocks over which missed blocks are counted, 0 disables jailing validators for downtime")
+		maxMissedBlocksOpt := cmd.IntOpt("param-maxmissedblocks", 0,
+			"Jail a validator once it has missed more than this many of the last liveness window blocks")
+		jailBlocksOpt := cmd.IntOpt("param-jailblocks", 0, "Number of blocks a jailed validator must wait to be unjailed")
+		unbondingBlocksOpt := cmd.IntOpt("param-unbondingblocks", 0,
+			"Number of blocks after unbonding before the bonded balance is returned, 0 returns it immediately")
+		minimumFeeOpt := cmd.IntOpt("param-minimumfee", 0, "Minimum fee a CallTx or NameTx must offer")
+		minimumGasPriceOpt := cmd.IntOpt("param-minimumgasprice", 0,
+			"Minimum fee per unit of gas limit a CallTx must offer")
 
 		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
 			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
@@ -60,6 +70,12 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			genesisSpec.Params.LivenessWindow = uint64(*livenessWindowOpt)
+			genesisSpec.Params.MaxMissedBlocks = uint64(*maxMissedBlocksOpt)
+			genesisSpec.Params.JailBlocks = uint64(*jailBlocksOpt)
+			genesisSpec.Params.UnbondingBlocks = uint64(*unbondingBlocksOpt)
+			genesisSpec.Params.MinimumFee = uint64(*minimumFeeOpt)
+			genesisSpec.Params.MinimumGasPrice = uint64(*minimumGasPriceOpt)
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
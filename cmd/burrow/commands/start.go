@@ -8,6 +8,13 @@ import (
 // Start launches the burrow daemon
 func Start(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
+		cmd.Spec = "[--halt-height=<height>]"
+		haltHeightOpt := cmd.Int(cli.IntOpt{
+			Name:   "halt-height",
+			Desc:   "Shut down once the block at this height has been committed",
+			EnvVar: "BURROW_HALT_HEIGHT",
+		})
+
 		configOpts := addConfigOptions(cmd)
 
 		cmd.Action = func() {
@@ -16,6 +23,12 @@ func Start(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("could not set up config: %v", err)
 			}
 
+			if *haltHeightOpt < 0 {
+				output.Fatalf("halt height cannot be negative")
+			} else if *haltHeightOpt > 0 {
+				conf.Execution.HaltHeight = uint64(*haltHeightOpt)
+			}
+
 			if err := conf.Verify(); err != nil {
 				output.Fatalf("cannot continue with config: %v", err)
 			}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/storage"
+	cli "github.com/jawher/mow.cli"
+)
+
+// State manages the databases of an offline Burrow node
+func State(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		cmd.Command("migrate", "copy burrow and Tendermint databases to another storage backend", func(cmd *cli.Cmd) {
+			configFileOpt := cmd.String(configFileOption)
+			genesisFileOpt := cmd.String(genesisFileOption)
+			toOpt := cmd.StringOpt("to", "", "Storage backend to migrate to, one of: goleveldb, boltdb, badgerdb")
+			cmd.Spec = "--to=<backend> " + configFileSpec + " " + genesisFileSpec
+
+			cmd.Action = func() {
+				conf, err := obtainDefaultConfig(*configFileOpt, *genesisFileOpt)
+				if err != nil {
+					output.Fatalf("could not obtain config: %v", err)
+				}
+				to, err := storage.ParseBackend(*toOpt)
+				if err != nil {
+					output.Fatalf("could not migrate: %v", err)
+				}
+				logger, err := logconfig.New().NewLogger()
+				if err != nil {
+					output.Fatalf("could not make logger: %v", err)
+				}
+				err = core.MigrateStorage(conf, to, logger)
+				if err != nil {
+					output.Fatalf("could not migrate: %v", err)
+				}
+				output.Printf("Migrated storage to %s, set Storage.Backend = \"%s\" in your config before starting "+
+					"this node", to, to)
+			}
+		})
+	}
+}
@@ -3,14 +3,17 @@ package commands
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"time"
 
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/jobs"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	cli "github.com/jawher/mow.cli"
 )
@@ -71,12 +74,14 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 			cmd.Command("bond", "bond a new validator", func(cmd *cli.Cmd) {
 				sourceOpt := cmd.StringOpt("s source", "", "Account with bonding perm, if not set config is used")
 				amountOpt := cmd.StringOpt("a amount", "", "Amount of value to bond, required")
-				cmd.Spec += "[--source=<address>] [--amount=<value>]"
+				delegateOpt := cmd.StringOpt("delegate", "", "Bonded validator to delegate to, if not set source bonds itself")
+				cmd.Spec += "[--source=<address>] [--amount=<value>] [--delegate=<address>]"
 
 				cmd.Action = func() {
 					bond := &def.Bond{
-						Source: jobs.FirstOf(*sourceOpt, address),
-						Amount: *amountOpt,
+						Source:    jobs.FirstOf(*sourceOpt, address),
+						Amount:    *amountOpt,
+						Validator: *delegateOpt,
 					}
 
 					if err := bond.Validate(); err != nil {
@@ -97,12 +102,14 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 			cmd.Command("unbond", "unbond an existing validator", func(cmd *cli.Cmd) {
 				sourceOpt := cmd.StringOpt("s source", "", "Validator to unbond, if not set config is used")
 				amountOpt := cmd.StringOpt("a amount", "", "Amount of value to unbond, required")
-				cmd.Spec += "[--source=<address>] [--amount=<value>]"
+				delegateOpt := cmd.StringOpt("delegate", "", "Validator delegated to, if not set source unbonds itself")
+				cmd.Spec += "[--source=<address>] [--amount=<value>] [--delegate=<address>]"
 
 				cmd.Action = func() {
 					unbond := &def.Unbond{
-						Source: jobs.FirstOf(*sourceOpt, address),
-						Amount: *amountOpt,
+						Source:    jobs.FirstOf(*sourceOpt, address),
+						Amount:    *amountOpt,
+						Validator: *delegateOpt,
 					}
 
 					if err := unbond.Validate(); err != nil {
@@ -120,6 +127,72 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 				}
 			})
 
+			cmd.Command("unjail", "restore the power of a validator jailed for downtime", func(cmd *cli.Cmd) {
+				sourceOpt := cmd.StringOpt("s source", "", "Jailed validator, if not set config is used")
+				cmd.Spec += "[--source=<address>]"
+
+				cmd.Action = func() {
+					unjail := &def.Unjail{
+						Source: jobs.FirstOf(*sourceOpt, address),
+					}
+
+					if err := unjail.Validate(); err != nil {
+						output.Fatalf("could not validate UnjailTx: %v", err)
+					}
+
+					tx, err := jobs.FormulateUnjailJob(unjail, address, client, logger)
+					if err != nil {
+						output.Fatalf("could not formulate UnjailTx: %v", err)
+					}
+
+					output.Printf("%s", source.JSONString(payload.Any{
+						UnjailTx: tx,
+					}))
+				}
+			})
+
+			cmd.Command("multisig", "change the members of a multi-signature account", func(cmd *cli.Cmd) {
+				sourceOpt := cmd.StringOpt("s source", "", "Address of the multi-signature account, required")
+				thresholdOpt := cmd.StringOpt("threshold", "", "Number of member signatures required, required")
+				membersOpt := cmd.StringsOpt("m member", nil, "Hex public key of a member, at least threshold required")
+				cmd.Spec += "[--source=<address>] [--threshold=<number>] [--member=<public key>...]"
+
+				cmd.Action = func() {
+					tx, err := client.MultiSig(&def.MultiSigArg{
+						Input:     *sourceOpt,
+						Threshold: *thresholdOpt,
+						Members:   *membersOpt,
+					}, logger)
+					if err != nil {
+						output.Fatalf("could not formulate MultiSigTx: %v", err)
+					}
+
+					output.Printf("%s", source.JSONString(payload.Any{
+						MultiSigTx: tx,
+					}))
+				}
+			})
+
+			cmd.Command("rotate", "replace the key that signs for an account", func(cmd *cli.Cmd) {
+				sourceOpt := cmd.StringOpt("s source", "", "Address of the account, if not set config is used")
+				keyOpt := cmd.StringOpt("k key", "", "Hex public key to sign for the account from now on, required")
+				cmd.Spec += "[--source=<address>] [--key=<public key>]"
+
+				cmd.Action = func() {
+					tx, err := client.RotateKey(&def.RotateKeyArg{
+						Input:     jobs.FirstOf(*sourceOpt, address),
+						PublicKey: *keyOpt,
+					}, logger)
+					if err != nil {
+						output.Fatalf("could not formulate RotateKeyTx: %v", err)
+					}
+
+					output.Printf("%s", source.JSONString(payload.Any{
+						RotateKeyTx: tx,
+					}))
+				}
+			})
+
 			cmd.Command("identify", "associate a validator with a node address", func(cmd *cli.Cmd) {
 				sourceOpt := cmd.StringOpt("source", "", "Address to send from, if not set config is used")
 				nodeKeyOpt := cmd.StringOpt("node-key", "", "File containing the nodeKey to use, default config")
@@ -157,6 +230,101 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 			})
 		})
 
+		cmd.Command("sign", "add multi-signature member signatures to a tx or signed envelope", func(cmd *cli.Cmd) {
+			conf, err := configOpts.obtainBurrowConfig()
+			if err != nil {
+				output.Fatalf("could not set up config: %v", err)
+			}
+			fileOpt := cmd.StringOpt("f file", "", "Read the tx or envelope from a file")
+			addressOpt := cmd.StringOpt("a address", "", "Address of the multi-signature account, required")
+			signersOpt := cmd.StringsOpt("signer", nil, "Address of a member key in burrow keys to sign with, "+
+				"if not set config is used")
+			thresholdOpt := cmd.StringOpt("threshold", "", "Number of member signatures required, "+
+				"if not set the current members are read from the chain")
+			membersOpt := cmd.StringsOpt("m member", nil, "Hex public key of a member, only used with --threshold")
+			cmd.Spec += "[--file=<location>] [--address=<address>] [--signer=<address>...] " +
+				"[--threshold=<number>] [--member=<public key>...]"
+
+			cmd.Action = func() {
+				if err := conf.Verify(); err != nil {
+					output.Fatalf("can't continue with config: %v", err)
+				}
+
+				chainHost := jobs.FirstOf(*chainOpt, conf.RPC.GRPC.ListenAddress())
+				client := def.NewClient(chainHost, conf.Keys.RemoteAddress, false, time.Duration(*timeoutOpt)*time.Second)
+				logger := logging.NewNoopLogger()
+
+				data, err := readInput(*fileOpt)
+				if err != nil {
+					output.Fatalf("no input: %v", err)
+				}
+				txEnv, err := readEnvelope(client, data, logger)
+				if err != nil {
+					output.Fatalf("could not read tx: %v", err)
+				}
+
+				address, err := crypto.AddressFromHexString(*addressOpt)
+				if err != nil {
+					output.Fatalf("could not parse multi-signature address: %v", err)
+				}
+				var multiSig *crypto.MultiSig
+				if *thresholdOpt != "" {
+					multiSig, err = def.ParseMultiSig(*thresholdOpt, *membersOpt)
+				} else {
+					multiSig, err = client.GetMultiSig(address, logger)
+				}
+				if err != nil {
+					output.Fatalf("could not obtain multi-signature members: %v", err)
+				}
+
+				var signers []crypto.Address
+				for _, signer := range *signersOpt {
+					signerAddress, err := crypto.AddressFromHexString(signer)
+					if err != nil {
+						output.Fatalf("could not parse signer address: %v", err)
+					}
+					signers = append(signers, signerAddress)
+				}
+				if len(signers) == 0 {
+					if conf.ValidatorAddress == nil {
+						output.Fatalf("no signer provided and no address in config")
+					}
+					signers = append(signers, *conf.ValidatorAddress)
+				}
+
+				err = client.SignMultiSig(txEnv, address, multiSig, signers, logger)
+				if err != nil {
+					output.Fatalf("could not sign tx: %v", err)
+				}
+				output.Printf("%s", source.JSONString(txEnv))
+			}
+		})
+
+		cmd.Command("merge", "combine the multi-signature member signatures of signed envelopes", func(cmd *cli.Cmd) {
+			filesArg := cmd.StringsArg("FILE", nil, "Files containing envelopes of the same tx")
+			cmd.Spec += "FILE..."
+
+			cmd.Action = func() {
+				var txEnv *txs.Envelope
+				for _, file := range *filesArg {
+					data, err := readInput(file)
+					if err != nil {
+						output.Fatalf("no input: %v", err)
+					}
+					other := new(txs.Envelope)
+					if err = json.Unmarshal(data, other); err != nil || other.Tx == nil {
+						output.Fatalf("could not unmarshal envelope from %s: %v", file, err)
+					}
+					if txEnv == nil {
+						txEnv = other
+					} else if err = txEnv.Merge(other); err != nil {
+						output.Fatalf("could not merge envelope from %s: %v", file, err)
+					}
+				}
+				output.Printf("%s", source.JSONString(txEnv))
+			}
+		})
+
 		cmd.Command("commit", "read and send a tx to mempool", func(cmd *cli.Cmd) {
 			conf, err := configOpts.obtainBurrowConfig()
 			if err != nil {
@@ -182,6 +350,17 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("no input: %v", err)
 				}
 
+				// Envelopes already signed by the members of multi-signature accounts are broadcast as they are
+				txEnv := new(txs.Envelope)
+				if err = json.Unmarshal(data, txEnv); err == nil && txEnv.Tx != nil {
+					txe, err := client.BroadcastEnvelope(txEnv, logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("failed to commit tx to mempool: %v", err)
+					}
+					output.Printf("%s", txe.Receipt.TxHash.String())
+					return
+				}
+
 				if err = json.Unmarshal(data, &rawTx); err != nil {
 					output.Fatalf("could not unmarshal Tx: %v", err)
 				}
@@ -193,8 +372,12 @@ func Tx(output Output) func(cmd *cli.Cmd) {
 					hash, err = makeTx(client, tx)
 				case *payload.UnbondTx:
 					hash, err = makeTx(client, tx)
+				case *payload.UnjailTx:
+					hash, err = makeTx(client, tx)
 				case *payload.IdentifyTx:
 					hash, err = makeTx(client, tx)
+				case *payload.RotateKeyTx:
+					hash, err = makeTx(client, tx)
 				default:
 					output.Fatalf("payload type not recognized")
 				}
@@ -223,6 +406,23 @@ func makeTx(client *def.Client, tx payload.Payload) (string, error) {
 	return txe.Receipt.TxHash.String(), nil
 }
 
+// Reads either a signed envelope or a tx to enclose in a new envelope
+func readEnvelope(client *def.Client, data []byte, logger *logging.Logger) (*txs.Envelope, error) {
+	txEnv := new(txs.Envelope)
+	if err := json.Unmarshal(data, txEnv); err == nil && txEnv.Tx != nil {
+		return txEnv, nil
+	}
+	var rawTx payload.Any
+	if err := json.Unmarshal(data, &rawTx); err != nil {
+		return nil, err
+	}
+	tx, ok := rawTx.GetValue().(payload.Payload)
+	if !ok {
+		return nil, fmt.Errorf("payload type not recognized")
+	}
+	return client.Enclose(tx, logger)
+}
+
 func readInput(file string) ([]byte, error) {
 	if file != "" {
 		data, err := ioutil.ReadFile(file)
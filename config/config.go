@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
 	tmConfig "github.com/tendermint/tendermint/config"
 )
 
@@ -30,6 +31,7 @@ type BurrowConfig struct {
 	Keys       *keys.KeysConfig                   `json:",omitempty" toml:",omitempty"`
 	RPC        *rpc.RPCConfig                     `json:",omitempty" toml:",omitempty"`
 	Logging    *logconfig.LoggingConfig           `json:",omitempty" toml:",omitempty"`
+	Storage    *storage.StorageConfig             `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowConfig() *BurrowConfig {
@@ -40,6 +42,7 @@ func DefaultBurrowConfig() *BurrowConfig {
 		RPC:        rpc.DefaultRPCConfig(),
 		Execution:  execution.DefaultExecutionConfig(),
 		Logging:    logconfig.DefaultNodeLoggingConfig(),
+		Storage:    storage.DefaultStorageConfig(),
 	}
 }
 
@@ -51,7 +54,17 @@ func (conf *BurrowConfig) Verify() error {
 }
 
 func (conf *BurrowConfig) TendermintConfig() (*tmConfig.Config, error) {
-	return conf.Tendermint.Config(conf.BurrowDir, conf.Execution.TimeoutFactor)
+	tmConf, err := conf.Tendermint.Config(conf.BurrowDir, conf.Execution.TimeoutFactor)
+	if err != nil {
+		return nil, err
+	}
+	// Tendermint shares our storage backend
+	backend, err := conf.Storage.BackendType()
+	if err != nil {
+		return nil, err
+	}
+	tmConf.DBBackend = string(backend)
+	return tmConf, nil
 }
 
 func (conf *BurrowConfig) JSONString() string {
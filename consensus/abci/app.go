@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/project"
@@ -37,32 +38,39 @@ type App struct {
 	// State
 	blockchain      *bcm.Blockchain
 	validators      Validators
+	upgrades        governance.UpgradeIterable
 	mempoolLocker   sync.Locker
 	authorizedPeers AuthorizedPeers
 	// We need to cache these from BeginBlock for when we need actually need it in Commit
 	block *types.RequestBeginBlock
 	// Function to use to fail gracefully from panic rather than letting Tendermint make us a zombie
 	panicFunc func(error)
-	checker   execution.BatchExecutor
-	committer execution.BatchCommitter
-	txDecoder txs.Decoder
-	logger    *logging.Logger
+	// Function to use to shut down once we have committed the last block we can or have been asked to run
+	haltFunc   func(error)
+	haltHeight uint64
+	halting    bool
+	checker    execution.BatchExecutor
+	committer  execution.BatchCommitter
+	txDecoder  txs.Decoder
+	logger     *logging.Logger
 }
 
 var _ types.Application = &App{}
 
-func NewApp(nodeInfo string, blockchain *bcm.Blockchain, validators Validators, checker execution.BatchExecutor,
-	committer execution.BatchCommitter, txDecoder txs.Decoder, authorizedPeers AuthorizedPeers,
-	panicFunc func(error), logger *logging.Logger) *App {
+func NewApp(nodeInfo string, blockchain *bcm.Blockchain, validators Validators, upgrades governance.UpgradeIterable,
+	checker execution.BatchExecutor, committer execution.BatchCommitter, txDecoder txs.Decoder,
+	authorizedPeers AuthorizedPeers, panicFunc, haltFunc func(error), logger *logging.Logger) *App {
 	return &App{
 		nodeInfo:        nodeInfo,
 		blockchain:      blockchain,
 		validators:      validators,
+		upgrades:        upgrades,
 		checker:         checker,
 		committer:       committer,
 		txDecoder:       txDecoder,
 		authorizedPeers: authorizedPeers,
 		panicFunc:       panicFunc,
+		haltFunc:        haltFunc,
 		logger: logger.WithScope("abci.NewApp").With(structure.ComponentKey, "ABCI_App",
 			"node_info", nodeInfo),
 	}
@@ -75,6 +83,20 @@ func (app *App) SetMempoolLocker(mempoolLocker sync.Locker) {
 	app.mempoolLocker = mempoolLocker
 }
 
+// Halt after committing the block at height, zero meaning never
+func (app *App) SetHaltHeight(height uint64) {
+	app.haltHeight = height
+}
+
+// CheckHalt returns an error if a node halting after haltHeight (when non-zero) and running the current version of
+// Burrow should not execute the block at height
+func CheckHalt(upgrades governance.UpgradeIterable, haltHeight, height uint64) error {
+	if haltHeight > 0 && height > haltHeight {
+		return fmt.Errorf("configured to halt after height %d", haltHeight)
+	}
+	return governance.CheckUpgrades(upgrades, height, project.History.CurrentVersion())
+}
+
 func (app *App) Info(info types.RequestInfo) types.ResponseInfo {
 	return types.ResponseInfo{
 		Data:             app.nodeInfo,
@@ -138,14 +160,23 @@ func (app *App) InitChain(chain types.RequestInitChain) types.ResponseInitChain
 }
 
 func (app *App) BeginBlock(block types.RequestBeginBlock) (respBeginBlock types.ResponseBeginBlock) {
+	if app.halting {
+		// Shutting down waits for consensus to stop, which it does when we panic back into it without applying this
+		// block. Our panicFunc would instead try to shut down again from within consensus and so never return.
+		panic(fmt.Errorf("refusing to run block at height %d while halting", block.Header.Height))
+	}
 	app.block = &block
 	defer func() {
 		if r := recover(); r != nil {
 			app.panicFunc(fmt.Errorf("panic occurred in abci.App/BeginBlock: %v\n%s", r, debug.Stack()))
 		}
 	}()
+	// We should have halted before this block
+	err := CheckHalt(app.upgrades, app.haltHeight, uint64(block.Header.Height))
+	if err != nil {
+		panic(fmt.Errorf("refusing to run block: %v", err))
+	}
 	if block.Header.Height > 1 {
-		var err error
 		previousValidators := validator.NewTrimSet()
 		// Tendermint runs two blocks behind plus we are updating in end block validators updated last round
 		err = validator.Write(previousValidators,
@@ -165,6 +196,10 @@ func (app *App) BeginBlock(block types.RequestBeginBlock) (respBeginBlock types.
 			}
 		}
 	}
+	err = app.committer.RecordLiveness(block.LastCommitInfo, block.ByzantineValidators)
+	if err != nil {
+		panic(fmt.Errorf("could not record validator liveness: %v", err))
+	}
 	return
 }
 
@@ -255,7 +290,8 @@ func (app *App) EndBlock(reqEndBlock types.RequestEndBlock) types.ResponseEndBlo
 		panic(err)
 	}
 	return types.ResponseEndBlock{
-		ValidatorUpdates: validatorUpdates,
+		ValidatorUpdates:      validatorUpdates,
+		ConsensusParamUpdates: app.committer.ConsensusParamUpdates(),
 	}
 }
 
@@ -318,6 +354,14 @@ func (app *App) Commit() types.ResponseCommit {
 	}
 	app.logger.InfoMsg("Committed block")
 
+	err = CheckHalt(app.upgrades, app.haltHeight, uint64(app.block.Header.Height)+1)
+	if err != nil {
+		app.logger.InfoMsg("Halting after committed block", structure.ErrorKey, err)
+		// Shutting down stops Tendermint, which waits for us to return, so we must not run another block meanwhile
+		app.halting = true
+		go app.haltFunc(err)
+	}
+
 	return types.ResponseCommit{
 		Data: appHash,
 	}
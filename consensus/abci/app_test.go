@@ -0,0 +1,23 @@
+package abci
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestBeginBlockWhileHalting(t *testing.T) {
+	var panicked error
+	app := NewApp("", nil, nil, nil, nil, nil, nil, nil, func(err error) { panicked = err }, nil,
+		logging.NewNoopLogger())
+	app.halting = true
+	// Consensus must be stopped by the panic itself rather than by our panicFunc, which would shut down a second time
+	assert.Panics(t, func() {
+		app.BeginBlock(types.RequestBeginBlock{Header: tmproto.Header{Height: 5}})
+	})
+	assert.NoError(t, panicked)
+	assert.Nil(t, app.block)
+}
@@ -44,6 +44,9 @@ type BurrowTendermintConfig struct {
 	// "", "never" (to never create unnecessary blocks)
 	// "always" (to create empty blocks each consensus round)
 	CreateEmptyBlocks string
+	// If set (as tcp://host:port or unix:///path) we listen here for an external signer speaking Tendermint's privval
+	// socket protocol (such as tmkms or Horcrux) to sign as our validator rather than signing with the keys service
+	PrivValidatorListenAddress string `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowTendermintConfig() *BurrowTendermintConfig {
@@ -88,7 +88,7 @@ func (nv *NodeView) RunID() simpleuuid.UUID {
 // Pass -1 to get all available transactions
 func (nv *NodeView) MempoolTransactions(maxTxs int) ([]*txs.Envelope, error) {
 	var transactions []*txs.Envelope
-	for _, txBytes := range nv.tmNode.Mempool().ReapMaxTxs(maxTxs) {
+	for _, txBytes := range nv.MempoolTxs(maxTxs) {
 		txEnv, err := nv.txDecoder.DecodeTx(txBytes)
 		if err != nil {
 			return nil, err
@@ -98,6 +98,29 @@ func (nv *NodeView) MempoolTransactions(maxTxs int) ([]*txs.Envelope, error) {
 	return transactions, nil
 }
 
+// Returns the undecoded transactions in the mempool, pass -1 to get all available transactions
+func (nv *NodeView) MempoolTxs(maxTxs int) types.Txs {
+	return nv.tmNode.Mempool().ReapMaxTxs(maxTxs)
+}
+
+func (nv *NodeView) MempoolSize() int {
+	return nv.tmNode.Mempool().Size()
+}
+
+// Remove all transactions from the mempool and its cache
+func (nv *NodeView) FlushMempool() {
+	nv.tmNode.Mempool().Flush()
+}
+
+// Lock the mempool as Tendermint does while committing a block, holding off CheckTx
+func (nv *NodeView) LockMempool() {
+	nv.tmNode.Mempool().Lock()
+}
+
+func (nv *NodeView) UnlockMempool() {
+	nv.tmNode.Mempool().Unlock()
+}
+
 func (nv *NodeView) RoundState() *ctypes.RoundState {
 	return nv.tmNode.ConsensusState().GetRoundState()
 }
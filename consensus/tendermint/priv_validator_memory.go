@@ -1,6 +1,8 @@
 package tendermint
 
 import (
+	"fmt"
+
 	"github.com/hyperledger/burrow/crypto"
 	tmCrypto "github.com/tendermint/tendermint/crypto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -9,7 +11,7 @@ import (
 
 type privValidatorMemory struct {
 	crypto.Addressable
-	signer         func(msg []byte) []byte
+	signer         tmCryptoSigner
 	lastSignedInfo *LastSignedInfo
 }
 
@@ -25,13 +27,34 @@ func NewPrivValidatorMemory(addressable crypto.Addressable, signer crypto.Signer
 	}
 }
 
-func asTendermintSigner(signer crypto.Signer) func(msg []byte) []byte {
-	return func(msg []byte) []byte {
+// Create a PrivValidator like NewPrivValidatorMemory but that persists the last signed height, round, and step to
+// stateFile before releasing any signature, and which loads it from there if it exists, so that we refuse to double
+// sign after a crash or restart.
+func NewPrivValidatorPersisted(addressable crypto.Addressable, signer crypto.Signer,
+	stateFile string) (*privValidatorMemory, error) {
+	lastSignedInfo, err := LoadLastSignedInfo(stateFile)
+	if err != nil {
+		return nil, err
+	}
+	return &privValidatorMemory{
+		Addressable:    addressable,
+		signer:         asTendermintSigner(signer),
+		lastSignedInfo: lastSignedInfo,
+	}, nil
+}
+
+// Signing errors are returned rather than swallowed so that we never record an empty signature as the last signed
+func asTendermintSigner(signer crypto.Signer) tmCryptoSigner {
+	return func(msg []byte) ([]byte, error) {
 		sig, err := signer.Sign(msg)
 		if err != nil {
-			return nil
+			return nil, fmt.Errorf("could not sign: %v", err)
+		}
+		tmSig := sig.TendermintSignature()
+		if len(tmSig) == 0 {
+			return nil, fmt.Errorf("signer returned an empty signature")
 		}
-		return sig.TendermintSignature()
+		return tmSig, nil
 	}
 }
 
@@ -43,7 +66,6 @@ func (pvm *privValidatorMemory) GetPubKey() (tmCrypto.PubKey, error) {
 	return pvm.GetPublicKey().TendermintPubKey(), nil
 }
 
-// TODO: consider persistence to disk/database to avoid double signing after a crash
 func (pvm *privValidatorMemory) SignVote(chainID string, vote *tmproto.Vote) error {
 	return pvm.lastSignedInfo.SignVote(pvm.signer, chainID, vote)
 }
@@ -0,0 +1,160 @@
+package tendermint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestPrivValidatorPersisted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestPrivValidatorPersisted")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stateFile := path.Join(dir, "priv_validator_state.json")
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	chainID := "TestChain"
+
+	pv, err := NewPrivValidatorPersisted(val, val, stateFile)
+	require.NoError(t, err)
+	signed := testVote(3, 1, "block")
+	require.NoError(t, pv.SignVote(chainID, signed))
+	require.NotEmpty(t, signed.Signature)
+
+	// Restart
+	pv, err = NewPrivValidatorPersisted(val, val, stateFile)
+	require.NoError(t, err)
+
+	t.Run("Resign", func(t *testing.T) {
+		vote := testVote(3, 1, "block")
+		vote.Timestamp = vote.Timestamp.Add(time.Second)
+		require.NoError(t, pv.SignVote(chainID, vote))
+		assert.Equal(t, signed.Signature, vote.Signature)
+		assert.Equal(t, signed.Timestamp, vote.Timestamp)
+	})
+
+	t.Run("Conflicting", func(t *testing.T) {
+		err := pv.SignVote(chainID, testVote(3, 1, "other block"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "conflicting data")
+	})
+
+	t.Run("Regression", func(t *testing.T) {
+		err := pv.SignVote(chainID, testVote(2, 5, "block"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "height regression")
+		assert.Contains(t, err.Error(), stateFile)
+		err = pv.SignVote(chainID, testVote(3, 0, "block"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "round regression")
+	})
+
+	t.Run("Progress", func(t *testing.T) {
+		require.NoError(t, pv.SignVote(chainID, testVote(4, 0, "next block")))
+		pv, err := NewPrivValidatorPersisted(val, val, stateFile)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), pv.lastSignedInfo.Height)
+		assert.Equal(t, stepPrecommit, pv.lastSignedInfo.Step)
+	})
+
+	t.Run("Corrupt", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(stateFile, []byte(`{"height": `), 0600))
+		_, err := NewPrivValidatorPersisted(val, val, stateFile)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "refusing to sign")
+	})
+}
+
+func TestPrivValidatorPersistFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestPrivValidatorPersistFailure")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	// The state cannot be written until its directory exists
+	stateDir := path.Join(dir, "data")
+	stateFile := path.Join(stateDir, "priv_validator_state.json")
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	chainID := "TestChain"
+
+	pv, err := NewPrivValidatorPersisted(val, val, stateFile)
+	require.NoError(t, err)
+	vote := testVote(3, 1, "block")
+	err = pv.SignVote(chainID, vote)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not persist")
+	assert.Empty(t, vote.Signature)
+
+	// Asking again must not release the signature that was not persisted
+	vote = testVote(3, 1, "block")
+	require.Error(t, pv.SignVote(chainID, vote))
+	assert.Empty(t, vote.Signature)
+	assert.Equal(t, int64(0), pv.lastSignedInfo.Height)
+
+	// Until it can be
+	require.NoError(t, os.Mkdir(stateDir, 0700))
+	require.NoError(t, pv.SignVote(chainID, vote))
+	assert.NotEmpty(t, vote.Signature)
+	pv, err = NewPrivValidatorPersisted(val, val, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pv.lastSignedInfo.Height)
+	assert.Equal(t, []byte(vote.Signature), pv.lastSignedInfo.Signature)
+}
+
+func TestPrivValidatorSignFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestPrivValidatorSignFailure")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stateFile := path.Join(dir, "priv_validator_state.json")
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	chainID := "TestChain"
+	signer := &flakySigner{Signer: val, fail: true}
+
+	pv, err := NewPrivValidatorPersisted(val, signer, stateFile)
+	require.NoError(t, err)
+	vote := testVote(3, 1, "block")
+	err = pv.SignVote(chainID, vote)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "keys unavailable")
+	assert.Empty(t, vote.Signature)
+	proposal := &tmproto.Proposal{Height: 3, Round: 1, Timestamp: vote.Timestamp}
+	require.Error(t, pv.SignProposal(chainID, proposal))
+	assert.Empty(t, proposal.Signature)
+	assert.Equal(t, int64(0), pv.lastSignedInfo.Height)
+
+	// Once the signer recovers we can sign the same height, round, and step, including after a restart
+	signer.fail = false
+	pv, err = NewPrivValidatorPersisted(val, signer, stateFile)
+	require.NoError(t, err)
+	require.NoError(t, pv.SignVote(chainID, vote))
+	assert.NotEmpty(t, vote.Signature)
+}
+
+type flakySigner struct {
+	crypto.Signer
+	fail bool
+}
+
+func (fs *flakySigner) Sign(msg []byte) (*crypto.Signature, error) {
+	if fs.fail {
+		return nil, fmt.Errorf("keys unavailable")
+	}
+	return fs.Signer.Sign(msg)
+}
+
+func testVote(height int64, round int32, block string) *tmproto.Vote {
+	return &tmproto.Vote{
+		Type:      tmproto.PrecommitType,
+		Height:    height,
+		Round:     round,
+		BlockID:   tmproto.BlockID{Hash: tmhash.Sum([]byte(block))},
+		Timestamp: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
@@ -0,0 +1,57 @@
+package tendermint
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const (
+	// How long we wait for an external signer to connect at startup
+	remoteSignerConnectTimeout = 30 * time.Second
+	// Retries of each request to the signer (as Tendermint does for its own remote signer) for up to 5s in total
+	remoteSignerRetries       = 50
+	remoteSignerRetryInterval = 100 * time.Millisecond
+)
+
+// NewRemotePrivValidator listens on listenAddress (of the form tcp://host:port or unix:///path) for an external signer
+// speaking Tendermint's privval socket protocol (such as tmkms or Horcrux) and returns a PrivValidator that forwards
+// signing requests to it. TCP connections are secured with secretConnKey, which the signer can use to authenticate us.
+// This blocks until a signer connects.
+func NewRemotePrivValidator(listenAddress, chainID string, secretConnKey ed25519.PrivKey,
+	logger *logging.Logger) (*privval.RetrySignerClient, error) {
+	protocol, address := tmnet.ProtocolAndAddress(listenAddress)
+	ln, err := net.Listen(protocol, address)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen for remote signer on %s: %v", listenAddress, err)
+	}
+	var listener net.Listener
+	switch protocol {
+	case "unix":
+		listener = privval.NewUnixListener(ln)
+	case "tcp":
+		listener = privval.NewTCPListener(ln, secretConnKey)
+	default:
+		ln.Close()
+		return nil, fmt.Errorf("remote signer listen address must use tcp:// or unix:// but got %s", listenAddress)
+	}
+
+	endpoint := privval.NewSignerListenerEndpoint(NewLogger(logger.WithScope("NewRemotePrivValidator")), listener)
+	client, err := privval.NewSignerClient(endpoint, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not start remote signer client: %v", err)
+	}
+	logger.InfoMsg("Waiting for remote signer to connect", "listen_address", listenAddress)
+	err = client.WaitForConnection(remoteSignerConnectTimeout)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("no remote signer connected to %s within %v: %v", listenAddress,
+			remoteSignerConnectTimeout, err)
+	}
+	return privval.NewRetrySignerClient(client, remoteSignerRetries, remoteSignerRetryInterval), nil
+}
@@ -0,0 +1,97 @@
+package tendermint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRemotePrivValidator(t *testing.T) {
+	chainID := "TestChain"
+	val := acm.GeneratePrivateAccountFromSecret("remote validator")
+
+	dir, err := ioutil.TempDir("", "TestRemotePrivValidator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, protocol := range []string{"tcp", "unix"} {
+		t.Run(protocol, func(t *testing.T) {
+			var listenAddress string
+			var dialer privval.SocketDialer
+			if protocol == "tcp" {
+				address := privval.GetFreeLocalhostAddrPort()
+				listenAddress = "tcp://" + address
+				dialer = privval.DialTCPFn(address, time.Second, ed25519.GenPrivKey())
+			} else {
+				socket := path.Join(dir, "signer.sock")
+				listenAddress = "unix://" + socket
+				dialer = privval.DialUnixFn(socket)
+			}
+
+			// A stand-in for an external signer such as tmkms with its own double-sign guard
+			signer := startSigner(t, chainID, dialer, NewPrivValidatorMemory(val, val))
+			defer signer.Stop()
+
+			pv, err := NewRemotePrivValidator(listenAddress, chainID, ed25519.GenPrivKey(), logging.NewNoopLogger())
+			require.NoError(t, err)
+			defer pv.Close()
+
+			pubKey, err := pv.GetPubKey()
+			require.NoError(t, err)
+			assert.Equal(t, val.GetPublicKey().TendermintPubKey(), pubKey)
+
+			vote := testVote(1, 0, "block")
+			require.NoError(t, pv.SignVote(chainID, vote))
+			assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, vote), vote.Signature))
+
+			proposal := &tmproto.Proposal{
+				Type:      tmproto.ProposalType,
+				Height:    2,
+				PolRound:  -1,
+				BlockID:   testVote(2, 0, "block").BlockID,
+				Timestamp: vote.Timestamp,
+			}
+			require.NoError(t, pv.SignProposal(chainID, proposal))
+			assert.True(t, pubKey.VerifySignature(types.ProposalSignBytes(chainID, proposal), proposal.Signature))
+
+			// The signer's guard refuses to sign at an earlier height
+			err = pv.SignVote(chainID, testVote(1, 0, "other block"))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "height regression")
+		})
+	}
+
+	t.Run("BadAddress", func(t *testing.T) {
+		_, err := NewRemotePrivValidator("http://127.0.0.1:0", chainID, ed25519.GenPrivKey(),
+			logging.NewNoopLogger())
+		require.Error(t, err)
+	})
+}
+
+func startSigner(t *testing.T, chainID string, dialer privval.SocketDialer,
+	pv types.PrivValidator) *privval.SignerServer {
+	endpoint := privval.NewSignerDialerEndpoint(NewLogger(logging.NewNoopLogger()), dialer,
+		privval.SignerDialerEndpointRetryWaitInterval(50*time.Millisecond),
+		privval.SignerDialerEndpointConnRetries(100))
+	signer := privval.NewSignerServer(endpoint, chainID, pv)
+	// The signer dials us so keeps retrying until we listen
+	go func() {
+		err := signer.Start()
+		if err != nil {
+			panic(fmt.Errorf("could not start stand-in signer: %v", err))
+		}
+	}()
+	return signer
+}
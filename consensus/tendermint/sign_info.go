@@ -2,14 +2,18 @@ package tendermint
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/tendermint/tendermint/libs/protoio"
+	"github.com/tendermint/tendermint/libs/tempfile"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -44,6 +48,8 @@ type LastSignedInfo struct {
 	Step      int8            `json:"step"`
 	Signature []byte          `json:"signature,omitempty"` // so we don't lose signatures
 	SignBytes binary.HexBytes `json:"signbytes,omitempty"` // so we don't lose signatures
+	// If set the info is written here before any signature is released, otherwise it is held only in memory
+	filePath string
 }
 
 func NewLastSignedInfo() *LastSignedInfo {
@@ -52,7 +58,31 @@ func NewLastSignedInfo() *LastSignedInfo {
 	}
 }
 
-type tmCryptoSigner func(msg []byte) []byte
+// LoadLastSignedInfo reads the LastSignedInfo persisted at filePath, or starts afresh if the file does not exist. The
+// returned LastSignedInfo is written atomically to filePath on each signature.
+func LoadLastSignedInfo(filePath string) (*LastSignedInfo, error) {
+	lsi := NewLastSignedInfo()
+	lsi.filePath = filePath
+	bs, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return lsi, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read last signed info from %s: %v", filePath, err)
+	}
+	err = json.Unmarshal(bs, lsi)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode last signed info from %s, refusing to sign without it since "+
+			"we may double sign: %v", filePath, err)
+	}
+	if lsi.SignBytes != nil && lsi.Signature == nil {
+		return nil, fmt.Errorf("last signed info from %s has SignBytes but no Signature, refusing to sign "+
+			"without it since we may double sign", filePath)
+	}
+	return lsi, nil
+}
+
+type tmCryptoSigner func(msg []byte) ([]byte, error)
 
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
@@ -79,17 +109,17 @@ func (lsi *LastSignedInfo) SignProposal(sign tmCryptoSigner, chainID string, pro
 // returns error if HRS regression or no SignBytes. returns true if HRS is unchanged
 func (lsi *LastSignedInfo) checkHRS(height int64, round int32, step int8) (bool, error) {
 	if lsi.Height > height {
-		return false, errors.New("height regression")
+		return false, lsi.regression("height", height, round, step)
 	}
 
 	if lsi.Height == height {
 		if lsi.Round > round {
-			return false, errors.New("round regression")
+			return false, lsi.regression("round", height, round, step)
 		}
 
 		if lsi.Round == round {
 			if lsi.Step > step {
-				return false, errors.New("step regression")
+				return false, lsi.regression("step", height, round, step)
 			} else if lsi.Step == step {
 				if lsi.SignBytes != nil {
 					if lsi.Signature == nil {
@@ -104,6 +134,15 @@ func (lsi *LastSignedInfo) checkHRS(height int64, round int32, step int8) (bool,
 	return false, nil
 }
 
+func (lsi *LastSignedInfo) regression(what string, height int64, round int32, step int8) error {
+	err := fmt.Errorf("%s regression: asked to sign at height %d, round %d, step %d but have already signed at "+
+		"height %d, round %d, step %d", what, height, round, step, lsi.Height, lsi.Round, lsi.Step)
+	if lsi.filePath != "" {
+		return fmt.Errorf("%v (as recorded in %s)", err, lsi.filePath)
+	}
+	return err
+}
+
 // signVote checks if the vote is good to sign and sets the vote signature.
 // It may need to set the timestamp as well if the vote is otherwise the same as
 // a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
@@ -134,8 +173,14 @@ func (lsi *LastSignedInfo) signVote(sign tmCryptoSigner, chainID string, vote *t
 	}
 
 	// It passed the checks. Sign the vote
-	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	sig, err := sign(signBytes)
+	if err != nil {
+		return err
+	}
+	err = lsi.saveSigned(height, round, step, signBytes, sig)
+	if err != nil {
+		return err
+	}
 	vote.Signature = sig
 	return nil
 }
@@ -171,21 +216,47 @@ func (lsi *LastSignedInfo) signProposal(sign tmCryptoSigner, chainID string, pro
 	}
 
 	// It passed the checks. Sign the proposal
-	sig := sign(signBytes)
-	lsi.saveSigned(height, round, step, signBytes, sig)
+	sig, err := sign(signBytes)
+	if err != nil {
+		return err
+	}
+	err = lsi.saveSigned(height, round, step, signBytes, sig)
+	if err != nil {
+		return err
+	}
 	proposal.Signature = sig
 	return nil
 }
 
-// Persist height/round/step and signature
+// Persist height/round/step and signature, if this returns an error the signature must not be released
 func (lsi *LastSignedInfo) saveSigned(height int64, round int32, step int8,
-	signBytes []byte, sig []byte) {
+	signBytes []byte, sig []byte) error {
+
+	if lsi.filePath != "" {
+		// We only take on the new info once it is persisted so that a signature we failed to write is never released
+		// by the same height, round, and step being asked for again
+		bs, err := json.Marshal(&LastSignedInfo{
+			Height:    height,
+			Round:     round,
+			Step:      step,
+			Signature: sig,
+			SignBytes: signBytes,
+		})
+		if err != nil {
+			return fmt.Errorf("could not encode last signed info: %v", err)
+		}
+		err = tempfile.WriteFileAtomic(lsi.filePath, bs, 0600)
+		if err != nil {
+			return fmt.Errorf("could not persist last signed info to %s: %v", lsi.filePath, err)
+		}
+	}
 
 	lsi.Height = height
 	lsi.Round = round
 	lsi.Step = step
 	lsi.Signature = sig
 	lsi.SignBytes = signBytes
+	return nil
 }
 
 // String returns a string representation of the LastSignedInfo.
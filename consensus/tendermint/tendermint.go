@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -69,6 +70,10 @@ func NewNode(conf *config.Config, privValidator tmTypes.PrivValidator, genesisDo
 	}
 
 	nde := &Node{}
+	// Close any connection to a remote signer
+	if closer, ok := privValidator.(interface{ Close() error }); ok {
+		nde.closers = append(nde.closers, closer)
+	}
 	nde.Node, err = node.NewNode(conf, privValidator,
 		nodeKey, proxy.NewLocalClientCreator(app),
 		func() (*tmTypes.GenesisDoc, error) {
@@ -95,11 +100,7 @@ func DeriveGenesisDoc(burrowGenesisDoc *genesis.GenesisDoc, appHash []byte) *tmT
 			Power:   int64(validator.Amount),
 		}
 	}
-	consensusParams := tmTypes.DefaultConsensusParams()
-	// This is the smallest increment we can use to get a strictly increasing sequence
-	// of block time - we set it low to avoid skew
-	// if the BlockTimeIota is longer than the average block time
-	consensusParams.Block.TimeIotaMs = 1
+	consensusParams := governance.DefaultTendermintConsensusParams()
 
 	return &tmTypes.GenesisDoc{
 		ChainID:         burrowGenesisDoc.ChainID(),
@@ -48,6 +48,7 @@ func (kern *Kernel) LoadExecutionOptionsFromConfig(conf *execution.ExecutionConf
 		}
 		kern.exeOptions = exeOptions
 		kern.timeoutFactor = conf.TimeoutFactor
+		kern.haltHeight = conf.HaltHeight
 	}
 	return nil
 }
@@ -72,8 +73,9 @@ func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal
 	kern.info = fmt.Sprintf("Burrow_%s_%s_ValidatorID:%X", project.History.CurrentVersion().String(),
 		kern.Blockchain.ChainID(), pubKey.Address())
 
-	app := abci.NewApp(kern.info, kern.Blockchain, kern.State, kern.checker, kern.committer, kern.txCodec,
-		authorizedPeersProvider, kern.Panic, kern.Logger)
+	app := abci.NewApp(kern.info, kern.Blockchain, kern.State, kern.State, kern.checker, kern.committer, kern.txCodec,
+		authorizedPeersProvider, kern.Panic, kern.Halt, kern.Logger)
+	app.SetHaltHeight(kern.haltHeight)
 
 	// We could use this to provide/register our own metrics (though this will register them with us). Unfortunately
 	// Tendermint currently ignores the metrics passed unless its own server is turned on.
@@ -97,7 +99,7 @@ func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal
 
 // LoadKernelFromConfig builds and returns a Kernel based solely on the supplied configuration
 func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
-	kern, err := NewKernel(conf.BurrowDir)
+	kern, err := NewKernel(conf.BurrowDir, conf.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("could not create initial kernel: %v", err)
 	}
@@ -125,9 +127,9 @@ func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
 		return nil, fmt.Errorf("Address must be set")
 	}
 
-	privVal, err := kern.PrivValidator(*conf.ValidatorAddress)
+	privVal, err := kern.privValidatorFromConfig(conf)
 	if err != nil {
-		return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
+		return nil, err
 	}
 
 	err = kern.LoadTendermintFromConfig(conf, privVal)
@@ -138,3 +140,28 @@ func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
 	kern.AddProcesses(DefaultProcessLaunchers(kern, conf.RPC, conf.Keys)...)
 	return kern, nil
 }
+
+// Our validator signs remotely if a listen address for a remote signer is configured, otherwise via the keys service
+// persisting its last signed state alongside Tendermint's if Tendermint is enabled
+func (kern *Kernel) privValidatorFromConfig(conf *config.BurrowConfig) (tmTypes.PrivValidator, error) {
+	if conf.Tendermint == nil || !conf.Tendermint.Enabled {
+		return kern.PrivValidator(*conf.ValidatorAddress, "")
+	}
+	tmConf, err := conf.TendermintConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not build Tendermint config: %v", err)
+	}
+	if conf.Tendermint.PrivValidatorListenAddress != "" {
+		privVal, err := kern.RemotePrivValidator(*conf.ValidatorAddress, conf.Tendermint.PrivValidatorListenAddress,
+			tmConf.NodeKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to remote signer: %v", err)
+		}
+		return privVal, nil
+	}
+	privVal, err := kern.PrivValidator(*conf.ValidatorAddress, tmConf.PrivValidatorStateFile())
+	if err != nil {
+		return nil, fmt.Errorf("could not form PrivValidator from Address: %v", err)
+	}
+	return privVal, nil
+}
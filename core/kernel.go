@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"net"
 	_ "net/http/pprof"
 	"os"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/dump"
@@ -32,8 +34,10 @@ import (
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
+	tmEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/store"
 	tmTypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -71,12 +75,13 @@ type Kernel struct {
 	processes      map[string]process.Process
 	listeners      map[string]net.Listener
 	timeoutFactor  float64
+	haltHeight     uint64
 	shutdownNotify chan struct{}
 	shutdownOnce   sync.Once
 }
 
-// NewKernel initializes an empty kernel
-func NewKernel(dbDir string) (*Kernel, error) {
+// NewKernel initializes an empty kernel with its database in dbDir using the backend from storageConf
+func NewKernel(dbDir string, storageConf *storage.StorageConfig) (*Kernel, error) {
 	if dbDir == "" {
 		return nil, fmt.Errorf("Burrow requires a database directory")
 	}
@@ -84,7 +89,11 @@ func NewKernel(dbDir string) (*Kernel, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not create runID UUID: %w", err)
 	}
-	db, err := dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dbDir)
+	backend, err := storageConf.BackendType()
+	if err != nil {
+		return nil, err
+	}
+	db, err := storage.NewDB(BurrowDBName, backend, dbDir)
 	if err != nil {
 		return nil, fmt.Errorf("could not create DB for Kernel: %w", err)
 	}
@@ -150,6 +159,12 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 
 	kern.Logger.InfoMsg("State loading successful")
 
+	// Refuse to run past a halt or an upgrade this version of Burrow cannot run
+	err = abci.CheckHalt(kern.State, kern.haltHeight, kern.Blockchain.LastBlockHeight()+1)
+	if err != nil {
+		return fmt.Errorf("cannot continue chain: %w", err)
+	}
+
 	params := execution.ParamsFromGenesis(genesisDoc)
 	kern.checker, err = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger)
 	if err != nil {
@@ -165,6 +180,35 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 
 // LoadDump restores chain state from the given dump file
 func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string, silent bool) (err error) {
+	return kern.loadDump(genesisDoc, silent, func() (dump.Source, error) {
+		// Archives are verified against their manifest here so we do not start restoring a corrupted dump
+		reader, err := dump.NewFileReader(restoreFile)
+		if err != nil {
+			return nil, err
+		}
+		if ar, ok := reader.(*dump.ArchiveReader); ok {
+			manifest := ar.Manifest()
+			kern.Logger.InfoMsg("Verified dump archive",
+				"chain_id", manifest.ChainID,
+				"start_height", manifest.StartHeight,
+				"end_height", manifest.EndHeight,
+				"app_hash", manifest.AppHash,
+				"chunks", len(manifest.Chunks))
+		}
+		return reader, nil
+	})
+}
+
+// LoadGethDump restores chain state from the given geth dump or genesis file, see dump.NewGethReader
+func (kern *Kernel) LoadGethDump(genesisDoc *genesis.GenesisDoc, restoreFile string, weiPerUnit *big.Int,
+	silent bool) error {
+	return kern.loadDump(genesisDoc, silent, func() (dump.Source, error) {
+		return dump.NewGethFileReader(restoreFile, weiPerUnit)
+	})
+}
+
+// The source is only opened once we know we need to restore
+func (kern *Kernel) loadDump(genesisDoc *genesis.GenesisDoc, silent bool, open func() (dump.Source, error)) (err error) {
 	var exists bool
 	if kern.Blockchain, exists, err = bcm.LoadOrNewBlockchain(kern.database, genesisDoc, kern.Logger); err != nil {
 		return fmt.Errorf("error creating or loading blockchain state: %v", err)
@@ -188,7 +232,7 @@ func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string,
 		return fmt.Errorf("AppHash is required when restoring chain")
 	}
 
-	reader, err := dump.NewFileReader(restoreFile)
+	reader, err := open()
 	if err != nil {
 		return err
 	}
@@ -240,8 +284,9 @@ func (kern *Kernel) SetKeyStore(store *keys.FilesystemKeyStore) {
 	kern.keyStore = store
 }
 
-// Generates an in-memory Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig)
-func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidator, error) {
+// Generates a Tendermint PrivValidator (suitable for passing to LoadTendermintFromConfig) that persists its last signed
+// state to stateFile, or holds it in memory only if stateFile is empty
+func (kern *Kernel) PrivValidator(validator crypto.Address, stateFile string) (tmTypes.PrivValidator, error) {
 	val, err := keys.AddressableSigner(kern.keyClient, validator)
 	if err != nil {
 		return nil, fmt.Errorf("could not get validator addressable from keys client: %v", err)
@@ -250,7 +295,40 @@ func (kern *Kernel) PrivValidator(validator crypto.Address) (tmTypes.PrivValidat
 	if err != nil {
 		return nil, err
 	}
-	return tendermint.NewPrivValidatorMemory(val, signer), nil
+	if stateFile == "" {
+		return tendermint.NewPrivValidatorMemory(val, signer), nil
+	}
+	return tendermint.NewPrivValidatorPersisted(val, signer, stateFile)
+}
+
+// Listens for an external signer (suitable for passing to LoadTendermintFromConfig), checking that it signs for
+// validator, see tendermint.NewRemotePrivValidator
+func (kern *Kernel) RemotePrivValidator(validator crypto.Address, listenAddress,
+	nodeKeyFile string) (tmTypes.PrivValidator, error) {
+	nodeKey, err := tendermint.EnsureNodeKey(nodeKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	secretConnKey, ok := nodeKey.PrivKey.(tmEd25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("node key in %s must be ed25519 to secure remote signer connection", nodeKeyFile)
+	}
+	privVal, err := tendermint.NewRemotePrivValidator(listenAddress, kern.Blockchain.ChainID(), secretConnKey,
+		kern.Logger)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		privVal.Close()
+		return nil, fmt.Errorf("could not get public key from remote signer: %v", err)
+	}
+	if !bytes.Equal(pubKey.Address(), validator.Bytes()) {
+		privVal.Close()
+		return nil, fmt.Errorf("remote signer signs for %X but our validator address is %v", pubKey.Address(),
+			validator)
+	}
+	return privVal, nil
 }
 
 // Boot the kernel starting Tendermint and RPC layers
@@ -274,6 +352,12 @@ func (kern *Kernel) Panic(err error) {
 	kern.ShutdownAndExit()
 }
 
+// Halt shuts down gracefully when we have committed the last block we are able or have been asked to run
+func (kern *Kernel) Halt(reason error) {
+	kern.Logger.InfoMsg("Halting", structure.ErrorKey, reason)
+	kern.ShutdownAndExit()
+}
+
 // Wait for a graceful shutdown
 func (kern *Kernel) WaitForShutdown() {
 	// Supports multiple goroutines waiting for shutdown since channel is closed
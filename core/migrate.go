@@ -0,0 +1,183 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/storage"
+	sm "github.com/tendermint/tendermint/state"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The Tendermint databases carried over when changing storage backend (we disable Tendermint's tx_index)
+var TendermintDBNames = []string{"blockstore", "state", "evidence"}
+
+type storageDB struct {
+	name string
+	dir  string
+}
+
+// MigrateStorage copies burrow's and Tendermint's databases from the storage backend in conf to backend and checks the
+// AppHash of the copied state. Only then are the copies moved into place, with the originals kept under a backup-<from>
+// directory alongside each database. Storage.Backend must be set to backend in config before the node is started.
+func MigrateStorage(conf *config.BurrowConfig, backend dbm.BackendType, logger *logging.Logger) error {
+	from, err := conf.Storage.BackendType()
+	if err != nil {
+		return err
+	}
+	to, err := storage.ParseBackend(string(backend))
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return fmt.Errorf("storage is already using the %s backend", to)
+	}
+	if from == dbm.MemDBBackend || to == dbm.MemDBBackend {
+		return fmt.Errorf("cannot migrate storage to or from %s since it is not persisted", dbm.MemDBBackend)
+	}
+	if conf.GenesisDoc == nil {
+		return fmt.Errorf("GenesisDoc is required to verify migrated state")
+	}
+	tmConf, err := conf.TendermintConfig()
+	if err != nil {
+		return fmt.Errorf("could not build Tendermint config: %w", err)
+	}
+
+	dbs := []storageDB{{name: BurrowDBName, dir: conf.BurrowDir}}
+	for _, name := range TendermintDBNames {
+		dbs = append(dbs, storageDB{name: name, dir: tmConf.DBDir()})
+	}
+	if !storage.DBExists(BurrowDBName, from, conf.BurrowDir) {
+		return fmt.Errorf("no %s burrow state found in %s", from, conf.BurrowDir)
+	}
+	for _, db := range dbs {
+		if storage.DBExists(db.name, from, backupDir(db.dir, from)) {
+			return fmt.Errorf("backup of %s database already exists in %s, please remove it before migrating",
+				db.name, backupDir(db.dir, from))
+		}
+	}
+
+	// Clear out any previous failed attempt
+	for _, db := range dbs {
+		err = os.RemoveAll(stagingDir(db.dir, to))
+		if err != nil {
+			return err
+		}
+	}
+
+	var migrated []storageDB
+	for _, db := range dbs {
+		if !storage.DBExists(db.name, from, db.dir) {
+			logger.InfoMsg("Skipping database that does not exist", "name", db.name, "dir", db.dir)
+			continue
+		}
+		count, err := copyDB(db.name, from, db.dir, to, stagingDir(db.dir, to))
+		if err != nil {
+			return fmt.Errorf("could not copy %s database: %w", db.name, err)
+		}
+		logger.InfoMsg("Copied database", "name", db.name, "keys", count, "from", from, "to", to)
+		migrated = append(migrated, db)
+	}
+
+	err = verifyMigratedState(conf.GenesisDoc, to, stagingDir(conf.BurrowDir, to), stagingDir(tmConf.DBDir(), to),
+		logger)
+	if err != nil {
+		return fmt.Errorf("migrated state could not be verified, original databases have not been modified: %w", err)
+	}
+
+	for _, db := range migrated {
+		backup := backupDir(db.dir, from)
+		err = os.MkdirAll(backup, 0700)
+		if err != nil {
+			return err
+		}
+		err = os.Rename(storage.DBPath(db.name, from, db.dir), storage.DBPath(db.name, from, backup))
+		if err != nil {
+			return err
+		}
+		err = os.Rename(storage.DBPath(db.name, to, stagingDir(db.dir, to)), storage.DBPath(db.name, to, db.dir))
+		if err != nil {
+			return err
+		}
+	}
+	for _, db := range migrated {
+		err = os.RemoveAll(stagingDir(db.dir, to))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyDB(name string, from dbm.BackendType, fromDir string, to dbm.BackendType, toDir string) (int, error) {
+	src, err := storage.NewDB(name, from, fromDir)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dst, err := storage.NewDB(name, to, toDir)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+	return storage.CopyDB(src, dst)
+}
+
+// Checks that the migrated burrow state hashes to the AppHash recorded by the migrated blockchain and by Tendermint
+func verifyMigratedState(genesisDoc *genesis.GenesisDoc, backend dbm.BackendType, burrowDir, tmDir string,
+	logger *logging.Logger) error {
+	db, err := storage.NewDB(BurrowDBName, backend, burrowDir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	blockchain, exists, err := bcm.LoadOrNewBlockchain(db, genesisDoc, logger)
+	if err != nil {
+		return err
+	} else if !exists {
+		return fmt.Errorf("no blockchain found in migrated state")
+	}
+	st, err := state.LoadState(db, execution.VersionAtHeight(blockchain.LastBlockHeight()))
+	if err != nil {
+		return err
+	}
+	appHash := blockchain.AppHashAfterLastBlock()
+	if !bytes.Equal(st.Hash(), appHash) {
+		return fmt.Errorf("migrated state has hash %X but blockchain gives AppHash %X at height %d",
+			st.Hash(), appHash, blockchain.LastBlockHeight())
+	}
+	if !storage.DBExists("state", backend, tmDir) {
+		return nil
+	}
+	tmDB, err := storage.NewDB("state", backend, tmDir)
+	if err != nil {
+		return err
+	}
+	defer tmDB.Close()
+	tmState, err := sm.NewStore(tmDB).Load()
+	if err != nil {
+		return err
+	}
+	// Tendermint may lag us by a block if we stopped between commits, in which case it replays on start
+	if uint64(tmState.LastBlockHeight) == blockchain.LastBlockHeight() && !bytes.Equal(tmState.AppHash, appHash) {
+		return fmt.Errorf("migrated Tendermint state has AppHash %X but burrow state has AppHash %X at height %d",
+			tmState.AppHash, appHash, blockchain.LastBlockHeight())
+	}
+	return nil
+}
+
+func stagingDir(dir string, to dbm.BackendType) string {
+	return filepath.Join(dir, "migrate-"+string(to))
+}
+
+func backupDir(dir string, from dbm.BackendType) string {
+	return filepath.Join(dir, "backup-"+string(from))
+}
@@ -2,12 +2,15 @@ package core
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/abci"
+	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -18,7 +21,9 @@ import (
 	"github.com/hyperledger/burrow/rpc/metrics"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcgateway"
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
+	"github.com/hyperledger/burrow/rpc/rpcmempool"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
 	"github.com/hyperledger/burrow/rpc/web3"
@@ -26,6 +31,8 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/version"
 	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -37,10 +44,13 @@ const (
 	Web3ProcessName        = "rpcConfig/web3"
 	InfoProcessName        = "rpcConfig/info"
 	GRPCProcessName        = "rpcConfig/GRPC"
+	GatewayProcessName     = "rpcConfig/gateway"
 	MetricsProcessName     = "rpcConfig/metrics"
 )
 
 func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig *keys.KeysConfig) []process.Launcher {
+	// Shared between servers so that a client's limits apply across all of them
+	limiter := rpc.NewRateLimiter(rpcConfig.RateLimit)
 	// Run announcer after Tendermint so it can get some details
 	return []process.Launcher{
 		ProfileLauncher(kern, rpcConfig.Profiler),
@@ -48,10 +58,11 @@ func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig
 		NoConsensusLauncher(kern),
 		TendermintLauncher(kern),
 		StartupLauncher(kern),
-		Web3Launcher(kern, rpcConfig.Web3),
-		InfoLauncher(kern, rpcConfig.Info),
-		MetricsLauncher(kern, rpcConfig.Metrics),
-		GRPCLauncher(kern, rpcConfig.GRPC, keysConfig),
+		Web3Launcher(kern, rpcConfig.Web3, rpcConfig.Auth, limiter),
+		InfoLauncher(kern, rpcConfig.Info, rpcConfig.Auth, limiter),
+		MetricsLauncher(kern, rpcConfig.Metrics, limiter),
+		GRPCLauncher(kern, rpcConfig.GRPC, rpcConfig.Auth, limiter, keysConfig),
+		GatewayLauncher(kern, rpcConfig.Gateway, rpcConfig.Auth, limiter, keysConfig),
 	}
 }
 
@@ -142,8 +153,9 @@ func TendermintLauncher(kern *Kernel) process.Launcher {
 			nameRegState := kern.State
 			nodeRegState := kern.State
 			validatorState := kern.State
+			paramsState := kern.State
 			kern.Service = rpc.NewService(accountState, nameRegState, nodeRegState, kern.Blockchain, validatorState, nodeView, kern.Logger)
-			kern.EthService = rpc.NewEthService(accountState, eventsState, kern.Blockchain, validatorState, nodeView, kern.Transactor, kern.keyStore, kern.Logger)
+			kern.EthService = rpc.NewEthService(accountState, eventsState, kern.Blockchain, validatorState, paramsState, nodeView, kern.Transactor, kern.keyStore, kern.Logger)
 
 			if err := kern.Node.Start(); err != nil {
 				return nil, fmt.Errorf("%s error starting Tendermint node: %v", errHeader, err)
@@ -214,20 +226,21 @@ func StartupLauncher(kern *Kernel) process.Launcher {
 	}
 }
 
-func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
+func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig, authConf *rpc.AuthConfig,
+	limiter *rpc.RateLimiter) process.Launcher {
 	return process.Launcher{
 		Name:    InfoProcessName,
 		Enabled: conf.Enabled,
 		Launch: func() (process.Process, error) {
-			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			auth, err := rpc.NewAuthorizer(authConf)
 			if err != nil {
 				return nil, err
 			}
-			err = kern.registerListener(InfoProcessName, listener)
+			listener, err := kern.httpListener(InfoProcessName, conf)
 			if err != nil {
 				return nil, err
 			}
-			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, kern.Logger)
+			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, auth, limiter, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -236,21 +249,24 @@ func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	}
 }
 
-func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
+func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig, authConf *rpc.AuthConfig,
+	limiter *rpc.RateLimiter) process.Launcher {
 	return process.Launcher{
 		Name:    Web3ProcessName,
 		Enabled: conf.Enabled,
 		Launch: func() (process.Process, error) {
-			listener, err := process.ListenerFromAddress(fmt.Sprintf("%s:%s", conf.ListenHost, conf.ListenPort))
+			auth, err := rpc.NewAuthorizer(authConf)
 			if err != nil {
 				return nil, err
 			}
-			err = kern.registerListener(Web3ProcessName, listener)
+			listener, err := kern.httpListener(Web3ProcessName, conf)
 			if err != nil {
 				return nil, err
 			}
 
-			srv, err := server.StartHTTPServer(listener, web3.NewServer(kern.EthService), kern.Logger)
+			handler := rpc.HTTPHandler(web3.NewServer(kern.EthService), auth, limiter,
+				rpc.JSONRPCMethods(rpc.Web3MethodPrefix, false))
+			srv, err := server.StartHTTPServer(listener, handler, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -260,7 +276,7 @@ func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	}
 }
 
-func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
+func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig, limiter *rpc.RateLimiter) process.Launcher {
 	return process.Launcher{
 		Name:    MetricsProcessName,
 		Enabled: conf.Enabled,
@@ -273,8 +289,12 @@ func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 			if err != nil {
 				return nil, err
 			}
+			var rejections metrics.RejectionCounter
+			if limiter != nil {
+				rejections = limiter
+			}
 			server, err := metrics.StartServer(kern.Service, conf.MetricsPath, listener, conf.BlockSampleSize,
-				kern.Logger)
+				rejections, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -283,7 +303,8 @@ func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 	}
 }
 
-func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConfig) process.Launcher {
+func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, authConf *rpc.AuthConfig, limiter *rpc.RateLimiter,
+	keyConfig *keys.KeysConfig) process.Launcher {
 	return process.Launcher{
 		Name:    GRPCProcessName,
 		Enabled: conf.Enabled,
@@ -293,6 +314,19 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 				return nil, err
 			}
 
+			auth, err := rpc.NewAuthorizer(authConf)
+			if err != nil {
+				return nil, err
+			}
+			var opts []grpc.ServerOption
+			if conf.TLS != nil {
+				tlsConfig, err := conf.TLS.Config()
+				if err != nil {
+					return nil, err
+				}
+				opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+			}
+
 			listener, err := process.ListenerFromAddress(conf.ListenAddress())
 			if err != nil {
 				return nil, err
@@ -302,30 +336,10 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 				return nil, err
 			}
 
-			grpcServer := rpc.NewGRPCServer(kern.Logger)
-			var ks *keys.FilesystemKeyStore
-			if kern.keyStore != nil {
-				ks = kern.keyStore
-			}
+			grpcServer := rpc.NewGRPCServer(kern.Logger, auth, limiter, opts...)
 			grpcServer.GetServiceInfo()
 
-			if keyConfig.GRPCServiceEnabled {
-				if kern.keyStore == nil {
-					ks = keys.NewFilesystemKeyStore(keyConfig.KeysDirectory, keyConfig.AllowBadFilePermissions)
-				}
-				keys.RegisterKeysServer(grpcServer, ks)
-			}
-			rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, kern.Blockchain, nodeView,
-				kern.Logger))
-
-			txCodec := txs.NewProtobufCodec()
-			rpctransact.RegisterTransactServer(grpcServer,
-				rpctransact.NewTransactServer(kern.State, kern.Blockchain, kern.Transactor, txCodec, kern.Logger))
-
-			rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
-				kern.Emitter, kern.Blockchain, kern.Logger))
-
-			rpcdump.RegisterDumpServer(grpcServer, rpcdump.NewDumpServer(kern.State, kern.Blockchain, kern.Logger))
+			registerServices(kern, grpcServer, nodeView, keyConfig)
 
 			// Provides metadata about services registered
 			// reflection.Register(grpcServer)
@@ -340,3 +354,84 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 		},
 	}
 }
+
+// Serves the GRPC services over HTTP/JSON
+func GatewayLauncher(kern *Kernel, conf *rpc.ServerConfig, authConf *rpc.AuthConfig, limiter *rpc.RateLimiter,
+	keyConfig *keys.KeysConfig) process.Launcher {
+	return process.Launcher{
+		Name:    GatewayProcessName,
+		Enabled: conf.Enabled,
+		Launch: func() (process.Process, error) {
+			nodeView, err := kern.GetNodeView()
+			if err != nil {
+				return nil, err
+			}
+
+			auth, err := rpc.NewAuthorizer(authConf)
+			if err != nil {
+				return nil, err
+			}
+			listener, err := kern.httpListener(GatewayProcessName, conf)
+			if err != nil {
+				return nil, err
+			}
+
+			unary, stream := rpc.Interceptors(kern.Logger.WithScope("GatewayLauncher"), auth, limiter)
+			gateway := rpcgateway.NewGateway(unary, stream, kern.Logger)
+
+			registerServices(kern, gateway, nodeView, keyConfig)
+
+			return server.StartHTTPServer(listener, gateway, kern.Logger)
+		},
+	}
+}
+
+// Register the GRPC services against either a GRPC server or the HTTP gateway
+func registerServices(kern *Kernel, registrar grpc.ServiceRegistrar, nodeView *tendermint.NodeView,
+	keyConfig *keys.KeysConfig) {
+
+	if keyConfig.GRPCServiceEnabled {
+		ks := kern.keyStore
+		if ks == nil {
+			ks = keys.NewFilesystemKeyStore(keyConfig.KeysDirectory, keyConfig.AllowBadFilePermissions)
+		}
+		keys.RegisterKeysServer(registrar, ks)
+	}
+	rpcquery.RegisterQueryServer(registrar, rpcquery.NewQueryServer(kern.State, kern.Blockchain, nodeView,
+		kern.Logger))
+
+	txCodec := txs.NewProtobufCodec()
+	rpctransact.RegisterTransactServer(registrar,
+		rpctransact.NewTransactServer(kern.State, kern.Blockchain, kern.Transactor, txCodec, kern.Logger))
+
+	rpcevents.RegisterExecutionEventsServer(registrar, rpcevents.NewExecutionEventsServer(kern.State,
+		kern.State, kern.Emitter, kern.Blockchain, kern.Logger))
+
+	rpcdump.RegisterDumpServer(registrar, rpcdump.NewDumpServer(kern.State, kern.Blockchain, kern.Logger))
+
+	// There is no mempool without Tendermint
+	if nodeView != nil {
+		rpcmempool.RegisterMempoolServer(registrar, rpcmempool.NewMempoolServer(nodeView, kern.checker, txCodec,
+			kern.Logger))
+	}
+}
+
+// Listen on the server's address, terminating TLS if it is configured
+func (kern *Kernel) httpListener(name string, conf *rpc.ServerConfig) (net.Listener, error) {
+	listener, err := process.ListenerFromAddress(conf.ListenAddress())
+	if err != nil {
+		return nil, err
+	}
+	err = kern.registerListener(name, listener)
+	if err != nil {
+		return nil, err
+	}
+	if conf.TLS == nil {
+		return listener, nil
+	}
+	tlsConfig, err := conf.TLS.Config()
+	if err != nil {
+		return nil, err
+	}
+	return tls.NewListener(listener, tlsConfig), nil
+}
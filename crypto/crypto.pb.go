@@ -169,6 +169,63 @@ func (m *Signature) GetSignature() []byte {
 func (*Signature) XXX_MessageName() string {
 	return "crypto.Signature"
 }
+
+// The threshold and members of a multi-signature account
+type MultiSig struct {
+	// The number of distinct member signatures required
+	Threshold uint32 `protobuf:"varint,1,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	// Members ordered by address
+	PublicKeys           []PublicKey `protobuf:"bytes,2,rep,name=PublicKeys,proto3" json:"PublicKeys"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MultiSig) Reset()      { *m = MultiSig{} }
+func (*MultiSig) ProtoMessage() {}
+func (*MultiSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_527278fb02d03321, []int{3}
+}
+func (m *MultiSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultiSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSig.Merge(m, src)
+}
+func (m *MultiSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSig proto.InternalMessageInfo
+
+func (m *MultiSig) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultiSig) GetPublicKeys() []PublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (*MultiSig) XXX_MessageName() string {
+	return "crypto.MultiSig"
+}
 func init() {
 	proto.RegisterType((*PublicKey)(nil), "crypto.PublicKey")
 	golang_proto.RegisterType((*PublicKey)(nil), "crypto.PublicKey")
@@ -176,31 +233,36 @@ func init() {
 	golang_proto.RegisterType((*PrivateKey)(nil), "crypto.PrivateKey")
 	proto.RegisterType((*Signature)(nil), "crypto.Signature")
 	golang_proto.RegisterType((*Signature)(nil), "crypto.Signature")
+	proto.RegisterType((*MultiSig)(nil), "crypto.MultiSig")
+	golang_proto.RegisterType((*MultiSig)(nil), "crypto.MultiSig")
 }
 
 func init() { proto.RegisterFile("crypto.proto", fileDescriptor_527278fb02d03321) }
 func init() { golang_proto.RegisterFile("crypto.proto", fileDescriptor_527278fb02d03321) }
 
 var fileDescriptor_527278fb02d03321 = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0x2e, 0xaa, 0x2c,
-	0x28, 0xc9, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x42, 0xfa, 0x20, 0x16, 0x44, 0x56, 0x69, 0x32, 0x23, 0x17, 0x67, 0x40, 0x69,
-	0x52, 0x4e, 0x66, 0xb2, 0x77, 0x6a, 0xa5, 0x90, 0x36, 0x17, 0xa7, 0x73, 0x69, 0x51, 0x59, 0x6a,
-	0x48, 0x65, 0x41, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xaf, 0x13, 0xef, 0xaf, 0x7b, 0xf2, 0x08,
-	0xc1, 0x20, 0x04, 0x53, 0x28, 0x18, 0x49, 0xa7, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x8f, 0x93, 0xe9,
-	0x89, 0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xeb, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25,
-	0xe7, 0xe7, 0xea, 0x67, 0x54, 0x16, 0xa4, 0x16, 0xe5, 0xa4, 0xa6, 0xa4, 0xa7, 0x16, 0xe9, 0x27,
-	0x95, 0x16, 0x15, 0xe5, 0x97, 0xeb, 0x27, 0x65, 0xe6, 0x25, 0x16, 0x55, 0xea, 0x79, 0xa4, 0x56,
-	0x38, 0x55, 0x96, 0xa4, 0x16, 0x07, 0x21, 0xcc, 0xb1, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0x41, 0xa9,
-	0x91, 0x91, 0x8b, 0x2b, 0xa0, 0x28, 0xb3, 0x2c, 0xb1, 0x24, 0x95, 0x64, 0x67, 0xc9, 0x60, 0x38,
-	0x0b, 0xc9, 0x7c, 0x21, 0x39, 0x64, 0x83, 0x25, 0x98, 0xc1, 0xd2, 0x48, 0x22, 0x56, 0x1c, 0x1d,
-	0x0b, 0xe4, 0x19, 0xc0, 0x6e, 0x88, 0xe1, 0xe2, 0x0c, 0xce, 0x4c, 0xcf, 0x4b, 0x2c, 0x29, 0x2d,
-	0x4a, 0x25, 0xd9, 0x05, 0x70, 0x9d, 0x30, 0x17, 0xc0, 0x05, 0x20, 0x3e, 0x74, 0xb2, 0x3b, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x1b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x3c,
-	0xf0, 0x58, 0x8e, 0xf1, 0xc4, 0x63, 0x39, 0xc6, 0x28, 0x15, 0xfc, 0x61, 0x07, 0x89, 0xcd, 0x24,
-	0x36, 0x70, 0xf4, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x98, 0xef, 0xb8, 0x3a, 0xec, 0x01,
-	0x00, 0x00,
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x7b, 0x6d, 0x29, 0xed, 0xd9, 0x0e, 0x06, 0x87, 0x20, 0x72, 0x29, 0xc5, 0xa1, 0x20,
+	0x26, 0xa0, 0x88, 0xd0, 0xc1, 0x21, 0x2e, 0x82, 0x08, 0x25, 0xed, 0x24, 0x2e, 0x4d, 0x7b, 0x5c,
+	0x0e, 0x62, 0x2f, 0x5c, 0xef, 0xaa, 0x37, 0xba, 0xb9, 0xbb, 0x38, 0xf6, 0xa3, 0x38, 0x66, 0x74,
+	0x14, 0x87, 0x20, 0xe9, 0xb7, 0x70, 0x92, 0x24, 0x35, 0x09, 0x08, 0x42, 0xb7, 0x77, 0xff, 0xf7,
+	0xde, 0xff, 0xfd, 0xee, 0xde, 0xc1, 0xf6, 0x94, 0xab, 0x40, 0x30, 0x33, 0xe0, 0x4c, 0x30, 0xad,
+	0x91, 0x9d, 0xf6, 0xf7, 0x08, 0x23, 0x2c, 0x95, 0xac, 0x24, 0xca, 0xb2, 0xbd, 0x17, 0x00, 0x5b,
+	0x43, 0xe9, 0xfa, 0x74, 0x7a, 0x8d, 0x95, 0x76, 0x04, 0x5b, 0x97, 0x92, 0x2f, 0xf1, 0x58, 0x05,
+	0x58, 0x07, 0x5d, 0xd0, 0xef, 0xd8, 0x9d, 0xef, 0xc8, 0x28, 0x44, 0xa7, 0x08, 0xb5, 0x51, 0xa9,
+	0x53, 0xaf, 0x76, 0x41, 0xbf, 0x6d, 0x9f, 0x85, 0x91, 0x51, 0xf9, 0x8c, 0x8c, 0x63, 0x42, 0x85,
+	0x27, 0x5d, 0x73, 0xca, 0xee, 0x2d, 0x4f, 0x05, 0x98, 0xfb, 0x78, 0x46, 0x30, 0xb7, 0x5c, 0xc9,
+	0x39, 0x7b, 0xb0, 0x5c, 0x3a, 0x9f, 0x70, 0x65, 0x5e, 0xe1, 0x47, 0x5b, 0x09, 0xbc, 0x70, 0x0a,
+	0x9f, 0x41, 0xfd, 0x75, 0x65, 0x54, 0x7a, 0x4f, 0x00, 0xc2, 0x21, 0xa7, 0xcb, 0x89, 0xc0, 0x5b,
+	0x63, 0x1d, 0xfc, 0xc1, 0x2a, 0xf9, 0x6b, 0xa8, 0x6c, 0xac, 0xd7, 0xd2, 0x74, 0x49, 0x19, 0x34,
+	0x9f, 0x57, 0x46, 0x25, 0x65, 0xb8, 0x83, 0xad, 0x11, 0x25, 0xf3, 0x89, 0x90, 0x1c, 0x6f, 0x4d,
+	0x90, 0x77, 0xfe, 0x12, 0xe4, 0xc2, 0xe6, 0x86, 0x04, 0x36, 0x6f, 0xa4, 0x2f, 0xe8, 0x88, 0x92,
+	0xa4, 0x7e, 0xec, 0x71, 0xbc, 0xf0, 0x98, 0x3f, 0xcb, 0xcc, 0x9d, 0x42, 0xd0, 0xce, 0x21, 0xcc,
+	0xf1, 0x17, 0x7a, 0xb5, 0x5b, 0xeb, 0xef, 0x9c, 0xec, 0x9a, 0x9b, 0x15, 0xe7, 0x19, 0xbb, 0x9e,
+	0x3c, 0xbd, 0x53, 0x2a, 0xcd, 0x06, 0xd9, 0x17, 0x61, 0x8c, 0xc0, 0x7b, 0x8c, 0xc0, 0x47, 0x8c,
+	0xc0, 0x57, 0x8c, 0xc0, 0xdb, 0x1a, 0x81, 0x70, 0x8d, 0xc0, 0xed, 0xe1, 0xff, 0x4b, 0xca, 0x26,
+	0xb8, 0x8d, 0xf4, 0x9f, 0x9c, 0xfe, 0x0c, 0x00, 0x96, 0xc2, 0x22, 0x39, 0x55, 0x02, 0x00, 0x00,
 }
 
 func (m *PublicKey) Marshal() (dAtA []byte, err error) {
@@ -330,6 +392,52 @@ func (m *Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrypto(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintCrypto(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCrypto(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCrypto(v)
 	base := offset
@@ -400,6 +508,27 @@ func (m *Signature) Size() (n int) {
 	return n
 }
 
+func (m *MultiSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovCrypto(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovCrypto(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCrypto(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -751,6 +880,110 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrypto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrypto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, PublicKey{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrypto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrypto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCrypto(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
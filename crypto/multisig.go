@@ -0,0 +1,99 @@
+package crypto
+
+import (
+	bin "encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Domain separator so a multi-signature address cannot collide with other hashes of the same public keys
+var multiSigAddressPrefix = []byte("MultiSig")
+
+// Returns a MultiSig requiring threshold of the publicKeys to sign, with members in canonical (address) order
+func NewMultiSig(threshold uint32, publicKeys ...PublicKey) (*MultiSig, error) {
+	ms := &MultiSig{
+		Threshold:  threshold,
+		PublicKeys: make([]PublicKey, len(publicKeys)),
+	}
+	copy(ms.PublicKeys, publicKeys)
+	sort.Slice(ms.PublicKeys, func(i, j int) bool {
+		return ms.PublicKeys[i].GetAddress().String() < ms.PublicKeys[j].GetAddress().String()
+	})
+	err := ms.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// The address of a multi-signature account created with this threshold and membership. Once created the address of
+// the account does not change when its membership does.
+func (ms *MultiSig) Address() (address Address) {
+	bs := make([]byte, 0, len(multiSigAddressPrefix)+4+len(ms.PublicKeys)*PublicKeyFixedWidthEncodingLength)
+	bs = append(bs, multiSigAddressPrefix...)
+	threshold := make([]byte, 4)
+	bin.BigEndian.PutUint32(threshold, ms.Threshold)
+	bs = append(bs, threshold...)
+	for _, pk := range ms.PublicKeys {
+		bs = append(bs, pk.EncodeFixedWidth()...)
+	}
+	copy(address[:], SHA256(bs))
+	return
+}
+
+func (ms *MultiSig) Validate() error {
+	if ms.Threshold == 0 || int(ms.Threshold) > len(ms.PublicKeys) {
+		return fmt.Errorf("multi-signature threshold %d must be between 1 and the number of members %d",
+			ms.Threshold, len(ms.PublicKeys))
+	}
+	var previous string
+	for i, pk := range ms.PublicKeys {
+		if !pk.IsValid() {
+			return fmt.Errorf("multi-signature member %d has invalid public key %v", i, pk)
+		}
+		address := pk.GetAddress().String()
+		if i > 0 && address <= previous {
+			return fmt.Errorf("multi-signature members must be distinct and ordered by address but %s follows %s",
+				address, previous)
+		}
+		previous = address
+	}
+	return nil
+}
+
+// Returns true if publicKey belongs to a member
+func (ms *MultiSig) IsMember(publicKey PublicKey) bool {
+	for _, pk := range ms.PublicKeys {
+		if pk.Equal(publicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ms *MultiSig) Equal(other *MultiSig) bool {
+	if ms == nil || other == nil {
+		return ms == other
+	}
+	if ms.Threshold != other.Threshold || len(ms.PublicKeys) != len(other.PublicKeys) {
+		return false
+	}
+	for _, pk := range other.PublicKeys {
+		if !ms.IsMember(pk) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ms *MultiSig) String() string {
+	if ms == nil {
+		return "MultiSig{<nil>}"
+	}
+	members := make([]string, len(ms.PublicKeys))
+	for i, pk := range ms.PublicKeys {
+		members[i] = pk.GetAddress().String()
+	}
+	return fmt.Sprintf("MultiSig{%d of [%s]}", ms.Threshold, strings.Join(members, ", "))
+}
@@ -0,0 +1,36 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSig(t *testing.T) {
+	a := PrivateKeyFromSecret("a", CurveTypeEd25519).GetPublicKey()
+	b := PrivateKeyFromSecret("b", CurveTypeSecp256k1).GetPublicKey()
+	c := PrivateKeyFromSecret("c", CurveTypeEd25519).GetPublicKey()
+
+	ms, err := NewMultiSig(2, a, b, c)
+	require.NoError(t, err)
+	reordered, err := NewMultiSig(2, c, a, b)
+	require.NoError(t, err)
+	assert.Equal(t, ms.Address(), reordered.Address())
+	assert.True(t, ms.Equal(reordered))
+	assert.True(t, ms.IsMember(b))
+	assert.False(t, ms.IsMember(PrivateKeyFromSecret("d", CurveTypeEd25519).GetPublicKey()))
+
+	// The threshold is part of the address
+	other, err := NewMultiSig(3, a, b, c)
+	require.NoError(t, err)
+	assert.NotEqual(t, ms.Address(), other.Address())
+	assert.False(t, ms.Equal(other))
+
+	_, err = NewMultiSig(4, a, b, c)
+	assert.Error(t, err)
+	_, err = NewMultiSig(0, a, b, c)
+	assert.Error(t, err)
+	_, err = NewMultiSig(1, a, a)
+	assert.Error(t, err)
+}
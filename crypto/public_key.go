@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -37,6 +38,10 @@ func (p PublicKey) IsSet() bool {
 	return p.CurveType != CurveTypeUnset && p.IsValid()
 }
 
+func (p PublicKey) Equal(other PublicKey) bool {
+	return p.CurveType == other.CurveType && bytes.Equal(p.PublicKey, other.PublicKey)
+}
+
 func (p PublicKey) MarshalJSON() ([]byte, error) {
 	jStruct := PublicKeyJSON{
 		CurveType: p.CurveType.String(),
@@ -247,7 +247,7 @@ func (c *Client) SignTx(tx payload.Payload, logger *logging.Logger) (*txs.Envelo
 	inputs := tx.GetInputs()
 	signers := make([]acm.AddressableSigner, len(inputs))
 	for i, input := range inputs {
-		signers[i], err = keys.AddressableSigner(c.keyClient, input.Address)
+		signers[i], err = c.accountSigner(input.Address)
 		if err != nil {
 			return nil, err
 		}
@@ -259,6 +259,19 @@ func (c *Client) SignTx(tx payload.Payload, logger *logging.Logger) (*txs.Envelo
 	return txEnv, nil
 }
 
+// Returns a signer using the current key of the account at address, which is the key the address is derived from
+// unless it has been rotated
+func (c *Client) accountSigner(address crypto.Address) (acm.AddressableSigner, error) {
+	acc, err := c.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if acc.PublicKey.IsSet() && acc.PublicKey.GetAddress() != address {
+		return keys.AccountSigner(c.keyClient, address, acc.PublicKey), nil
+	}
+	return keys.AddressableSigner(c.keyClient, address)
+}
+
 // Creates a keypair using attached keys service
 func (c *Client) CreateKey(keyName, curveTypeString string, logger *logging.Logger) (crypto.PublicKey, error) {
 	err := c.dial(logger)
@@ -544,9 +557,10 @@ func (c *Client) Send(arg *SendArg, logger *logging.Logger) (*payload.SendTx, er
 }
 
 type BondArg struct {
-	Input    string
-	Amount   string
-	Sequence string
+	Input     string
+	Amount    string
+	Validator string
+	Sequence  string
 }
 
 func (c *Client) Bond(arg *BondArg, logger *logging.Logger) (*payload.BondTx, error) {
@@ -559,15 +573,24 @@ func (c *Client) Bond(arg *BondArg, logger *logging.Logger) (*payload.BondTx, er
 	if err != nil {
 		return nil, err
 	}
-	return &payload.BondTx{
+	tx := &payload.BondTx{
 		Input: input,
-	}, nil
+	}
+	if arg.Validator != "" {
+		validator, err := c.ParseAddress(arg.Validator, logger)
+		if err != nil {
+			return nil, err
+		}
+		tx.Validator = &validator
+	}
+	return tx, nil
 }
 
 type UnbondArg struct {
-	Output   string
-	Amount   string
-	Sequence string
+	Output    string
+	Amount    string
+	Validator string
+	Sequence  string
 }
 
 func (c *Client) Unbond(arg *UnbondArg, logger *logging.Logger) (*payload.UnbondTx, error) {
@@ -582,10 +605,149 @@ func (c *Client) Unbond(arg *UnbondArg, logger *logging.Logger) (*payload.Unbond
 
 	tx := payload.NewUnbondTx(input.Address, input.Amount)
 	tx.Input = input
+	if arg.Validator != "" {
+		validator, err := c.ParseAddress(arg.Validator, logger)
+		if err != nil {
+			return nil, err
+		}
+		tx.Validator = &validator
+	}
 
 	return tx, nil
 }
 
+type UnjailArg struct {
+	Input    string
+	Sequence string
+}
+
+func (c *Client) Unjail(arg *UnjailArg, logger *logging.Logger) (*payload.UnjailTx, error) {
+	logger.InfoMsg("UnjailTx", "account", arg)
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	input, err := c.TxInput(arg.Input, "", arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &payload.UnjailTx{
+		Input: input,
+	}, nil
+}
+
+type RotateKeyArg struct {
+	Input    string
+	Sequence string
+	// Hex-encoded new public key
+	PublicKey string
+}
+
+func (c *Client) RotateKey(arg *RotateKeyArg, logger *logging.Logger) (*payload.RotateKeyTx, error) {
+	logger.InfoMsg("RotateKeyTx", "account", arg)
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	publicKey, err := PublicKeyFromString(arg.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse public key: %v", err)
+	}
+	input, err := c.TxInput(arg.Input, "", arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &payload.RotateKeyTx{
+		Input:     input,
+		PublicKey: &publicKey,
+	}, nil
+}
+
+type MultiSigArg struct {
+	Input     string
+	Sequence  string
+	Threshold string
+	// Hex-encoded public keys of the members
+	Members []string
+}
+
+func (c *Client) MultiSig(arg *MultiSigArg, logger *logging.Logger) (*payload.MultiSigTx, error) {
+	logger.InfoMsg("MultiSigTx", "account", arg)
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	multiSig, err := ParseMultiSig(arg.Threshold, arg.Members)
+	if err != nil {
+		return nil, err
+	}
+	// The input is signed by the members rather than a key known to burrow keys so mempool signing is not possible
+	input, err := c.TxInput(arg.Input, "", arg.Sequence, false, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &payload.MultiSigTx{
+		Input:    input,
+		MultiSig: multiSig,
+	}, nil
+}
+
+// Parse a threshold and hex-encoded member public keys
+func ParseMultiSig(threshold string, members []string) (*crypto.MultiSig, error) {
+	m, err := strconv.ParseUint(threshold, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse multi-signature threshold '%s': %v", threshold, err)
+	}
+	publicKeys := make([]crypto.PublicKey, len(members))
+	for i, member := range members {
+		publicKeys[i], err = PublicKeyFromString(member)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return crypto.NewMultiSig(uint32(m), publicKeys...)
+}
+
+// Returns the current members of the multi-signature account at address
+func (c *Client) GetMultiSig(address crypto.Address, logger *logging.Logger) (*crypto.MultiSig, error) {
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	acc, err := c.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil || acc.MultiSig == nil {
+		return nil, fmt.Errorf("account %v has not been used as a multi-signature account so its members must "+
+			"be provided", address)
+	}
+	return acc.MultiSig, nil
+}
+
+// Enclose tx for this chain without signing it
+func (c *Client) Enclose(tx payload.Payload, logger *logging.Logger) (*txs.Envelope, error) {
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	return txs.Enclose(c.chainID, tx), nil
+}
+
+// Adds the signatures of the signers, whose keys must be available to burrow keys, to the input from the
+// multi-signature account at address. Members can each sign in turn or separately and have their envelopes merged.
+func (c *Client) SignMultiSig(txEnv *txs.Envelope, address crypto.Address, multiSig *crypto.MultiSig,
+	signers []crypto.Address, logger *logging.Logger) error {
+	if err := c.dial(logger); err != nil {
+		return err
+	}
+	members := make([]acm.AddressableSigner, len(signers))
+	for i, signer := range signers {
+		member, err := keys.AddressableSigner(c.keyClient, signer)
+		if err != nil {
+			return err
+		}
+		members[i] = member
+	}
+	logger.InfoMsg("Signing as multi-signature members", "address", address, "members", signers)
+	return txEnv.SignMultiSig(address, multiSig, members...)
+}
+
 type NameArg struct {
 	Input    string
 	Amount   string
@@ -43,6 +43,8 @@ type Job struct {
 	Bond *Bond `mapstructure:"bond,omitempty" json:"bond,omitempty" yaml:"bond,omitempty" toml:"bond"`
 	// Unbond tokens from an account
 	Unbond *Unbond `mapstructure:"unbond,omitempty" json:"unbond,omitempty" yaml:"unbond,omitempty" toml:"unbond"`
+	// Restore the power of a validator jailed for downtime
+	Unjail *Unjail `mapstructure:"unjail,omitempty" json:"unjail,omitempty" yaml:"unjail,omitempty" toml:"unjail"`
 	// Utilize monax:db's native name registry to register a name
 	RegisterName *RegisterName `mapstructure:"register,omitempty" json:"register,omitempty" yaml:"register,omitempty" toml:"register"`
 	// Validator identify as node key
@@ -182,6 +182,8 @@ type Bond struct {
 	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
 	// (Required) the Tendermint validator power to claim
 	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional) address of a bonded validator to which to delegate the power rather than claiming it for source
+	Validator string `mapstructure:"validator" json:"validator" yaml:"validator" toml:"validator"`
 	// (Optional, advanced only) sequence to use when burrow keys signs the transaction
 	// (do not use unless you know what you're doing)
 	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
@@ -200,6 +202,8 @@ type Unbond struct {
 	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
 	// (Required) the Tendermint validator power to unclaim
 	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional) address of the validator from which to withdraw power delegated by source
+	Validator string `mapstructure:"validator" json:"validator" yaml:"validator" toml:"validator"`
 	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
 	// know what you're doing)
 	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
@@ -212,6 +216,21 @@ func (job *Unbond) Validate() error {
 	)
 }
 
+type Unjail struct {
+	// (Optional, if account job or global account set) address of the jailed validator (the public key for the
+	// validator must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
+	// know what you're doing)
+	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+}
+
+func (job *Unjail) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+	)
+}
+
 type RegisterName struct {
 	// (Optional, if account job or global account set) address of the account from which to send (the
 	// public key for the account must be available to burrow keys)
@@ -196,6 +196,16 @@ func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, lo
 			if err != nil {
 				return err
 			}
+		case *def.Unjail:
+			announce(job.Name, "Unjail", logger)
+			tx, err := FormulateUnjailJob(job.Unjail, playbook.Account, client, logger)
+			if err != nil {
+				return err
+			}
+			job.Result, err = UnjailJob(tx, client, logger)
+			if err != nil {
+				return err
+			}
 		case *def.RegisterName:
 			announce(job.Name, "RegisterName", logger)
 			txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
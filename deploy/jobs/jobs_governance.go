@@ -76,12 +76,14 @@ func FormulateBondJob(bond *def.Bond, account string, client *def.Client, logger
 	// Formulate tx
 	logger.InfoMsg("Bonding Transaction",
 		"source", bond.Source,
-		"amount", bond.Amount)
+		"amount", bond.Amount,
+		"validator", bond.Validator)
 
 	arg := &def.BondArg{
-		Input:    bond.Source,
-		Amount:   bond.Amount,
-		Sequence: bond.Sequence,
+		Input:     bond.Source,
+		Amount:    bond.Amount,
+		Validator: bond.Validator,
+		Sequence:  bond.Sequence,
 	}
 
 	return client.Bond(arg, logger)
@@ -108,12 +110,14 @@ func FormulateUnbondJob(unbond *def.Unbond, account string, client *def.Client,
 
 	// Formulate tx
 	logger.InfoMsg("Unbonding Transaction",
-		"source", unbond.Source)
+		"source", unbond.Source,
+		"validator", unbond.Validator)
 
 	arg := &def.UnbondArg{
-		Output:   unbond.Source,
-		Amount:   unbond.Amount,
-		Sequence: unbond.Sequence,
+		Output:    unbond.Source,
+		Amount:    unbond.Amount,
+		Validator: unbond.Validator,
+		Sequence:  unbond.Sequence,
 	}
 
 	return client.Unbond(arg, logger)
@@ -133,3 +137,34 @@ func UnbondJob(tx *payload.UnbondTx, client *def.Client, logger *logging.Logger)
 
 	return txe.Receipt.TxHash.String(), nil
 }
+
+func FormulateUnjailJob(unjail *def.Unjail, account string, client *def.Client, logger *logging.Logger) (*payload.UnjailTx, error) {
+	// Use Default
+	unjail.Source = FirstOf(unjail.Source, account)
+
+	// Formulate tx
+	logger.InfoMsg("Unjail Transaction",
+		"source", unjail.Source)
+
+	arg := &def.UnjailArg{
+		Input:    unjail.Source,
+		Sequence: unjail.Sequence,
+	}
+
+	return client.Unjail(arg, logger)
+}
+
+func UnjailJob(tx *payload.UnjailTx, client *def.Client, logger *logging.Logger) (string, error) {
+	// Sign, broadcast, display
+	txe, err := client.SignAndBroadcast(tx, logger)
+	if err != nil {
+		return "", fmt.Errorf("error in UnjailJob with payload %v: %w", tx, err)
+	}
+
+	LogTxExecution(txe, logger)
+	if err != nil {
+		return "", err
+	}
+
+	return txe.Receipt.TxHash.String(), nil
+}
@@ -73,6 +73,8 @@ func ListProposals(args *def.DeployArgs, reqState ProposalState, logger *logging
 			state = "FAILED"
 		case payload.Ballot_EXECUTED:
 			state = "EXECUTED"
+		case payload.Ballot_EXPIRED:
+			state = "EXPIRED"
 		case payload.Ballot_PROPOSED:
 			if ProposalExpired(prop.Ballot.Proposal, client, logger) != nil {
 				state = "EXPIRED"
@@ -0,0 +1,302 @@
+package dump
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	bin "encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/burrow/encoding"
+)
+
+// An archive is laid out as:
+//
+//   magic | chunk... | manifest | manifest length (8 bytes) | manifest SHA-256 (32 bytes) | magic
+//
+// where each chunk is a gzip-compressed run of length-prefixed Dump rows described (along with its SHA-256) by the
+// manifest. Keeping the manifest at the end allows archives to be written in a single pass to a stream.
+
+const ArchiveVersion = 1
+
+// Start compressing a new chunk once this many bytes of rows have been written to the current one
+const thresholdArchiveChunkBytes = 1 << 24
+
+var archiveMagic = []byte("BRWDUMP\x01")
+
+const archiveTrailerLength = 8 + sha256.Size
+
+type ArchiveWriter struct {
+	out      io.Writer
+	manifest *Manifest
+	// Offset of the next byte to be written to out
+	offset uint64
+	// The current chunk
+	chunk      *bytes.Buffer
+	compressor *gzip.Writer
+	rows       uint64
+	rowBytes   int
+	chunkBytes int
+}
+
+var _ Sink = &ArchiveWriter{}
+
+// NewArchiveWriter returns a Sink that writes rows to out as an archive described by manifest, whose Chunks are
+// appended to as rows are written. Close must be called to write the manifest once all rows are sent.
+func NewArchiveWriter(out io.Writer, manifest *Manifest) (*ArchiveWriter, error) {
+	_, err := out.Write(archiveMagic)
+	if err != nil {
+		return nil, fmt.Errorf("could not write dump archive header: %v", err)
+	}
+	chunk := new(bytes.Buffer)
+	return &ArchiveWriter{
+		out:        out,
+		manifest:   manifest,
+		offset:     uint64(len(archiveMagic)),
+		chunk:      chunk,
+		compressor: gzip.NewWriter(chunk),
+		chunkBytes: thresholdArchiveChunkBytes,
+	}, nil
+}
+
+func (aw *ArchiveWriter) Send(row *Dump) error {
+	n, err := encoding.WriteMessage(aw.compressor, row)
+	if err != nil {
+		return fmt.Errorf("could not write row to dump archive: %v", err)
+	}
+	aw.rows++
+	aw.rowBytes += n
+	if aw.rowBytes >= aw.chunkBytes {
+		return aw.flushChunk()
+	}
+	return nil
+}
+
+// Close writes any pending rows and the manifest
+func (aw *ArchiveWriter) Close() error {
+	if aw.rows > 0 {
+		err := aw.flushChunk()
+		if err != nil {
+			return err
+		}
+	}
+	bs, err := encoding.Encode(aw.manifest)
+	if err != nil {
+		return fmt.Errorf("could not encode dump manifest: %v", err)
+	}
+	trailer := make([]byte, archiveTrailerLength, archiveTrailerLength+len(archiveMagic))
+	bin.BigEndian.PutUint64(trailer, uint64(len(bs)))
+	hash := sha256.Sum256(bs)
+	copy(trailer[8:], hash[:])
+	trailer = append(trailer, archiveMagic...)
+	_, err = aw.out.Write(append(bs, trailer...))
+	if err != nil {
+		return fmt.Errorf("could not write dump manifest: %v", err)
+	}
+	return nil
+}
+
+func (aw *ArchiveWriter) flushChunk() error {
+	err := aw.compressor.Close()
+	if err != nil {
+		return fmt.Errorf("could not compress dump archive chunk: %v", err)
+	}
+	hash := sha256.Sum256(aw.chunk.Bytes())
+	n, err := aw.out.Write(aw.chunk.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not write dump archive chunk: %v", err)
+	}
+	aw.manifest.Chunks = append(aw.manifest.Chunks, &Chunk{
+		Offset: aw.offset,
+		Length: uint64(n),
+		Rows:   aw.rows,
+		SHA256: hash[:],
+	})
+	aw.offset += uint64(n)
+	aw.chunk.Reset()
+	aw.compressor.Reset(aw.chunk)
+	aw.rows = 0
+	aw.rowBytes = 0
+	return nil
+}
+
+// Write a dump archive described by manifest to out by pulling rows from source
+func WriteArchive(out io.Writer, source Source, manifest *Manifest) error {
+	aw, err := NewArchiveWriter(out, manifest)
+	if err != nil {
+		return err
+	}
+	for {
+		row, err := source.Recv()
+		if err == io.EOF {
+			return aw.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to recv dump: %v", err)
+		}
+		err = aw.Send(row)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+type ArchiveReader struct {
+	reader   io.ReadSeeker
+	manifest *Manifest
+	// Index of the next chunk to read
+	next int
+	// Rows remaining in the current chunk
+	rows  uint64
+	chunk io.Reader
+}
+
+var _ Source = &ArchiveReader{}
+
+// NewArchiveReader reads the manifest of the archive in reader, checking it against its SHA-256 and that its chunks
+// span the archive. Chunks are checked against their SHA-256 as they are read, call Verify to check them all up front.
+func NewArchiveReader(reader io.ReadSeeker) (*ArchiveReader, error) {
+	size, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	footer := int64(archiveTrailerLength + len(archiveMagic))
+	if size < int64(len(archiveMagic))+footer {
+		return nil, fmt.Errorf("dump archive is truncated")
+	}
+	header, err := readAt(reader, 0, len(archiveMagic))
+	if err != nil {
+		return nil, err
+	}
+	trailer, err := readAt(reader, size-footer, int(footer))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header, archiveMagic) || !bytes.Equal(trailer[archiveTrailerLength:], archiveMagic) {
+		return nil, fmt.Errorf("not a dump archive or archive is truncated")
+	}
+	manifestLength := bin.BigEndian.Uint64(trailer)
+	manifestOffset := size - footer - int64(manifestLength)
+	if manifestOffset < int64(len(archiveMagic)) {
+		return nil, fmt.Errorf("dump archive manifest length %d is larger than archive", manifestLength)
+	}
+	bs, err := readAt(reader, manifestOffset, int(manifestLength))
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bs)
+	if !bytes.Equal(hash[:], trailer[8:archiveTrailerLength]) {
+		return nil, fmt.Errorf("dump archive manifest does not match its SHA-256 %X", trailer[8:archiveTrailerLength])
+	}
+	manifest := new(Manifest)
+	err = encoding.Decode(bs, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode dump archive manifest: %v", err)
+	}
+	if manifest.Version != ArchiveVersion {
+		return nil, fmt.Errorf("dump archive version %d is not supported (expected %d)", manifest.Version,
+			ArchiveVersion)
+	}
+	offset := uint64(len(archiveMagic))
+	for i, chunk := range manifest.Chunks {
+		if chunk.Offset != offset {
+			return nil, fmt.Errorf("dump archive chunk %d starts at %d but should start at %d", i, chunk.Offset, offset)
+		}
+		offset += chunk.Length
+	}
+	if offset != uint64(manifestOffset) {
+		return nil, fmt.Errorf("dump archive chunks end at %d but manifest starts at %d", offset, manifestOffset)
+	}
+	return &ArchiveReader{
+		reader:   reader,
+		manifest: manifest,
+	}, nil
+}
+
+func (ar *ArchiveReader) Manifest() *Manifest {
+	return ar.manifest
+}
+
+// Verify checks every chunk of the archive against its SHA-256
+func (ar *ArchiveReader) Verify() error {
+	for i := range ar.manifest.Chunks {
+		_, err := ar.readChunk(i)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ar *ArchiveReader) Recv() (*Dump, error) {
+	for ar.rows == 0 {
+		if ar.chunk != nil {
+			// Any trailing bytes would be rows that the manifest does not account for
+			n, err := ar.chunk.Read(make([]byte, 1))
+			if n > 0 || err != io.EOF {
+				return nil, fmt.Errorf("dump archive chunk %d has more rows than its manifest entry", ar.next-1)
+			}
+			ar.chunk = nil
+		}
+		if ar.next >= len(ar.manifest.Chunks) {
+			return nil, io.EOF
+		}
+		bs, err := ar.readChunk(ar.next)
+		if err != nil {
+			return nil, err
+		}
+		ar.chunk, err = gzip.NewReader(bytes.NewReader(bs))
+		if err != nil {
+			return nil, fmt.Errorf("could not decompress dump archive chunk %d: %v", ar.next, err)
+		}
+		ar.rows = ar.manifest.Chunks[ar.next].Rows
+		ar.next++
+	}
+	row := new(Dump)
+	_, err := encoding.ReadMessage(ar.chunk, row)
+	if err != nil {
+		return nil, fmt.Errorf("could not read row from dump archive chunk %d: %v", ar.next-1, err)
+	}
+	ar.rows--
+	return row, nil
+}
+
+func (ar *ArchiveReader) readChunk(i int) ([]byte, error) {
+	chunk := ar.manifest.Chunks[i]
+	bs, err := readAt(ar.reader, int64(chunk.Offset), int(chunk.Length))
+	if err != nil {
+		return nil, fmt.Errorf("could not read dump archive chunk %d: %v", i, err)
+	}
+	hash := sha256.Sum256(bs)
+	if !bytes.Equal(hash[:], chunk.SHA256) {
+		return nil, fmt.Errorf("dump archive chunk %d does not match its SHA-256 %v", i, chunk.SHA256)
+	}
+	return bs, nil
+}
+
+func readAt(reader io.ReadSeeker, offset int64, length int) ([]byte, error) {
+	_, err := reader.Seek(offset, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	bs := make([]byte, length)
+	_, err = io.ReadFull(reader, bs)
+	if err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+func isArchive(reader io.ReadSeeker) (bool, error) {
+	defer reader.Seek(0, io.SeekStart)
+	header := make([]byte, len(archiveMagic))
+	_, err := io.ReadFull(reader, header)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(header, archiveMagic), nil
+}
@@ -0,0 +1,99 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	mockSource := NewMockSource(50, 50, 100, 100)
+	st := testLoad(t, mockSource)
+	dumper := NewDumper(st, mockSource)
+	manifest, err := dumper.Manifest(0, 0, All, nil)
+	require.NoError(t, err)
+	assert.Equal(t, st.Hash(), manifest.AppHash.Bytes())
+	assert.Equal(t, mockSource.ChainID(), manifest.ChainID)
+
+	buf := new(bytes.Buffer)
+	aw, err := NewArchiveWriter(buf, manifest)
+	require.NoError(t, err)
+	// Force several chunks
+	aw.chunkBytes = 1 << 14
+	err = dumper.Transmit(aw, 0, 0, All, nil)
+	require.NoError(t, err)
+	require.NoError(t, aw.Close())
+	require.True(t, len(manifest.Chunks) > 1, "expected more than one chunk")
+
+	ar, err := NewArchiveReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.NoError(t, ar.Verify())
+	assert.Equal(t, manifest, ar.Manifest())
+
+	jsonBuf := new(bytes.Buffer)
+	err = Write(jsonBuf, dumper.Source(0, 0, All, nil), false, All)
+	require.NoError(t, err)
+	assert.Equal(t, jsonBuf.String(), archiveToJSONString(t, ar))
+
+	t.Run("CorruptChunk", func(t *testing.T) {
+		bs := append([]byte(nil), buf.Bytes()...)
+		bs[manifest.Chunks[1].Offset+10] ^= 0xff
+		ar, err := NewArchiveReader(bytes.NewReader(bs))
+		require.NoError(t, err)
+		err = ar.Verify()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "chunk 1 does not match its SHA-256")
+	})
+
+	t.Run("CorruptManifest", func(t *testing.T) {
+		bs := append([]byte(nil), buf.Bytes()...)
+		bs[len(bs)-archiveTrailerLength-len(archiveMagic)-1] ^= 0xff
+		_, err := NewArchiveReader(bytes.NewReader(bs))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "manifest does not match its SHA-256")
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		_, err := NewArchiveReader(bytes.NewReader(buf.Bytes()[:buf.Len()/2]))
+		require.Error(t, err)
+	})
+
+	t.Run("NewFileReader", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "TestArchive")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		filename := path.Join(dir, "dump.archive")
+		require.NoError(t, ioutil.WriteFile(filename, buf.Bytes(), 0644))
+		src, err := NewFileReader(filename)
+		require.NoError(t, err)
+		require.IsType(t, &ArchiveReader{}, src)
+		assert.Equal(t, jsonBuf.String(), archiveToJSONString(t, src))
+
+		bs := append([]byte(nil), buf.Bytes()...)
+		bs[manifest.Chunks[0].Offset] ^= 0xff
+		require.NoError(t, ioutil.WriteFile(filename, bs, 0644))
+		_, err = NewFileReader(filename)
+		require.Error(t, err)
+	})
+}
+
+func archiveToJSONString(t *testing.T, src Source) string {
+	buf := new(bytes.Buffer)
+	for {
+		row, err := src.Recv()
+		if err == io.EOF {
+			return buf.String()
+		}
+		require.NoError(t, err)
+		bs, err := json.Marshal(row)
+		require.NoError(t, err)
+		buf.Write(append(bs, '\n'))
+	}
+}
@@ -63,21 +63,25 @@ func (options Option) Enabled(option Option) bool {
 }
 
 // Transmit Dump rows to the provided Sink over the inclusive range of heights provided, if endHeight is 0 the latest
-// height is used.
+// height is used. Only rows matching filter are sent, which may be nil to send everything.
 
-func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Option) error {
-	lastHeight := ds.blockchain.LastBlockHeight()
-	if endHeight == 0 || endHeight > lastHeight {
-		endHeight = lastHeight
-	}
+func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Option, filter *Filter) error {
+	endHeight = ds.resolveEndHeight(endHeight)
 	st, err := ds.state.LoadHeight(endHeight)
 	if err != nil {
 		return err
 	}
+	rf, err := newRowFilter(filter)
+	if err != nil {
+		return err
+	}
 
 	if options.Enabled(Accounts) {
 		ds.logger.InfoMsg("Dumping accounts")
-		err = st.IterateAccounts(func(acc *acm.Account) error {
+		err = iterateAccounts(st, filter, func(acc *acm.Account) error {
+			if !rf.matchesAccount(acc) {
+				return nil
+			}
 			// Since we tend to want to handle accounts and their storage as a single unit we multiplex account
 			// and storage within the same row. If the storage gets too large we chunk it and send in separate rows
 			// (so that we stay well below the 4MiB GRPC message size limit and generally maintain stream-ability)
@@ -152,6 +156,9 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 	if options.Enabled(Names) {
 		ds.logger.InfoMsg("Dumping names")
 		err = st.IterateNames(func(entry *names.Entry) error {
+			if !rf.matchesName(entry) {
+				return nil
+			}
 			return sink.Send(&Dump{Height: endHeight, Name: entry})
 		})
 		if err != nil {
@@ -174,6 +181,9 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 				case ev.BeginTx != nil:
 					origin = ev.BeginTx.TxHeader.Origin
 				case ev.Event != nil && ev.Event.Log != nil:
+					if !rf.matchesEvent(ev.Event.Log) {
+						return nil
+					}
 					row := &Dump{EVMEvent: &EVMEvent{Event: ev.Event.Log}}
 					if origin != nil {
 						// this event was already restored
@@ -205,11 +215,38 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 	return nil
 }
 
+// Manifest describes the dump Transmit would send for the same arguments, its Chunks are left for an ArchiveWriter to
+// fill in
+func (ds *Dumper) Manifest(startHeight, endHeight uint64, options Option, filter *Filter) (*Manifest, error) {
+	endHeight = ds.resolveEndHeight(endHeight)
+	appHash, err := ds.state.HashAtHeight(endHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state hash at height %d: %v", endHeight, err)
+	}
+	return &Manifest{
+		Version:     ArchiveVersion,
+		ChainID:     ds.blockchain.ChainID(),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Options:     options,
+		AppHash:     appHash,
+		Filter:      filter,
+	}, nil
+}
+
+func (ds *Dumper) resolveEndHeight(endHeight uint64) uint64 {
+	lastHeight := ds.blockchain.LastBlockHeight()
+	if endHeight == 0 || endHeight > lastHeight {
+		return lastHeight
+	}
+	return endHeight
+}
+
 // Return a Source that is a Pipe fed from this Dumper's Transmit function
-func (ds *Dumper) Source(startHeight, endHeight uint64, options Option) Source {
+func (ds *Dumper) Source(startHeight, endHeight uint64, options Option, filter *Filter) Source {
 	p := make(Pipe)
 	go func() {
-		err := ds.Transmit(p, startHeight, endHeight, options)
+		err := ds.Transmit(p, startHeight, endHeight, options, filter)
 		if err != nil {
 			p <- msg{err: err}
 		}
@@ -218,6 +255,27 @@ func (ds *Dumper) Source(startHeight, endHeight uint64, options Option) Source {
 	return p
 }
 
+// Iterates over the accounts named by filter if it names any, otherwise over all accounts
+func iterateAccounts(st *state.ReadState, filter *Filter, consumer func(*acm.Account) error) error {
+	if filter == nil || len(filter.Addresses) == 0 {
+		return st.IterateAccounts(consumer)
+	}
+	for _, address := range filter.Addresses {
+		acc, err := st.GetAccount(address)
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			continue
+		}
+		err = consumer(acc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ds *Dumper) WithLogger(logger *logging.Logger) *Dumper {
 	ds.logger = logger
 	return ds
@@ -240,7 +298,7 @@ func Write(out io.Writer, source Source, useBinaryEncoding bool, options Option)
 			if err != nil {
 				return fmt.Errorf("failed write to binary dump message: %v", err)
 			}
-			return nil
+			continue
 		}
 
 		bs, err := json.Marshal(resp)
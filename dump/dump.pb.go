@@ -277,6 +277,235 @@ func (m *Dump) GetName() *names.Entry {
 func (*Dump) XXX_MessageName() string {
 	return "dump.Dump"
 }
+
+// Restricts a dump to a subset of state, where a row must satisfy every condition given
+type Filter struct {
+	// Only dump accounts (and their storage) matching this query, e.g. "Balance > 0", see event/query
+	AccountQuery string `protobuf:"bytes,1,opt,name=AccountQuery,proto3" json:"AccountQuery,omitempty"`
+	// Only dump these accounts and their storage
+	Addresses []github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,rep,name=Addresses,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Addresses"`
+	// Only dump names matching this query, e.g. "Owner = '<address>'"
+	NameQuery string `protobuf:"bytes,3,opt,name=NameQuery,proto3" json:"NameQuery,omitempty"`
+	// Only dump events logged by these addresses
+	EventAddresses       []github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,rep,name=EventAddresses,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"EventAddresses"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *Filter) Reset()         { *m = Filter{} }
+func (m *Filter) String() string { return proto.CompactTextString(m) }
+func (*Filter) ProtoMessage()    {}
+func (*Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{4}
+}
+func (m *Filter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Filter.Merge(m, src)
+}
+func (m *Filter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Filter proto.InternalMessageInfo
+
+func (m *Filter) GetAccountQuery() string {
+	if m != nil {
+		return m.AccountQuery
+	}
+	return ""
+}
+
+func (m *Filter) GetNameQuery() string {
+	if m != nil {
+		return m.NameQuery
+	}
+	return ""
+}
+
+func (*Filter) XXX_MessageName() string {
+	return "dump.Filter"
+}
+
+// Describes the contents of a dump archive, see dump.ArchiveWriter
+type Manifest struct {
+	// Version of the archive format
+	Version uint32 `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	ChainID string `protobuf:"bytes,2,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	// Events are dumped from StartHeight to EndHeight inclusive, accounts and names as of EndHeight
+	StartHeight uint64 `protobuf:"varint,3,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,4,opt,name=EndHeight,proto3" json:"EndHeight,omitempty"`
+	Options     Option `protobuf:"varint,5,opt,name=Options,proto3,casttype=Option" json:"Options,omitempty"`
+	// The state hash of the dumped chain at EndHeight
+	AppHash              github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,6,opt,name=AppHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"AppHash"`
+	Chunks               []*Chunk                                      `protobuf:"bytes,7,rep,name=Chunks,proto3" json:"Chunks,omitempty"`
+	Filter               *Filter                                       `protobuf:"bytes,8,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *Manifest) Reset()         { *m = Manifest{} }
+func (m *Manifest) String() string { return proto.CompactTextString(m) }
+func (*Manifest) ProtoMessage()    {}
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{5}
+}
+func (m *Manifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Manifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Manifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Manifest.Merge(m, src)
+}
+func (m *Manifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *Manifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_Manifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Manifest proto.InternalMessageInfo
+
+func (m *Manifest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Manifest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Manifest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Manifest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Manifest) GetOptions() Option {
+	if m != nil {
+		return m.Options
+	}
+	return 0
+}
+
+func (m *Manifest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+func (m *Manifest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (*Manifest) XXX_MessageName() string {
+	return "dump.Manifest"
+}
+
+// A gzip-compressed run of length-prefixed Dump rows
+type Chunk struct {
+	// Position of the compressed chunk in the archive
+	Offset uint64 `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Length uint64 `protobuf:"varint,2,opt,name=Length,proto3" json:"Length,omitempty"`
+	Rows   uint64 `protobuf:"varint,3,opt,name=Rows,proto3" json:"Rows,omitempty"`
+	// Hash of the compressed chunk
+	SHA256               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=SHA256,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"SHA256"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *Chunk) Reset()         { *m = Chunk{} }
+func (m *Chunk) String() string { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()    {}
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58418148159c29a6, []int{6}
+}
+func (m *Chunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Chunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Chunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chunk.Merge(m, src)
+}
+func (m *Chunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *Chunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Chunk proto.InternalMessageInfo
+
+func (m *Chunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Chunk) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *Chunk) GetRows() uint64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (*Chunk) XXX_MessageName() string {
+	return "dump.Chunk"
+}
 func init() {
 	proto.RegisterType((*Storage)(nil), "dump.Storage")
 	golang_proto.RegisterType((*Storage)(nil), "dump.Storage")
@@ -286,44 +515,65 @@ func init() {
 	golang_proto.RegisterType((*EVMEvent)(nil), "dump.EVMEvent")
 	proto.RegisterType((*Dump)(nil), "dump.Dump")
 	golang_proto.RegisterType((*Dump)(nil), "dump.Dump")
+	proto.RegisterType((*Filter)(nil), "dump.Filter")
+	golang_proto.RegisterType((*Filter)(nil), "dump.Filter")
+	proto.RegisterType((*Manifest)(nil), "dump.Manifest")
+	golang_proto.RegisterType((*Manifest)(nil), "dump.Manifest")
+	proto.RegisterType((*Chunk)(nil), "dump.Chunk")
+	golang_proto.RegisterType((*Chunk)(nil), "dump.Chunk")
 }
 
 func init() { proto.RegisterFile("dump.proto", fileDescriptor_58418148159c29a6) }
 func init() { golang_proto.RegisterFile("dump.proto", fileDescriptor_58418148159c29a6) }
 
 var fileDescriptor_58418148159c29a6 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3d, 0x6f, 0x13, 0x41,
-	0x10, 0x65, 0xe3, 0x73, 0x9c, 0xac, 0x43, 0x8a, 0x95, 0x85, 0x4e, 0x2e, 0xce, 0x96, 0x85, 0xc0,
-	0x42, 0xb0, 0x27, 0x19, 0x82, 0x28, 0xd2, 0xc4, 0xc4, 0x28, 0x51, 0x20, 0xc5, 0x12, 0x05, 0x89,
-	0xee, 0x3e, 0x86, 0xf3, 0x49, 0xbe, 0xdb, 0xd3, 0xde, 0x1e, 0xf8, 0x7e, 0x02, 0x1d, 0x35, 0x05,
-	0xbf, 0x85, 0xd2, 0x25, 0xa2, 0x42, 0x14, 0x01, 0x39, 0x7f, 0x04, 0xdd, 0x7e, 0x10, 0x91, 0x02,
-	0x41, 0x37, 0x33, 0x4f, 0xf3, 0xe6, 0xed, 0x7b, 0x8b, 0x71, 0x5c, 0x65, 0x05, 0x2d, 0x04, 0x97,
-	0x9c, 0x38, 0x4d, 0xdd, 0xef, 0x25, 0x3c, 0xe1, 0x6a, 0xe0, 0x37, 0x95, 0xc6, 0xfa, 0x83, 0x84,
-	0xf3, 0x64, 0x01, 0xbe, 0xea, 0xc2, 0xea, 0x8d, 0x2f, 0xd3, 0x0c, 0x4a, 0x19, 0xd8, 0xe5, 0xfe,
-	0x76, 0x10, 0x65, 0xa6, 0xc4, 0xb0, 0x84, 0xc8, 0xd4, 0xdd, 0x3c, 0xc8, 0xa0, 0xd4, 0xcd, 0xe8,
-	0x13, 0xc2, 0x9d, 0x97, 0x92, 0x8b, 0x20, 0x01, 0xf2, 0x0c, 0xb7, 0x4e, 0xa0, 0x76, 0xd1, 0x10,
-	0x8d, 0x77, 0xa6, 0x8f, 0x56, 0x17, 0x83, 0x1b, 0xdf, 0x2f, 0x06, 0xf7, 0x93, 0x54, 0xce, 0xab,
-	0x90, 0x46, 0x3c, 0xf3, 0xe7, 0x75, 0x01, 0x62, 0x01, 0x71, 0x02, 0xc2, 0x0f, 0x2b, 0x21, 0xf8,
-	0x3b, 0x3f, 0x4c, 0xf3, 0x40, 0xd4, 0xf4, 0x15, 0x17, 0xf1, 0x64, 0xef, 0x31, 0x6b, 0x08, 0xc8,
-	0x09, 0x6e, 0x9f, 0x07, 0x8b, 0x0a, 0xdc, 0x0d, 0xc5, 0xb4, 0x67, 0x98, 0x1e, 0xfc, 0x13, 0xd3,
-	0x11, 0x2c, 0xa7, 0xb5, 0x84, 0x92, 0x69, 0x8e, 0xd1, 0x7b, 0x84, 0x77, 0x0f, 0xa2, 0x88, 0x57,
-	0xb9, 0xb4, 0x3a, 0x4f, 0x71, 0xe7, 0x20, 0x8e, 0x05, 0x94, 0xe5, 0xff, 0x69, 0x8d, 0x44, 0x5d,
-	0x48, 0x4e, 0xcd, 0x2e, 0xb3, 0x24, 0xe4, 0xee, 0x6f, 0x0b, 0xdc, 0x8d, 0x61, 0x6b, 0xdc, 0x9d,
-	0xdc, 0xa4, 0x2a, 0x02, 0x33, 0x64, 0x16, 0x1d, 0x7d, 0x44, 0x78, 0x6b, 0x76, 0xfe, 0x62, 0xf6,
-	0x16, 0x72, 0x49, 0x5c, 0xdc, 0x79, 0x3a, 0x0f, 0xd2, 0xfc, 0xf8, 0x50, 0xa9, 0xd8, 0x66, 0xb6,
-	0x25, 0x3d, 0xdc, 0x3e, 0xce, 0x63, 0x58, 0xba, 0xce, 0x10, 0x8d, 0x1d, 0xa6, 0x1b, 0xf2, 0x04,
-	0x3b, 0x67, 0x69, 0xa6, 0x4d, 0xe9, 0x4e, 0xfa, 0x54, 0xa7, 0x47, 0x6d, 0x7a, 0xf4, 0xcc, 0xa6,
-	0x37, 0xdd, 0x6a, 0x9e, 0xf3, 0xe1, 0xc7, 0x00, 0x31, 0xb5, 0x41, 0x6e, 0xe3, 0xb6, 0x3a, 0xe9,
-	0xb6, 0xd4, 0xea, 0x2e, 0x55, 0x61, 0x3e, 0xe7, 0x89, 0x9a, 0x32, 0x0d, 0x8e, 0xbe, 0x22, 0xec,
-	0x1c, 0x56, 0x59, 0x41, 0x6e, 0xe1, 0xcd, 0x23, 0x48, 0x93, 0xb9, 0x54, 0xba, 0x1c, 0x66, 0x3a,
-	0x72, 0x07, 0x77, 0x8c, 0x91, 0x46, 0xc3, 0x0e, 0x6d, 0x3e, 0x88, 0x99, 0x31, 0x0b, 0x92, 0xfd,
-	0xeb, 0x86, 0x9b, 0xbb, 0x3d, 0xed, 0xca, 0x9f, 0x18, 0xbb, 0x1e, 0xce, 0xbd, 0x2b, 0x8b, 0xd4,
-	0xfb, 0x1b, 0xbd, 0x6a, 0xcf, 0x4e, 0xd9, 0x95, 0x85, 0x43, 0xec, 0x9c, 0x06, 0x19, 0xb8, 0x6d,
-	0x23, 0x47, 0x7f, 0xcc, 0x59, 0x2e, 0x45, 0xcd, 0x14, 0x32, 0xdd, 0x5f, 0xad, 0x3d, 0xf4, 0x65,
-	0xed, 0xa1, 0x6f, 0x6b, 0x0f, 0xfd, 0x5c, 0x7b, 0xe8, 0xf3, 0xa5, 0x87, 0x56, 0x97, 0x1e, 0x7a,
-	0x3d, 0xfa, 0x7b, 0xd6, 0xcd, 0xc9, 0x70, 0x53, 0x99, 0xfb, 0xf0, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x6b, 0xbe, 0xfd, 0xc8, 0x52, 0x03, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xbe, 0x93, 0x38, 0x7f, 0x27, 0x6d, 0x17, 0xa3, 0xea, 0xca, 0x8a, 0xae, 0x92, 0xc8, 0xb7,
+	0xba, 0xb7, 0x42, 0xe0, 0x48, 0x81, 0x56, 0x2c, 0xba, 0x49, 0xda, 0x54, 0xa9, 0xfa, 0x27, 0xa6,
+	0x55, 0x91, 0x10, 0x1b, 0x27, 0x99, 0x38, 0x16, 0xb1, 0xc7, 0x1a, 0x8f, 0x69, 0xfd, 0x08, 0xec,
+	0x58, 0xb3, 0xe0, 0x59, 0xba, 0xec, 0x12, 0xb1, 0x42, 0x2c, 0x0a, 0x4a, 0xdf, 0x02, 0xb1, 0x40,
+	0x9e, 0x19, 0x37, 0x6d, 0x17, 0x08, 0xe8, 0x6e, 0xce, 0x77, 0x7c, 0xbe, 0xf3, 0xf7, 0x1d, 0x03,
+	0x8c, 0x62, 0x3f, 0xb4, 0x43, 0xce, 0x04, 0xc3, 0x46, 0xfa, 0xae, 0x2d, 0xbb, 0xcc, 0x65, 0x12,
+	0x68, 0xa5, 0x2f, 0xe5, 0xab, 0x35, 0x5c, 0xc6, 0xdc, 0x29, 0x6d, 0x49, 0x6b, 0x10, 0x8f, 0x5b,
+	0xc2, 0xf3, 0x69, 0x24, 0x9c, 0x2c, 0xb8, 0x56, 0x71, 0x86, 0xbe, 0x7e, 0x02, 0x3d, 0xa3, 0x43,
+	0xfd, 0xae, 0x06, 0x8e, 0x4f, 0x23, 0x65, 0x58, 0xef, 0x11, 0x94, 0x8e, 0x04, 0xe3, 0x8e, 0x4b,
+	0xf1, 0x36, 0xe4, 0x77, 0x69, 0x62, 0xa2, 0x26, 0x5a, 0x5d, 0xe8, 0x3e, 0xb9, 0xb8, 0x6c, 0xfc,
+	0xf5, 0xf9, 0xb2, 0xf1, 0xd0, 0xf5, 0xc4, 0x24, 0x1e, 0xd8, 0x43, 0xe6, 0xb7, 0x26, 0x49, 0x48,
+	0xf9, 0x94, 0x8e, 0x5c, 0xca, 0x5b, 0x83, 0x98, 0x73, 0x76, 0xda, 0x1a, 0x78, 0x81, 0xc3, 0x13,
+	0xfb, 0x39, 0xe3, 0xa3, 0xf6, 0xda, 0x3a, 0x49, 0x09, 0xf0, 0x2e, 0x14, 0x4e, 0x9c, 0x69, 0x4c,
+	0xcd, 0x9c, 0x64, 0x5a, 0xd3, 0x4c, 0x8f, 0x7e, 0x89, 0xa9, 0x4f, 0xcf, 0xba, 0x89, 0xa0, 0x11,
+	0x51, 0x1c, 0xd6, 0x1b, 0x04, 0x4b, 0x9d, 0xe1, 0x90, 0xc5, 0x81, 0xc8, 0xea, 0x3c, 0x80, 0x52,
+	0x67, 0x34, 0xe2, 0x34, 0x8a, 0x7e, 0xaf, 0xd6, 0x21, 0x4f, 0x42, 0xc1, 0x6c, 0x1d, 0x4b, 0x32,
+	0x12, 0xfc, 0xff, 0xf5, 0x08, 0xcc, 0x5c, 0x33, 0xbf, 0x5a, 0x6d, 0x2f, 0xda, 0x72, 0x05, 0x1a,
+	0x24, 0x99, 0xd7, 0x7a, 0x87, 0xa0, 0xdc, 0x3b, 0xd9, 0xef, 0xbd, 0xa6, 0x81, 0xc0, 0x26, 0x94,
+	0x36, 0x27, 0x8e, 0x17, 0xec, 0x6c, 0xc9, 0x2a, 0x2a, 0x24, 0x33, 0xf1, 0x32, 0x14, 0x76, 0x82,
+	0x11, 0x3d, 0x33, 0x8d, 0x26, 0x5a, 0x35, 0x88, 0x32, 0xf0, 0x53, 0x30, 0x8e, 0x3d, 0x5f, 0x0d,
+	0xa5, 0xda, 0xae, 0xd9, 0x6a, 0x7b, 0x76, 0xb6, 0x3d, 0xfb, 0x38, 0xdb, 0x5e, 0xb7, 0x9c, 0xb6,
+	0xf3, 0xf6, 0x4b, 0x03, 0x11, 0x19, 0x81, 0x57, 0xa0, 0x20, 0x53, 0x9a, 0x79, 0x19, 0xba, 0x64,
+	0xcb, 0x65, 0xee, 0x31, 0x57, 0xa2, 0x44, 0x39, 0xad, 0x8f, 0x08, 0x8c, 0xad, 0xd8, 0x0f, 0xf1,
+	0xdf, 0x50, 0xec, 0x53, 0xcf, 0x9d, 0x08, 0x59, 0x97, 0x41, 0xb4, 0x85, 0xff, 0x83, 0x92, 0x1e,
+	0xa4, 0xae, 0x61, 0xc1, 0x4e, 0x05, 0xa2, 0x31, 0x92, 0x39, 0xf1, 0xc6, 0xdd, 0x81, 0xeb, 0xbc,
+	0xcb, 0x6a, 0x2a, 0xb7, 0x7d, 0xe4, 0xee, 0x72, 0x1e, 0xcc, 0x47, 0x64, 0x1a, 0xba, 0x5e, 0x19,
+	0x97, 0xa1, 0x64, 0x3e, 0xc2, 0x26, 0x18, 0x07, 0x8e, 0x4f, 0xcd, 0x82, 0x2e, 0x47, 0x09, 0xb3,
+	0x17, 0x08, 0x9e, 0x10, 0xe9, 0xb1, 0xbe, 0x23, 0x28, 0x6e, 0x7b, 0x53, 0x41, 0x39, 0xb6, 0x60,
+	0x41, 0xa7, 0x7a, 0x16, 0x53, 0x9e, 0xe8, 0xa1, 0xdf, 0xc2, 0x30, 0x81, 0x8a, 0x5e, 0x2a, 0x8d,
+	0xe4, 0x2e, 0xff, 0x54, 0x1b, 0x73, 0x1a, 0xfc, 0x0f, 0x54, 0xd2, 0x52, 0x54, 0xd2, 0xbc, 0x4c,
+	0x3a, 0x07, 0xf0, 0x4b, 0x58, 0x92, 0xbd, 0xcc, 0xd3, 0x1a, 0xf7, 0x48, 0x7b, 0x87, 0xcb, 0x3a,
+	0xcf, 0x41, 0x79, 0xdf, 0x09, 0xbc, 0x31, 0x8d, 0xa4, 0xe0, 0x4e, 0x28, 0x8f, 0x3c, 0x16, 0xc8,
+	0xde, 0x17, 0x49, 0x66, 0xde, 0x94, 0x62, 0xee, 0xb6, 0x14, 0x9b, 0x50, 0x3d, 0x12, 0x0e, 0x17,
+	0x5a, 0x10, 0x79, 0x29, 0x88, 0x9b, 0x50, 0xda, 0x5e, 0x2f, 0x18, 0x69, 0xbf, 0x12, 0xec, 0x1c,
+	0xc0, 0x2b, 0x50, 0x3a, 0x0c, 0x85, 0xc7, 0x82, 0x48, 0x2e, 0xc9, 0xe8, 0xc2, 0xb7, 0xcb, 0x46,
+	0x51, 0x41, 0x24, 0x73, 0xe1, 0x43, 0x28, 0x75, 0xc2, 0xb0, 0xef, 0x44, 0x13, 0xb3, 0x78, 0x9f,
+	0x93, 0xcf, 0x58, 0xf0, 0xbf, 0x50, 0xdc, 0x9c, 0xc4, 0xc1, 0xab, 0xc8, 0x2c, 0xc9, 0x83, 0xac,
+	0x2a, 0x09, 0x49, 0x8c, 0x68, 0x17, 0x5e, 0xc9, 0xa4, 0x61, 0x96, 0xb5, 0x7e, 0xe4, 0x47, 0x0a,
+	0x23, 0xda, 0x97, 0xde, 0x6c, 0x41, 0x06, 0xa4, 0x77, 0x71, 0x38, 0x1e, 0x47, 0xf4, 0xfa, 0x2e,
+	0x94, 0x95, 0xe2, 0x7b, 0x34, 0x70, 0xc5, 0x44, 0x0e, 0xcf, 0x20, 0xda, 0xc2, 0x18, 0x0c, 0xc2,
+	0x4e, 0x23, 0x3d, 0x34, 0xf9, 0xc6, 0xfb, 0x50, 0x3c, 0xea, 0x77, 0xda, 0x6b, 0xeb, 0xa6, 0x71,
+	0x9f, 0x46, 0x35, 0x49, 0x77, 0xe3, 0x62, 0x56, 0x47, 0x1f, 0x66, 0x75, 0xf4, 0x69, 0x56, 0x47,
+	0x5f, 0x67, 0x75, 0x74, 0x7e, 0x55, 0x47, 0x17, 0x57, 0x75, 0xf4, 0xc2, 0xfa, 0x39, 0x61, 0xda,
+	0xe9, 0xa0, 0x28, 0xff, 0x1d, 0x8f, 0x7f, 0x0c, 0x00, 0x12, 0x7f, 0x44, 0x2d, 0x31, 0x06, 0x00,
+	0x00,
 }
 
 func (m *Storage) Marshal() (dAtA []byte, err error) {
@@ -563,94 +813,305 @@ func (m *Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDump(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDump(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Filter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Storage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Key.Size()
-	n += 1 + l + sovDump(uint64(l))
-	l = m.Value.Size()
-	n += 1 + l + sovDump(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *Filter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountStorage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovDump(uint64(l))
-	if len(m.Storage) > 0 {
-		for _, e := range m.Storage {
-			l = e.Size()
-			n += 1 + l + sovDump(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *EVMEvent) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovDump(uint64(l))
+	if len(m.EventAddresses) > 0 {
+		for iNdEx := len(m.EventAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EventAddresses[iNdEx].Size()
+				i -= size
+				if _, err := m.EventAddresses[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintDump(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovDump(uint64(l))
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovDump(uint64(l))
+	if len(m.NameQuery) > 0 {
+		i -= len(m.NameQuery)
+		copy(dAtA[i:], m.NameQuery)
+		i = encodeVarintDump(dAtA, i, uint64(len(m.NameQuery)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Index != 0 {
-		n += 1 + sovDump(uint64(m.Index))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Addresses[iNdEx].Size()
+				i -= size
+				if _, err := m.Addresses[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintDump(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.AccountQuery) > 0 {
+		i -= len(m.AccountQuery)
+		copy(dAtA[i:], m.AccountQuery)
+		i = encodeVarintDump(dAtA, i, uint64(len(m.AccountQuery)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Dump) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Manifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Manifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Manifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovDump(uint64(m.Height))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovDump(uint64(l))
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDump(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.AccountStorage != nil {
-		l = m.AccountStorage.Size()
-		n += 1 + l + sovDump(uint64(l))
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDump(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.AppHash.Size()
+		i -= size
+		if _, err := m.AppHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDump(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Options != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Options))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintDump(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Chunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size := m.SHA256.Size()
+		i -= size
+		if _, err := m.SHA256.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDump(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Rows != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Length != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintDump(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDump(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDump(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Storage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovDump(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovDump(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EVMEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDump(uint64(l))
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovDump(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Dump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDump(uint64(m.Height))
+	}
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.AccountStorage != nil {
+		l = m.AccountStorage.Size()
+		n += 1 + l + sovDump(uint64(l))
 	}
 	if m.EVMEvent != nil {
 		l = m.EVMEvent.Size()
@@ -666,6 +1127,101 @@ func (m *Dump) Size() (n int) {
 	return n
 }
 
+func (m *Filter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountQuery)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovDump(uint64(l))
+		}
+	}
+	l = len(m.NameQuery)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if len(m.EventAddresses) > 0 {
+		for _, e := range m.EventAddresses {
+			l = e.Size()
+			n += 1 + l + sovDump(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Manifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovDump(uint64(m.Version))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDump(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovDump(uint64(m.EndHeight))
+	}
+	if m.Options != 0 {
+		n += 1 + sovDump(uint64(m.Options))
+	}
+	l = m.AppHash.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDump(uint64(l))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovDump(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Chunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovDump(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovDump(uint64(m.Length))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovDump(uint64(m.Rows))
+	}
+	l = m.SHA256.Size()
+	n += 1 + l + sovDump(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDump(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -820,7 +1376,542 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, &Storage{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &exec.LogEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dump) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dump: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dump: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &acm.Account{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountStorage == nil {
+				m.AccountStorage = &AccountStorage{}
+			}
+			if err := m.AccountStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EVMEvent == nil {
+				m.EVMEvent = &EVMEvent{}
+			}
+			if err := m.EVMEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Name == nil {
+				m.Name = &names.Entry{}
+			}
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDump(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDump
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Filter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDump
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountQuery", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountQuery = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -847,15 +1938,17 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Addresses = append(m.Addresses, v)
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameQuery", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -865,23 +1958,56 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDump
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDump
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Storage = append(m.Storage, &Storage{})
-			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NameQuery = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAddresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.EventAddresses = append(m.EventAddresses, v)
+			if err := m.EventAddresses[len(m.EventAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -907,7 +2033,7 @@ func (m *AccountStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EVMEvent) Unmarshal(dAtA []byte) error {
+func (m *Manifest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,13 +2056,32 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EVMEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Manifest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EVMEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Manifest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
@@ -968,11 +2113,68 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			m.Options = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Options |= Option(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -982,28 +2184,28 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDump
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDump
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AppHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1030,18 +2232,16 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &exec.LogEvent{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -1051,11 +2251,28 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &Filter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDump(dAtA[iNdEx:])
@@ -1078,7 +2295,7 @@ func (m *EVMEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dump) Unmarshal(dAtA []byte) error {
+func (m *Chunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1101,17 +2318,17 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dump: wiretype end group for non-group")
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dump: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.Height = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -1121,16 +2338,16 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Offset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var msglen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -1140,33 +2357,16 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDump
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDump
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Account == nil {
-				m.Account = &acm.Account{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountStorage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
 			}
-			var msglen int
+			m.Rows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -1176,69 +2376,16 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDump
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDump
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AccountStorage == nil {
-				m.AccountStorage = &AccountStorage{}
-			}
-			if err := m.AccountStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EVMEvent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDump
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDump
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDump
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EVMEvent == nil {
-				m.EVMEvent = &EVMEvent{}
-			}
-			if err := m.EVMEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA256", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDump
@@ -1248,25 +2395,22 @@ func (m *Dump) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDump
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDump
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name == nil {
-				m.Name = &names.Entry{}
-			}
-			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SHA256.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
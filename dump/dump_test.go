@@ -36,7 +36,7 @@ func BenchmarkDump(b *testing.B) {
 	dumper := NewDumper(st, &bcm.Blockchain{})
 	b.StartTimer()
 	for n := 0; n < b.N; n++ {
-		err := dumper.Transmit(NullSink{}, 0, 0, All)
+		err := dumper.Transmit(NullSink{}, 0, 0, All, nil)
 		require.NoError(b, err)
 	}
 }
@@ -48,7 +48,7 @@ func TestDump(t *testing.T) {
 	sink := CollectSink{
 		Rows: make([]string, 0),
 	}
-	err := dumper.Transmit(&sink, 0, 0, All)
+	err := dumper.Transmit(&sink, 0, 0, All, nil)
 	require.NoError(t, err)
 
 	sort.Strings(sink.Rows)
@@ -80,7 +80,7 @@ func normaliseDump(dump string) string {
 
 func dumpToJSONString(t *testing.T, st *state.State, blockchain Blockchain) string {
 	buf := new(bytes.Buffer)
-	receiver := NewDumper(st, blockchain).Source(0, 0, All)
+	receiver := NewDumper(st, blockchain).Source(0, 0, All, nil)
 	err := Write(buf, receiver, false, All)
 	require.NoError(t, err)
 	return string(buf.Bytes())
@@ -0,0 +1,74 @@
+package dump
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+)
+
+// A Filter with its queries parsed, the zero value matches everything
+type rowFilter struct {
+	accountQuery   query.Query
+	addresses      map[crypto.Address]struct{}
+	nameQuery      query.Query
+	eventAddresses map[crypto.Address]struct{}
+}
+
+func newRowFilter(filter *Filter) (*rowFilter, error) {
+	rf := new(rowFilter)
+	if filter == nil {
+		return rf, nil
+	}
+	var err error
+	if filter.AccountQuery != "" {
+		rf.accountQuery, err = query.New(filter.AccountQuery)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse account query: %v", err)
+		}
+	}
+	if filter.NameQuery != "" {
+		rf.nameQuery, err = query.New(filter.NameQuery)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse name query: %v", err)
+		}
+	}
+	rf.addresses = addressSet(filter.Addresses)
+	rf.eventAddresses = addressSet(filter.EventAddresses)
+	return rf, nil
+}
+
+func (rf *rowFilter) matchesAccount(acc *acm.Account) bool {
+	if rf.addresses != nil {
+		if _, ok := rf.addresses[acc.Address]; !ok {
+			return false
+		}
+	}
+	return rf.accountQuery == nil || rf.accountQuery.Matches(acc)
+}
+
+func (rf *rowFilter) matchesName(entry *names.Entry) bool {
+	return rf.nameQuery == nil || rf.nameQuery.Matches(entry)
+}
+
+func (rf *rowFilter) matchesEvent(log *exec.LogEvent) bool {
+	if rf.eventAddresses == nil {
+		return true
+	}
+	_, ok := rf.eventAddresses[log.Address]
+	return ok
+}
+
+func addressSet(addresses []crypto.Address) map[crypto.Address]struct{} {
+	if len(addresses) == 0 {
+		return nil
+	}
+	set := make(map[crypto.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		set[address] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,108 @@
+package dump
+
+import (
+	bin "encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	mockSource := NewMockSource(50, 50, 100, 100)
+	st := testLoad(t, mockSource)
+	dumper := NewDumper(st, mockSource)
+
+	t.Run("Addresses", func(t *testing.T) {
+		addresses := []crypto.Address{mockAddress(3), mockAddress(4), crypto.Address{0xFF}}
+		rows := transmitRows(t, dumper, &Filter{Addresses: addresses})
+		var accounts, storage int
+		for _, row := range rows {
+			if row.Account != nil {
+				accounts++
+				assert.Contains(t, addresses, row.Account.Address)
+			}
+			if row.AccountStorage != nil {
+				storage++
+				assert.Contains(t, addresses, row.AccountStorage.Address)
+			}
+		}
+		// The last address does not exist
+		assert.Equal(t, 2, accounts)
+		assert.Equal(t, 2, storage)
+		// Names and events are not filtered
+		assert.Len(t, rows, 2+100+100)
+	})
+
+	t.Run("AccountQuery", func(t *testing.T) {
+		acc, err := st.GetAccount(mockAddress(3))
+		require.NoError(t, err)
+		rows := transmitRows(t, dumper, &Filter{
+			AccountQuery: query.NewBuilder().AndEquals("Balance", acc.Balance).String(),
+		})
+		var accounts int
+		for _, row := range rows {
+			if row.Account != nil {
+				accounts++
+				assert.Equal(t, mockAddress(3), row.Account.Address)
+			}
+		}
+		assert.Equal(t, 1, accounts)
+	})
+
+	t.Run("NameQuery", func(t *testing.T) {
+		rows := transmitRows(t, dumper, &Filter{NameQuery: "Name = 'name7'"})
+		var names int
+		for _, row := range rows {
+			if row.Name != nil {
+				names++
+				assert.Equal(t, "name7", row.Name.Name)
+			}
+		}
+		assert.Equal(t, 1, names)
+	})
+
+	t.Run("EventAddresses", func(t *testing.T) {
+		rows := transmitRows(t, dumper, &Filter{EventAddresses: []crypto.Address{crypto.ZeroAddress}})
+		assert.Len(t, rows, len(transmitRows(t, dumper, nil)))
+		rows = transmitRows(t, dumper, &Filter{EventAddresses: []crypto.Address{mockAddress(1)}})
+		for _, row := range rows {
+			assert.Nil(t, row.EVMEvent)
+		}
+	})
+
+	t.Run("BadQuery", func(t *testing.T) {
+		err := dumper.Transmit(NullSink{}, 0, 0, All, &Filter{NameQuery: "Name =="})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not parse name query")
+	})
+
+	t.Run("Manifest", func(t *testing.T) {
+		filter := &Filter{NameQuery: "Name = 'name7'"}
+		manifest, err := dumper.Manifest(0, 0, All, filter)
+		require.NoError(t, err)
+		assert.Equal(t, filter, manifest.Filter)
+	})
+}
+
+func mockAddress(i uint64) crypto.Address {
+	var address crypto.Address
+	bin.BigEndian.PutUint64(address[:], i)
+	return address
+}
+
+func transmitRows(t *testing.T, dumper *Dumper, filter *Filter) []*Dump {
+	var rows []*Dump
+	src := dumper.Source(0, 0, All, filter)
+	for {
+		row, err := src.Recv()
+		if err == io.EOF {
+			return rows
+		}
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+}
@@ -0,0 +1,285 @@
+package dump
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"golang.org/x/crypto/sha3"
+)
+
+// DefaultWeiPerUnit is the number of wei in a Burrow native unit (1 native unit to 1 ether as per balance.NativeToWei)
+var DefaultWeiPerUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// Top-level keys under which geth nests a map of address to account
+var gethAccountMapKeys = map[string]bool{
+	// geth dump
+	"accounts": true,
+	// geth genesis
+	"alloc": true,
+}
+
+type gethImporter struct {
+	weiPerUnit *big.Int
+}
+
+// An account as emitted by geth dump or as a geth genesis alloc entry, the latter using hex quantities
+type gethAccount struct {
+	// Only present in iterative (line-delimited) geth dumps
+	Address string            `json:"address"`
+	Balance gethQuantity      `json:"balance"`
+	Nonce   gethQuantity      `json:"nonce"`
+	Code    string            `json:"code"`
+	Storage map[string]string `json:"storage"`
+}
+
+// A JSON number or string holding a decimal or 0x-prefixed hex integer
+type gethQuantity struct {
+	big.Int
+}
+
+func (q *gethQuantity) UnmarshalJSON(bs []byte) error {
+	str := strings.Trim(string(bs), `"`)
+	if str == "" || str == "0x" {
+		q.SetUint64(0)
+		return nil
+	}
+	var ok bool
+	if strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X") {
+		_, ok = q.SetString(str[2:], 16)
+	} else {
+		_, ok = q.SetString(str, 10)
+	}
+	if !ok {
+		return fmt.Errorf("could not parse %s as a geth quantity", bs)
+	}
+	return nil
+}
+
+// NewGethFileReader returns a Source reading the geth state in filename, see NewGethReader
+func NewGethFileReader(filename string, weiPerUnit *big.Int) (Source, error) {
+	f, err := os.OpenFile(filename, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewGethReader(f, weiPerUnit)
+}
+
+// NewGethReader returns a Source of account rows read from the Ethereum state in reader, which may be the output of
+// geth dump (either a single JSON object or line-delimited with one account per line), a geth genesis file, or a bare
+// genesis alloc object. Balances are divided by weiPerUnit (discarding any remainder) to give Burrow native units.
+func NewGethReader(reader io.Reader, weiPerUnit *big.Int) (Source, error) {
+	if weiPerUnit == nil || weiPerUnit.Sign() <= 0 {
+		return nil, fmt.Errorf("wei per unit must be positive but got %v", weiPerUnit)
+	}
+	gi := &gethImporter{weiPerUnit: weiPerUnit}
+	p := make(Pipe)
+	go func() {
+		err := gi.transmit(p, reader)
+		if err != nil {
+			p <- msg{err: err}
+		}
+		close(p)
+	}()
+	return p, nil
+}
+
+func (gi *gethImporter) transmit(sink Sink, reader io.Reader) error {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	for {
+		err := expectDelim(decoder, '{')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// Fields that are not account maps or addresses, small enough to collect since these are either the fields of
+		// an iterative dump's account line or top-level metadata like root and config
+		fields := make(map[string]json.RawMessage)
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return fmt.Errorf("expected object key in geth state but got %v", key)
+			}
+			switch {
+			case gethAccountMapKeys[name]:
+				err = gi.transmitAccountMap(sink, decoder)
+			case isGethAddress(name):
+				err = gi.transmitAccount(sink, decoder, name)
+			default:
+				var value json.RawMessage
+				err = decoder.Decode(&value)
+				fields[name] = value
+			}
+			if err != nil {
+				return err
+			}
+		}
+		err = expectDelim(decoder, '}')
+		if err != nil {
+			return err
+		}
+		if _, ok := fields["address"]; ok {
+			bs, err := json.Marshal(fields)
+			if err != nil {
+				return err
+			}
+			account := new(gethAccount)
+			err = json.Unmarshal(bs, account)
+			if err != nil {
+				return fmt.Errorf("could not decode geth account: %v", err)
+			}
+			err = gi.send(sink, account.Address, account)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (gi *gethImporter) transmitAccountMap(sink Sink, decoder *json.Decoder) error {
+	err := expectDelim(decoder, '{')
+	if err != nil {
+		return err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		address, ok := key.(string)
+		if !ok {
+			return fmt.Errorf("expected address key in geth accounts but got %v", key)
+		}
+		err = gi.transmitAccount(sink, decoder, address)
+		if err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, '}')
+}
+
+func (gi *gethImporter) transmitAccount(sink Sink, decoder *json.Decoder, address string) error {
+	account := new(gethAccount)
+	err := decoder.Decode(account)
+	if err != nil {
+		return fmt.Errorf("could not decode geth account %s: %v", address, err)
+	}
+	return gi.send(sink, address, account)
+}
+
+// Sends the account as a single row multiplexing the account and its storage
+func (gi *gethImporter) send(sink Sink, hexAddress string, account *gethAccount) error {
+	bs, err := decodeGethHex(hexAddress)
+	if err != nil {
+		return fmt.Errorf("could not decode geth address %s: %v", hexAddress, err)
+	}
+	address, err := crypto.AddressFromBytes(bs)
+	if err != nil {
+		return err
+	}
+	amount := new(big.Int).Div(&account.Balance.Int, gi.weiPerUnit)
+	if !amount.IsUint64() {
+		return fmt.Errorf("balance of geth account %v is %v wei which is %v native units after scaling by %v "+
+			"wei per unit, this does not fit in 64 bits", address, &account.Balance.Int, amount, gi.weiPerUnit)
+	}
+	if !account.Nonce.IsUint64() {
+		return fmt.Errorf("nonce of geth account %v does not fit in 64 bits", address)
+	}
+	acc := &acm.Account{
+		Address:  address,
+		Balance:  amount.Uint64(),
+		Sequence: account.Nonce.Uint64(),
+	}
+	code, err := decodeGethHex(account.Code)
+	if err != nil {
+		return fmt.Errorf("could not decode code of geth account %v: %v", address, err)
+	}
+	if len(code) > 0 {
+		acc.EVMCode = code
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write(code)
+		acc.CodeHash = hash.Sum(nil)
+	}
+	row := &Dump{
+		Account: acc,
+	}
+	if len(account.Storage) > 0 {
+		row.AccountStorage = &AccountStorage{
+			Address: address,
+			Storage: make([]*Storage, 0, len(account.Storage)),
+		}
+		keys := make([]string, 0, len(account.Storage))
+		for key := range account.Storage {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			k, err := decodeGethWord(key)
+			if err != nil {
+				return fmt.Errorf("could not decode storage key %s of geth account %v: %v", key, address, err)
+			}
+			v, err := decodeGethWord(account.Storage[key])
+			if err != nil {
+				return fmt.Errorf("could not decode storage value %s of geth account %v: %v",
+					account.Storage[key], address, err)
+			}
+			row.AccountStorage.Storage = append(row.AccountStorage.Storage, &Storage{Key: k, Value: v.Bytes()})
+		}
+	}
+	return sink.Send(row)
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected '%v' in geth state but got %v", delim, token)
+	}
+	return nil
+}
+
+func isGethAddress(str string) bool {
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+	if len(str) != 2*crypto.AddressLength {
+		return false
+	}
+	_, err := hex.DecodeString(str)
+	return err == nil
+}
+
+// Decodes hex with or without a 0x prefix, geth drops leading zeros from storage values
+func decodeGethHex(str string) ([]byte, error) {
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+	if len(str)%2 == 1 {
+		str = "0" + str
+	}
+	return hex.DecodeString(str)
+}
+
+func decodeGethWord(str string) (binary.Word256, error) {
+	bs, err := decodeGethHex(str)
+	if err != nil {
+		return binary.Zero256, err
+	}
+	if len(bs) > binary.Word256Bytes {
+		return binary.Zero256, fmt.Errorf("%s is longer than %d bytes", str, binary.Word256Bytes)
+	}
+	return binary.LeftPadWord256(bs), nil
+}
@@ -0,0 +1,118 @@
+package dump
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const gethDump = `{
+    "root": "f3f0a0b0e6bf0bd0b1ad30a5b2f2fd22ea7d6eb0bd7bd0cc4c0ac4b4b19ab4c6",
+    "accounts": {
+        "0x6075eadd0c7a33ee6153f3fa1b21e4d80045fce2": {
+            "balance": "3000000000000000000",
+            "nonce": 7,
+            "root": "56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+            "codeHash": "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+        },
+        "0x1f3f8ac3d6b8e1c5a4e9d45d7c6e8f2b1a0c9d8e": {
+            "balance": "0",
+            "nonce": 1,
+            "root": "a1b2",
+            "codeHash": "d3e4",
+            "code": "0x6080604052",
+            "storage": {
+                "0000000000000000000000000000000000000000000000000000000000000000": "2a",
+                "0000000000000000000000000000000000000000000000000000000000000001": "0102"
+            }
+        }
+    }
+}`
+
+const gethIterativeDump = `{"root": "f3f0a0b0e6bf0bd0b1ad30a5b2f2fd22ea7d6eb0bd7bd0cc4c0ac4b4b19ab4c6"}
+{"balance": "3000000000000000000", "nonce": 7, "root": "56e8", "codeHash": "c5d2", "address": "0x6075eadd0c7a33ee6153f3fa1b21e4d80045fce2", "key": "0x00"}
+{"balance": "0", "nonce": 1, "root": "a1b2", "codeHash": "d3e4", "code": "0x6080604052", "storage": {"0000000000000000000000000000000000000000000000000000000000000000": "2a", "0000000000000000000000000000000000000000000000000000000000000001": "0102"}, "address": "0x1f3f8ac3d6b8e1c5a4e9d45d7c6e8f2b1a0c9d8e", "key": "0x01"}
+`
+
+const gethGenesis = `{
+    "config": {"chainId": 1337, "homesteadBlock": 0},
+    "difficulty": "0x1",
+    "gasLimit": "0x8000000",
+    "alloc": {
+        "6075eadd0c7a33ee6153f3fa1b21e4d80045fce2": {"balance": "0x29a2241af62c0000", "nonce": "0x7"},
+        "0x1f3f8ac3d6b8e1c5a4e9d45d7c6e8f2b1a0c9d8e": {
+            "balance": "0",
+            "nonce": "0x1",
+            "code": "0x6080604052",
+            "storage": {
+                "0x0000000000000000000000000000000000000000000000000000000000000000": "0x000000000000000000000000000000000000000000000000000000000000002a",
+                "0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000102"
+            }
+        }
+    }
+}`
+
+func TestGethReader(t *testing.T) {
+	user := crypto.MustAddressFromHexString("6075EADD0C7A33EE6153F3FA1B21E4D80045FCE2")
+	contract := crypto.MustAddressFromHexString("1F3F8AC3D6B8E1C5A4E9D45D7C6E8F2B1A0C9D8E")
+
+	for name, geth := range map[string]string{
+		"Dump":          gethDump,
+		"IterativeDump": gethIterativeDump,
+		"Genesis":       gethGenesis,
+	} {
+		t.Run(name, func(t *testing.T) {
+			src, err := NewGethReader(strings.NewReader(geth), DefaultWeiPerUnit)
+			require.NoError(t, err)
+			st := state.NewState(dbm.NewMemDB())
+			require.NoError(t, Load(src, st))
+
+			acc, err := st.GetAccount(user)
+			require.NoError(t, err)
+			require.NotNil(t, acc)
+			assert.Equal(t, uint64(3), acc.Balance)
+			assert.Equal(t, uint64(7), acc.Sequence)
+			assert.Empty(t, acc.EVMCode)
+
+			acc, err = st.GetAccount(contract)
+			require.NoError(t, err)
+			require.NotNil(t, acc)
+			assert.Equal(t, uint64(1), acc.Sequence)
+			assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, acc.EVMCode.Bytes())
+			assert.Len(t, acc.CodeHash, 32)
+
+			value, err := st.GetStorage(contract, binary.Int64ToWord256(0))
+			require.NoError(t, err)
+			assert.Equal(t, binary.Int64ToWord256(42).Bytes(), value)
+			value, err = st.GetStorage(contract, binary.Int64ToWord256(1))
+			require.NoError(t, err)
+			assert.Equal(t, binary.Int64ToWord256(0x102).Bytes(), value)
+		})
+	}
+
+	t.Run("WeiPerUnit", func(t *testing.T) {
+		src, err := NewGethReader(strings.NewReader(gethDump), big.NewInt(1000))
+		require.NoError(t, err)
+		row, err := src.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3000000000000000), row.Account.Balance)
+
+		// 100 ether does not fit in 64 bits when counted in wei
+		src, err = NewGethReader(strings.NewReader(`{"alloc": {"6075eadd0c7a33ee6153f3fa1b21e4d80045fce2": `+
+			`{"balance": "100000000000000000000"}}}`), big.NewInt(1))
+		require.NoError(t, err)
+		_, err = src.Recv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not fit in 64 bits")
+
+		_, err = NewGethReader(strings.NewReader(gethDump), big.NewInt(0))
+		require.Error(t, err)
+	})
+}
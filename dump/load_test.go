@@ -55,7 +55,7 @@ func TestLoadAndDump(t *testing.T) {
 		sink := CollectSink{
 			Rows: make([]string, 0),
 		}
-		err = dumper.Transmit(&sink, 0, 0, All)
+		err = dumper.Transmit(&sink, 0, 0, All, nil)
 		require.NoError(t, err)
 
 		st, err = state.MakeGenesisState(testDB(t), &genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions, ChainName: fmt.Sprintf("CHAIN #%d", i)})
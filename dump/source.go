@@ -18,11 +18,28 @@ type StreamReader struct {
 	decode func(*Dump) error
 }
 
+// NewFileReader returns a Source reading the dump in filename, which may be JSON, protobuf, or an archive. Archives
+// are verified against their manifest before they are returned.
 func NewFileReader(filename string) (Source, error) {
 	f, err := os.OpenFile(filename, os.O_RDONLY, 0644)
 	if err != nil {
 		return nil, err
 	}
+	archive, err := isArchive(f)
+	if err != nil {
+		return nil, err
+	}
+	if archive {
+		ar, err := NewArchiveReader(f)
+		if err != nil {
+			return nil, err
+		}
+		err = ar.Verify()
+		if err != nil {
+			return nil, err
+		}
+		return ar, nil
+	}
 	decoder, err := decoderFor(f)
 	if err != nil {
 		return nil, err
@@ -78,14 +78,11 @@ func ReadMessage(r io.Reader, pb proto.Message) (int, error) {
 	read := br.read
 	// Use any message bytes at end of buffer
 	bs := make([]byte, msgLength)
-	n, err := r.Read(bs)
+	n, err := io.ReadFull(r, bs)
 	read += n
 	if err != nil {
-		return read, fmt.Errorf("%s: %v", errHeader, err)
-	}
-	if len(bs) != n {
-		return read, fmt.Errorf("%s: expected protobuf message of %d bytes but could only read %d bytes",
-			errHeader, msgLength, n)
+		return read, fmt.Errorf("%s: expected protobuf message of %d bytes but could only read %d bytes: %v",
+			errHeader, msgLength, n, err)
 	}
 	err = Decode(bs, pb)
 	if err != nil {
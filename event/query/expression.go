@@ -141,6 +141,44 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 	return stack[0].match, nil
 }
 
+// RequiredEqualities returns the string equality conditions that must hold for the expression to match, that is those
+// conditions joined to the rest of the expression by AND alone. Conditions under an OR are never required.
+func (e *Expression) RequiredEqualities() []Condition {
+	if len(e.errors) > 0 {
+		return nil
+	}
+	var left, right *instruction
+	stack := make([]*instruction, 0, len(e.code))
+	required := make([][]Condition, 0, len(e.code))
+	for _, in := range e.code {
+		if in.op == OpTerminal {
+			stack = append(stack, in)
+			required = append(required, nil)
+			continue
+		}
+		if len(stack) < 2 {
+			return nil
+		}
+		stack, left, right = pop(stack)
+		var conditions []Condition
+		switch in.op {
+		case OpAnd:
+			conditions = append(required[len(required)-2], required[len(required)-1]...)
+		case OpOr:
+		default:
+			if in.op == OpEqual && left.tag != nil && right.string != nil {
+				conditions = []Condition{{Tag: *left.tag, Op: OpEqual, Operand: *right.string}}
+			}
+		}
+		required = append(required[:len(required)-2], conditions)
+		stack = append(stack, &instruction{})
+	}
+	if len(required) != 1 {
+		return nil
+	}
+	return required[0]
+}
+
 func (e *Expression) explainf(fmt string, args ...interface{}) {
 	if e.explainer != nil {
 		e.explainer(fmt, args...)
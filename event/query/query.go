@@ -98,6 +98,11 @@ func (q *PegQuery) Matches(tags Tagged) bool {
 	return match
 }
 
+// RequiredEqualities returns the conditions of the form tag = 'value' that any matching tags must satisfy
+func (q *PegQuery) RequiredEqualities() []Condition {
+	return q.parser.RequiredEqualities()
+}
+
 // Returns whether a matching error occurred (which would result in a false from Matches)
 func (q *PegQuery) MatchError() error {
 	if q.error == nil {
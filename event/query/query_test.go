@@ -86,3 +86,26 @@ func TestMustParse(t *testing.T) {
 	assert.Panics(t, func() { MustParse("=") })
 	assert.NotPanics(t, func() { MustParse("tm.events.type='NewBlock'") })
 }
+
+func TestRequiredEqualities(t *testing.T) {
+	testCases := []struct {
+		s        string
+		required []Condition
+	}{
+		{"Address = 'AB'", []Condition{{Tag: "Address", Op: OpEqual, Operand: "AB"}}},
+		{"Address = 'AB' AND Log0 = 'CD' AND Height > 3", []Condition{
+			{Tag: "Address", Op: OpEqual, Operand: "AB"},
+			{Tag: "Log0", Op: OpEqual, Operand: "CD"},
+		}},
+		{"(Address = 'AB' OR Address = 'EF') AND Log1 = 'CD'", []Condition{
+			{Tag: "Log1", Op: OpEqual, Operand: "CD"},
+		}},
+		{"Address = 'AB' OR Log1 = 'CD'", nil},
+		{"Address CONTAINS 'AB' AND Height = 3", nil},
+	}
+	for _, tc := range testCases {
+		q, err := New(tc.s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.required, q.RequiredEqualities(), tc.s)
+	}
+}
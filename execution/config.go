@@ -3,7 +3,9 @@ package execution
 import (
 	"fmt"
 
+	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/txs/payload"
 )
 
 type VMOption string
@@ -23,6 +25,8 @@ type ExecutionConfig struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	VMOptions                []VMOption `json:",omitempty" toml:",omitempty"`
+	// Shut down once the block at this height has been committed, zero meaning never
+	HaltHeight uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -42,6 +46,25 @@ func VMOptions(vmOptions evm.Options) func(*executor) {
 	}
 }
 
+// UpgradeContext executes transactions of type ty with ctx once the named upgrade has activated, and as before until
+// then
+func UpgradeContext(upgrade string, ty payload.Type, ctx contexts.Context) Option {
+	return func(exe *executor) {
+		exe.upgradeContexts = append(exe.upgradeContexts, upgradeContext{
+			Type: ty,
+			UpgradeContext: &contexts.UpgradeContext{
+				Upgrade: upgrade,
+				After:   ctx,
+			},
+		})
+	}
+}
+
+type upgradeContext struct {
+	payload.Type
+	*contexts.UpgradeContext
+}
+
 func (ec *ExecutionConfig) ExecutionOptions() ([]Option, error) {
 	var exeOptions []Option
 	vmOptions := evm.Options{
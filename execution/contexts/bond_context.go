@@ -8,18 +8,25 @@ import (
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/execution/staking"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
 type BondContext struct {
-	State        acmstate.ReaderWriter
-	ValidatorSet validator.ReaderWriter
-	Logger       *logging.Logger
-	tx           *payload.BondTx
+	State          acmstate.ReaderWriter
+	ValidatorSet   validator.NextReaderWriter
+	Liveness       liveness.Reader
+	LivenessParams liveness.Params
+	Upgrades       governance.Reader
+	Staking        staking.ReaderWriter
+	Logger         *logging.Logger
+	tx             *payload.BondTx
 }
 
-// Execute a BondTx to add or remove a new validator
+// Execute a BondTx to add power to a new or existing validator, either its own or delegated from another account
 func (ctx *BondContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
 	var ok bool
 	ctx.tx, ok = p.(*payload.BondTx)
@@ -34,7 +41,33 @@ func (ctx *BondContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		return err
 	}
 
-	ct := account.PublicKey.GetCurveType()
+	// Until the upgrade bonds are not recorded, so power can only be added to the account's own validator
+	staked, err := governance.Activated(ctx.Upgrades, governance.StakingUpgrade, txe.Height)
+	if err != nil {
+		return err
+	}
+
+	// the validator receiving power is the account itself unless it is delegating
+	publicKey := account.PublicKey
+	if ctx.tx.Validator != nil && *ctx.tx.Validator != account.Address {
+		if !staked {
+			return fmt.Errorf("cannot delegate to %v until the %s upgrade", *ctx.tx.Validator,
+				governance.StakingUpgrade)
+		}
+		selfBond, err := getSelfBond(ctx.Staking, ctx.ValidatorSet, ctx.Liveness, *ctx.tx.Validator)
+		if err != nil {
+			return err
+		}
+		if selfBond == nil {
+			return fmt.Errorf("%v is not a bonded validator so cannot be delegated to", *ctx.tx.Validator)
+		}
+		publicKey = selfBond.PublicKey
+	} else if publicKey.GetAddress() != account.Address {
+		// A validator's address is derived from the key it signs blocks with
+		return fmt.Errorf("account %s has rotated its key so cannot be a validator", account.Address)
+	}
+
+	ct := publicKey.GetCurveType()
 	if ct == crypto.CurveTypeSecp256k1 {
 		return fmt.Errorf("secp256k1 not supported")
 	}
@@ -44,6 +77,24 @@ func (ctx *BondContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		return fmt.Errorf("account '%s' lacks bond permission", account.Address)
 	}
 
+	// jailed validators must be unjailed before they can regain power
+	jailing, err := ctx.jailing(txe.Height)
+	if err != nil {
+		return err
+	}
+	if jailing {
+		address := publicKey.GetAddress()
+		l, err := ctx.Liveness.GetLiveness(address)
+		if err != nil {
+			return err
+		}
+		if l != nil && l.Tombstoned {
+			return fmt.Errorf("validator %s was jailed for double signing so cannot bond", address)
+		} else if l != nil && l.Jailed {
+			return fmt.Errorf("validator %s is jailed so must be unjailed before bonding", address)
+		}
+	}
+
 	// check account has enough to bond
 	amount := ctx.tx.Input.GetAmount()
 	if amount == 0 {
@@ -53,17 +104,66 @@ func (ctx *BondContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 			"we are deducting %v", account.Address, account.Balance, amount)
 	}
 
-	// we're good to go
+	// we're good to go, the balance is locked in the bond until it is unbonded
 	err = account.SubtractFromBalance(amount)
 	if err != nil {
 		return err
 	}
 
-	// assume public key is know as we update account from signatures
-	err = validator.AddPower(ctx.ValidatorSet, account.PublicKey, power)
+	if staked && publicKey.GetAddress() == account.Address {
+		// Record any stake the validator already has before adding to it
+		_, err = getSelfBond(ctx.Staking, ctx.ValidatorSet, ctx.Liveness, account.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	// assume public key is know as we update account from signatures, or from the bond of the validator delegated to
+	err = validator.AddPower(ctx.ValidatorSet, publicKey, power)
 	if err != nil {
 		return err
 	}
 
+	if staked {
+		err = staking.AddBond(ctx.Staking, publicKey, account.Address, amount)
+		if err != nil {
+			return err
+		}
+	}
+
 	return ctx.State.UpdateAccount(account)
 }
+
+// Validators can only be jailed for downtime when it is enabled from genesis, or for double signing once
+// governance.JailingUpgrade has activated, and bonds were accepted regardless of liveness until either
+func (ctx *BondContext) jailing(height uint64) (bool, error) {
+	if ctx.LivenessParams.Enabled() {
+		return true, nil
+	}
+	return governance.Activated(ctx.Upgrades, governance.JailingUpgrade, height)
+}
+
+// Returns the validator's bond with itself, first recording any implicit self-bond it has (see
+// staking.RecordSelfBond), or nil if it has none. The power of a jailed validator is what it would regain on being
+// unjailed.
+func getSelfBond(stakingRW staking.ReaderWriter, validators validator.NextReader, livenessReader liveness.Reader,
+	address crypto.Address) (*staking.Bond, error) {
+	power, publicKey, err := validators.NextPower(address)
+	if err != nil {
+		return nil, err
+	}
+	if livenessReader != nil {
+		l, err := livenessReader.GetLiveness(address)
+		if err != nil {
+			return nil, err
+		}
+		if l != nil && l.Jailed {
+			power = new(big.Int).SetUint64(l.JailedPower)
+			publicKey = &l.PublicKey
+		}
+	}
+	if publicKey == nil || power.Sign() == 0 {
+		return stakingRW.GetBond(address, address)
+	}
+	return staking.RecordSelfBond(stakingRW, *publicKey, power.Uint64())
+}
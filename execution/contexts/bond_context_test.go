@@ -8,9 +8,12 @@ import (
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestBondContext(t *testing.T) {
@@ -30,10 +33,11 @@ func TestBondContext(t *testing.T) {
 		bondContext := &BondContext{
 			State:        accountState,
 			ValidatorSet: validator.NewSet(),
+			Upgrades:     governance.NewCache(state.NewState(dbm.NewMemDB()), nil),
 			Logger:       logging.NewNoopLogger(),
 		}
 
-		err = bondContext.Execute(&exec.TxExecution{}, &payload.BondTx{
+		err = bondContext.Execute(&exec.TxExecution{TxHeader: &exec.TxHeader{}}, &payload.BondTx{
 			Input: &payload.TxInput{
 				Address: address,
 				Amount:  1337,
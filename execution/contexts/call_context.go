@@ -10,6 +10,8 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/fees"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/wasm"
 	"github.com/hyperledger/burrow/logging"
@@ -25,6 +27,8 @@ type CallContext struct {
 	State         acmstate.ReaderWriter
 	MetadataState acmstate.MetadataReaderWriter
 	Blockchain    engine.Blockchain
+	Params        governance.Reader
+	Fees          *fees.Pool
 	RunCall       bool
 	Logger        *logging.Logger
 	tx            *payload.CallTx
@@ -102,6 +106,8 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	// The fee is paid to the validators at the end of the block, or burnt until governance.FeesUpgrade activates
+	ctx.Fees.Add(ctx.tx.Fee)
 	return inAcc, outAcc, nil
 }
 
@@ -220,6 +226,7 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		// EVM
 		ctx.EVM.SetNonce(txHash)
 		ctx.EVM.SetLogger(ctx.Logger.With(structure.TxHashKey, txHash))
+		ctx.EVM.SetUpgrades(ctx.upgrades(ctx.txe.Height))
 
 		ret, err = ctx.EVM.Execute(txCache, ctx.Blockchain, ctx.txe, params, code)
 
@@ -271,3 +278,13 @@ func (ctx *CallContext) Sync(cache *acmstate.Cache, metaCache *acmstate.Metadata
 	}
 	return metaCache.Sync(ctx.MetadataState)
 }
+
+// The upgrades gating EVM opcodes at height, none of which are active without Params (as in a simulated call)
+func (ctx *CallContext) upgrades(height uint64) evm.Upgrades {
+	if ctx.Params == nil {
+		return nil
+	}
+	return func(name string) (bool, error) {
+		return governance.Activated(ctx.Params, name, height)
+	}
+}
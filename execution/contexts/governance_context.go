@@ -8,8 +8,12 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/execution/staking"
 	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -18,14 +22,18 @@ import (
 
 type GovernanceContext struct {
 	State        acmstate.ReaderWriter
-	ValidatorSet validator.ReaderWriter
+	ValidatorSet validator.NextReaderWriter
+	Liveness     liveness.Reader
+	Staking      staking.Reader
+	Params       governance.ReaderWriter
+	Blockchain   engine.Blockchain
 	Logger       *logging.Logger
 	tx           *payload.GovTx
 	txe          *exec.TxExecution
 }
 
 // GovTx provides a set of TemplateAccounts and GovernanceContext tries to alter the chain state to match the
-// specification given
+// specification given, it may also change the execution parameters and Tendermint's consensus parameters
 func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
 	var ok bool
 	ctx.txe = txe
@@ -54,6 +62,20 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 		if err != nil {
 			return fmt.Errorf("GovTx: %v", err)
 		}
+		err = ctx.checkKeyReplacement(update)
+		if err != nil {
+			return fmt.Errorf("GovTx: %v", err)
+		}
+	}
+	// Execution parameters are in force from the next transaction, consensus parameters from the next block
+	height := ctx.Blockchain.LastBlockHeight() + 1
+	// There is no rollback of a failed transaction so we must check everything before we write anything
+	consensusParams, err := ctx.validate(height)
+	if err != nil {
+		return fmt.Errorf("GovTx: %v", err)
+	}
+
+	for _, update := range ctx.tx.AccountUpdates {
 		account, err := getOrMakeOutput(ctx.State, accounts, *update.Address, ctx.Logger)
 		if err != nil {
 			return err
@@ -65,6 +87,76 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution, p payload.Payload)
 		}
 		txe.GovernAccount(governAccountEvent, nil)
 	}
+	if ctx.tx.ExecutionParams != nil {
+		ctx.Logger.InfoMsg("Updating execution parameters", "params", ctx.tx.ExecutionParams)
+		err = ctx.Params.UpdateExecutionParams(height, ctx.tx.ExecutionParams)
+		if err != nil {
+			return err
+		}
+	}
+	if consensusParams != nil {
+		ctx.Logger.InfoMsg("Updating consensus parameters", "params", consensusParams)
+		err = ctx.Params.UpdateConsensusParams(height, consensusParams)
+		if err != nil {
+			return err
+		}
+	}
+	if ctx.tx.Upgrade != nil {
+		ctx.Logger.InfoMsg("Scheduling upgrade", "upgrade", ctx.tx.Upgrade)
+		return ctx.Params.UpdateUpgrade(ctx.tx.Upgrade)
+	}
+	return nil
+}
+
+// Checks the parameters and upgrade of the GovTx, returning the consensus parameters merged with those in force if
+// they are to change
+func (ctx *GovernanceContext) validate(height uint64) (*governance.ConsensusParams, error) {
+	if ctx.tx.ExecutionParams != nil {
+		err := ctx.tx.ExecutionParams.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var params *governance.ConsensusParams
+	if ctx.tx.ConsensusParams != nil {
+		current, err := ctx.Params.GetConsensusParams()
+		if err != nil {
+			return nil, err
+		}
+		// Tendermint will halt if passed parameters it cannot accept so we must check them here
+		params = current.Update(ctx.tx.ConsensusParams)
+		err = params.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ctx.tx.Upgrade != nil {
+		err := ctx.validateUpgrade(height)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+// Upgrades may be rescheduled or cancelled until they activate but not after
+func (ctx *GovernanceContext) validateUpgrade(height uint64) error {
+	upgrade := ctx.tx.Upgrade
+	// Nodes halt once the block before the upgrade is committed so it cannot activate in the block being executed
+	err := upgrade.Validate(height + 1)
+	if err != nil {
+		return err
+	}
+	existing, err := ctx.Params.GetUpgrade(upgrade.Name)
+	if err != nil {
+		return err
+	}
+	if existing.ActiveAt(height) {
+		return fmt.Errorf("upgrade %s has already activated at height %d", existing.Name, existing.Height)
+	}
+	if upgrade.Height == 0 && existing == nil {
+		return fmt.Errorf("cannot cancel upgrade %s since it is not scheduled", upgrade.Name)
+	}
 	return nil
 }
 
@@ -87,6 +179,13 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 			return ev, err
 		}
 	}
+	if replacesKey(account, update) {
+		ctx.Logger.InfoMsg("Replacing account key", "address", account.Address, "from", account.PublicKey,
+			"to", update.PublicKey)
+		account.PublicKey = *update.PublicKey
+		// The key now signs for the account in place of any members
+		account.MultiSig = nil
+	}
 	if update.Code != nil {
 		account.EVMCode = *update.Code
 		if err != nil {
@@ -111,6 +210,28 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 	return
 }
 
+// Validators sign blocks with their key so governance cannot replace it under them
+func (ctx *GovernanceContext) checkKeyReplacement(update *spec.TemplateAccount) error {
+	account, err := ctx.State.GetAccount(*update.Address)
+	if err != nil {
+		return err
+	}
+	if account == nil || !replacesKey(account, update) {
+		return nil
+	}
+	err = checkNotValidator(ctx.ValidatorSet, ctx.Liveness, ctx.Staking, account.Address)
+	if err != nil {
+		return fmt.Errorf("%v so its key cannot be replaced", err)
+	}
+	return nil
+}
+
+// Whether the update gives the account a key other than the one it has or the one its address was derived from
+func replacesKey(account *acm.Account, update *spec.TemplateAccount) bool {
+	return update.PublicKey != nil && !update.PublicKey.Equal(account.PublicKey) &&
+		(account.PublicKey.IsSet() || update.PublicKey.GetAddress() != account.Address)
+}
+
 func VerifyIdentity(sw acmstate.ReaderWriter, account *spec.TemplateAccount) (err error) {
 	if account.Address == nil && account.PublicKey == nil {
 		// We do not want to generate a key
@@ -126,11 +247,24 @@ func VerifyIdentity(sw acmstate.ReaderWriter, account *spec.TemplateAccount) (er
 	// Check address
 	if account.PublicKey != nil {
 		address := account.PublicKey.GetAddress()
-		if account.Address != nil && address != *account.Address {
-			return fmt.Errorf("supplied public key %v whose address %v does not match %v provided by"+
-				"GovTx", account.PublicKey, address, account.Address)
+		if account.Address == nil {
+			account.Address = &address
+		} else if address != *account.Address {
+			// The key replaces the current key of an existing account, for instance to recover one whose key is lost
+			acc, err := sw.GetAccount(*account.Address)
+			if err != nil {
+				return err
+			}
+			if acc == nil {
+				return fmt.Errorf("supplied public key %v whose address %v does not match %v provided by "+
+					"GovTx so can only replace the key of an existing account", account.PublicKey, address,
+					account.Address)
+			}
+			if account.Balances().HasPower() {
+				return fmt.Errorf("cannot set validator power for %v whose address is not derived from its "+
+					"public key %v", account.Address, account.PublicKey)
+			}
 		}
-		account.Address = &address
 	} else if account.Balances().HasPower() {
 		// If we are updating power we will need the key
 		return fmt.Errorf("must be provided with public key when updating validator power")
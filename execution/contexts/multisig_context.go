@@ -0,0 +1,51 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type MultiSigContext struct {
+	State  acmstate.ReaderWriter
+	Logger *logging.Logger
+	tx     *payload.MultiSigTx
+}
+
+// Execute a MultiSigTx to change the members of a multi-signature account. The executor has already checked that the
+// input was signed by a threshold of the current members.
+func (ctx *MultiSigContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.MultiSigTx)
+	if !ok {
+		return fmt.Errorf("payload must be MultiSigTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+
+	address := ctx.tx.Input.Address
+	acc, err := ctx.State.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		return errors.Codes.InvalidAddress
+	}
+	if acc.MultiSig == nil {
+		return fmt.Errorf("account %v is not a multi-signature account", address)
+	}
+	if ctx.tx.MultiSig == nil {
+		return fmt.Errorf("MultiSigTx must provide the new members of %v", address)
+	}
+	err = ctx.tx.MultiSig.Validate()
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.InfoMsg("Changing multi-signature members", "address", address, "from", acc.MultiSig,
+		"to", ctx.tx.MultiSig)
+	acc.MultiSig = ctx.tx.MultiSig
+	return ctx.State.UpdateAccount(acc)
+}
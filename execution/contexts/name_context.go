@@ -9,6 +9,8 @@ import (
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/fees"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -23,6 +25,8 @@ type NameContext struct {
 	Blockchain engine.Blockchain
 	State      acmstate.ReaderWriter
 	NameReg    names.ReaderWriter
+	Params     governance.Reader
+	Fees       *fees.Pool
 	Logger     *logging.Logger
 	tx         *payload.NameTx
 }
@@ -60,8 +64,12 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 
 	value := ctx.tx.Input.Amount - ctx.tx.Fee
 
+	params, err := ctx.Params.GetExecutionParams()
+	if err != nil {
+		return err
+	}
 	// let's say cost of a name for one block is len(data) + 32
-	costPerBlock := names.NameCostPerBlock(names.NameBaseCost(ctx.tx.Name, ctx.tx.Data))
+	costPerBlock := params.NameCostPerBlock(names.NameBaseCost(ctx.tx.Name, ctx.tx.Data))
 	expiresIn := value / uint64(costPerBlock)
 	lastBlockHeight := ctx.Blockchain.LastBlockHeight()
 
@@ -117,7 +125,7 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 			} else {
 				// since the size of the data may have changed
 				// we use the total amount of "credit"
-				oldCredit := (entry.Expires - lastBlockHeight) * names.NameBaseCost(entry.Name, entry.Data)
+				oldCredit := (entry.Expires - lastBlockHeight) * params.NameCostPerBlock(names.NameBaseCost(entry.Name, entry.Data))
 				credit := oldCredit + value
 				expiresIn = uint64(credit / costPerBlock)
 				if expiresIn < names.MinNameRegistrationPeriod {
@@ -166,7 +174,16 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		"old_sequence", inAcc.Sequence,
 		"new_sequence", inAcc.Sequence+1)
 
-	err = inAcc.SubtractFromBalance(value)
+	payingFees, err := governance.Activated(ctx.Params, governance.FeesUpgrade, lastBlockHeight+1)
+	if err != nil {
+		return err
+	}
+	amount := value
+	if payingFees {
+		// The value pays for the name and the fee is paid to the validators at the end of the block
+		amount = ctx.tx.Input.Amount
+	}
+	err = inAcc.SubtractFromBalance(amount)
 	if err != nil {
 		return errors.Errorf(errors.Codes.InsufficientFunds,
 			"Input account does not have sufficient balance to cover input amount: %v", ctx.tx.Input)
@@ -175,6 +192,9 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	if err != nil {
 		return err
 	}
+	if payingFees {
+		ctx.Fees.Add(ctx.tx.Fee)
+	}
 
 	// TODO: maybe we want to take funds on error and allow txs in that don't do anything?
 
@@ -9,6 +9,8 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/fees"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
@@ -35,6 +37,8 @@ func TestNameContext(t *testing.T) {
 		Logger:     logging.NewNoopLogger(),
 		Blockchain: blockchain,
 		NameReg:    names.NewCache(state),
+		Params:     governance.NewCache(state, governance.ExecutionParamsFromGenesis(genesisDoc)),
+		Fees:       new(fees.Pool),
 	}
 
 	callTx := &payload.CallTx{}
@@ -3,16 +3,19 @@ package contexts
 import (
 	"crypto/sha256"
 	"fmt"
+	"math/big"
 	"runtime/debug"
 	"unicode"
 
 	"github.com/hyperledger/burrow/encoding"
 
+	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -21,16 +24,21 @@ import (
 )
 
 type ProposalContext struct {
-	ChainID           string
-	ProposalThreshold uint64
-	State             acmstate.ReaderWriter
-	ValidatorSet      validator.Writer
-	ProposalReg       proposal.ReaderWriter
-	Logger            *logging.Logger
-	tx                *payload.ProposalTx
-	Contexts          map[payload.Type]Context
+	ChainID string
+	Params  governance.Reader
+	// Accounts that vote on proposals, also counted for the total membership of a proposal's role
+	State acmstate.RoleCountingReaderWriter
+	// Validators whose power weights votes on proposals without a role
+	ValidatorSet validator.IterableReader
+	ProposalReg  proposal.ReaderWriter
+	Logger       *logging.Logger
+	tx           *payload.ProposalTx
+	Contexts     map[payload.Type]Context
 }
 
+// A simple majority of votes cast when a proposal sets a quorum or role but no threshold
+var defaultProposalThreshold = &payload.Fraction{Numerator: 1, Denominator: 2}
+
 func HashProposal(p *payload.Proposal) []byte {
 	bs, err := encoding.Encode(p)
 	if err != nil {
@@ -65,6 +73,13 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		return fmt.Errorf("account %s does not have Proposal permission", ctx.tx.Input.Address)
 	}
 
+	// Before the upgrade proposals are decided by the ProposalThreshold alone and votes are not checked against the
+	// state of their ballot
+	upgraded, err := governance.Activated(ctx.Params, governance.ProposalsUpgrade, txe.Height)
+	if err != nil {
+		return err
+	}
+
 	var ballot *payload.Ballot
 	var proposalHash []byte
 
@@ -79,8 +94,11 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		if err != nil {
 			return err
 		}
+		if ballot == nil {
+			return errors.Errorf(errors.Codes.InvalidProposal, "no proposal with hash %v", ctx.tx.ProposalHash)
+		}
 	} else {
-		if ctx.tx.ProposalHash != nil || ctx.tx.Proposal.BatchTx == nil ||
+		if ctx.tx.ProposalHash != nil || ctx.tx.Withdraw || ctx.tx.Proposal.BatchTx == nil ||
 			len(ctx.tx.Proposal.BatchTx.Txs) == 0 || len(ctx.tx.Proposal.BatchTx.GetInputs()) == 0 {
 			return errors.Codes.InvalidProposal
 		}
@@ -97,6 +115,12 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 			return err
 		}
 
+		if ballot == nil && upgraded {
+			err = validateProposalTerms(ctx.tx.Proposal, txe.Height)
+			if err != nil {
+				return err
+			}
+		}
 		if ballot == nil {
 			ballot = &payload.Ballot{
 				Proposal:      ctx.tx.Proposal,
@@ -107,17 +131,41 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		// else vote for existing proposal
 	}
 
-	// Check that we have not voted this already
-	for _, vote := range ballot.Votes {
-		for _, i := range ctx.tx.GetInputs() {
-			if i.Address == vote.Address {
-				return errors.Codes.AlreadyVoted
+	if upgraded {
+		err = checkBallotOpen(ballot, proposalHash, txe.Height)
+		if err != nil {
+			return err
+		}
+	} else if ctx.tx.Withdraw || !legacyTerms(ballot.Proposal) {
+		return errors.Errorf(errors.Codes.InvalidProposal,
+			"proposal terms and vote withdrawal are not available until the %s upgrade", governance.ProposalsUpgrade)
+	}
+
+	event := &exec.ProposalEvent{
+		ProposalHash: proposalHash,
+		Withdrawn:    ctx.tx.Withdraw,
+	}
+
+	if ctx.tx.Withdraw {
+		event.Vote, err = withdrawVote(ballot, ctx.tx.Input.Address)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Check that we have not voted this already
+		for _, vote := range ballot.Votes {
+			for _, i := range ctx.tx.GetInputs() {
+				if i.Address == vote.Address {
+					return errors.Codes.AlreadyVoted
+				}
 			}
 		}
 	}
 
-	// count votes for proposal
-	votes := make(map[crypto.Address]int64)
+	tally, err := ctx.newTally(ballot.Proposal)
+	if err != nil {
+		return err
+	}
 
 	if ballot.Votes == nil {
 		ballot.Votes = make([]*payload.Vote, 0)
@@ -132,7 +180,10 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		if !hasProposalPermission(ctx.State, acc, ctx.Logger) {
 			return fmt.Errorf("account %s does not have Proposal permission", ctx.tx.Input.Address)
 		}
-		votes[v.Address] = v.VotingWeight
+		err = tally.count(acc, v.VotingWeight)
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, i := range ballot.Proposal.BatchTx.GetInputs() {
@@ -157,21 +208,22 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		}
 	}
 
-	for _, i := range ctx.tx.GetInputs() {
-		// Do we have a record of our own vote
-		if _, ok := votes[i.Address]; !ok {
-			votes[i.Address] = ctx.tx.VotingWeight
-			ballot.Votes = append(ballot.Votes, &payload.Vote{Address: i.Address, VotingWeight: ctx.tx.VotingWeight})
+	if !ctx.tx.Withdraw {
+		// Record our own vote
+		vote := &payload.Vote{Address: ctx.tx.Input.Address, VotingWeight: ctx.tx.VotingWeight}
+		weight, err := tally.weight(inAcc)
+		if err != nil {
+			return err
 		}
-	}
-
-	// Count the number of validators; ensure we have at least half the number of validators
-	// This also means that when running with a single validator, a proposal will run straight away
-	var power uint64
-	for _, v := range votes {
-		if v > 0 {
-			power++
+		if weight.Sign() == 0 {
+			return fmt.Errorf("account %s has no voting weight for proposal %X", vote.Address, proposalHash)
+		}
+		err = tally.count(inAcc, vote.VotingWeight)
+		if err != nil {
+			return err
 		}
+		ballot.Votes = append(ballot.Votes, vote)
+		event.Vote = vote
 	}
 
 	stateCache := acmstate.NewCache(ctx.State)
@@ -198,7 +250,11 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		}
 	}
 
-	if power >= ctx.ProposalThreshold {
+	passed, err := tally.passed(ctx.Params)
+	if err != nil {
+		return err
+	}
+	if passed {
 		ballot.ProposalState = payload.Ballot_EXECUTED
 
 		txe.TxExecutions = make([]*exec.TxExecution, 0)
@@ -253,9 +309,59 @@ func (ctx *ProposalContext) Execute(txe *exec.TxExecution, p payload.Payload) er
 		}
 	}
 
+	if upgraded {
+		event.ProposalState = ballot.ProposalState
+		txe.Proposal(event, nil)
+	}
+
 	return ctx.ProposalReg.UpdateProposal(proposalHash, ballot)
 }
 
+func checkBallotOpen(ballot *payload.Ballot, proposalHash []byte, height uint64) error {
+	switch ballot.ProposalState {
+	case payload.Ballot_PROPOSED:
+	case payload.Ballot_EXPIRED:
+		return errors.Errorf(errors.Codes.ExpiredProposal, "proposal %X expired at height %d", proposalHash,
+			ballot.Proposal.ExpiryHeight)
+	default:
+		return errors.Errorf(errors.Codes.ProposalExecuted, "proposal %X is already %v", proposalHash,
+			ballot.ProposalState)
+	}
+	// The proposal will expire when the block at its expiry height is committed
+	if ballot.Proposal.ExpiryHeight != 0 && height > ballot.Proposal.ExpiryHeight {
+		return errors.Errorf(errors.Codes.ExpiredProposal, "proposal %X expired at height %d", proposalHash,
+			ballot.Proposal.ExpiryHeight)
+	}
+	return nil
+}
+
+func withdrawVote(ballot *payload.Ballot, address crypto.Address) (*payload.Vote, error) {
+	for i, vote := range ballot.Votes {
+		if vote.Address == address {
+			ballot.Votes = append(ballot.Votes[:i], ballot.Votes[i+1:]...)
+			return vote, nil
+		}
+	}
+	return nil, errors.Errorf(errors.Codes.InvalidProposal, "account %v has not voted on proposal", address)
+}
+
+func validateProposalTerms(proposal *payload.Proposal, height uint64) error {
+	// Proposals expire when their expiry height is committed, so they must be in committed state by then
+	if proposal.ExpiryHeight != 0 && proposal.ExpiryHeight <= height {
+		return errors.Errorf(errors.Codes.ExpiredProposal, "proposal expiry height %d must be after height %d",
+			proposal.ExpiryHeight, height)
+	}
+	for _, f := range []*payload.Fraction{proposal.Quorum, proposal.Threshold} {
+		if f != nil {
+			err := f.Validate()
+			if err != nil {
+				return errors.Errorf(errors.Codes.InvalidProposal, "invalid proposal terms: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
 func validateProposalStrings(proposal *payload.Proposal) error {
 	if len(proposal.Name) == 0 {
 		return errors.Errorf(errors.Codes.InvalidString, "name must not be empty")
@@ -282,3 +388,102 @@ func validateStringPrintable(data string) bool {
 	}
 	return true
 }
+
+// Accumulates the votes on a proposal according to its terms
+type proposalTally struct {
+	proposal   *payload.Proposal
+	validators validator.Reader
+	// Number of votes in favour with the legacy rule, weight in favour otherwise
+	inFavour *big.Int
+	// Weight of all votes cast
+	cast *big.Int
+	// Weight of all eligible voters
+	total *big.Int
+}
+
+func (ctx *ProposalContext) newTally(p *payload.Proposal) (*proposalTally, error) {
+	tally := &proposalTally{
+		proposal:   p,
+		validators: ctx.ValidatorSet,
+		inFavour:   new(big.Int),
+		cast:       new(big.Int),
+		total:      new(big.Int),
+	}
+	var err error
+	switch {
+	case tally.legacy():
+	case p.Role != "":
+		var members uint64
+		members, err = ctx.State.CountRole(p.Role)
+		tally.total.SetUint64(members)
+	default:
+		err = ctx.ValidatorSet.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+			tally.total.Add(tally.total, power)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tally, nil
+}
+
+// Proposals that set none of the weighted terms are decided by counting votes against the ProposalThreshold
+// execution parameter
+func (tally *proposalTally) legacy() bool {
+	return tally.proposal.Role == "" && tally.proposal.Quorum == nil && tally.proposal.Threshold == nil
+}
+
+// Proposals that set none of the terms introduced by governance.ProposalsUpgrade
+func legacyTerms(p *payload.Proposal) bool {
+	return p.ExpiryHeight == 0 && p.Role == "" && p.Quorum == nil && p.Threshold == nil
+}
+
+// The weight carried by acc's vote, which is zero if it is not eligible to vote
+func (tally *proposalTally) weight(acc *acm.Account) (*big.Int, error) {
+	switch {
+	case tally.legacy():
+		return big.NewInt(1), nil
+	case tally.proposal.Role != "":
+		if acc.Permissions.HasRole(tally.proposal.Role) {
+			return big.NewInt(1), nil
+		}
+		return new(big.Int), nil
+	default:
+		return tally.validators.Power(acc.Address)
+	}
+}
+
+// Positive voting weights are in favour of the proposal, others against
+func (tally *proposalTally) count(acc *acm.Account, votingWeight int64) error {
+	weight, err := tally.weight(acc)
+	if err != nil {
+		return err
+	}
+	tally.cast.Add(tally.cast, weight)
+	if votingWeight > 0 {
+		tally.inFavour.Add(tally.inFavour, weight)
+	}
+	return nil
+}
+
+func (tally *proposalTally) passed(params governance.Reader) (bool, error) {
+	if tally.legacy() {
+		execParams, err := params.GetExecutionParams()
+		if err != nil {
+			return false, err
+		}
+		return tally.inFavour.Cmp(new(big.Int).SetUint64(execParams.ProposalThreshold)) >= 0, nil
+	}
+	if tally.inFavour.Sign() == 0 {
+		return false, nil
+	}
+	if tally.proposal.Quorum != nil && !tally.proposal.Quorum.Reached(tally.cast, tally.total) {
+		return false, nil
+	}
+	threshold := tally.proposal.Threshold
+	if threshold == nil {
+		threshold = defaultProposalThreshold
+	}
+	return threshold.Reached(tally.inFavour, tally.cast), nil
+}
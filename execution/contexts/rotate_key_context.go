@@ -0,0 +1,99 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type RotateKeyContext struct {
+	State        acmstate.ReaderWriter
+	ValidatorSet validator.NextReaderWriter
+	Liveness     liveness.Reader
+	Staking      staking.Reader
+	Logger       *logging.Logger
+	tx           *payload.RotateKeyTx
+}
+
+// Execute a RotateKeyTx to replace the key that signs for an account. The executor has already checked that the input
+// was signed by the current key.
+func (ctx *RotateKeyContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.RotateKeyTx)
+	if !ok {
+		return fmt.Errorf("payload must be RotateKeyTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+
+	address := ctx.tx.Input.Address
+	acc, err := ctx.State.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		return errors.Codes.InvalidAddress
+	}
+	if acc.MultiSig != nil {
+		return fmt.Errorf("account %v is a multi-signature account so its members must be changed with MultiSigTx",
+			address)
+	}
+	if ctx.tx.PublicKey == nil || !ctx.tx.PublicKey.IsValid() {
+		return fmt.Errorf("RotateKeyTx must provide a valid public key for %v", address)
+	}
+	if acc.PublicKey.Equal(*ctx.tx.PublicKey) {
+		return fmt.Errorf("%v is already the key of account %v", ctx.tx.PublicKey, address)
+	}
+	err = checkNotValidator(ctx.ValidatorSet, ctx.Liveness, ctx.Staking, address)
+	if err != nil {
+		return fmt.Errorf("%v so its key cannot be rotated", err)
+	}
+
+	ctx.Logger.InfoMsg("Rotating account key", "address", address, "from", acc.PublicKey,
+		"to", ctx.tx.PublicKey)
+	acc.PublicKey = *ctx.tx.PublicKey
+	return ctx.State.UpdateAccount(acc)
+}
+
+// The validator set tracks the key validators sign blocks with so it cannot change under them. This includes a
+// validator joining the set in this block, a jailed validator that would regain its power under its current key on
+// being unjailed, and one with bonds held against that key.
+func checkNotValidator(validators validator.NextReaderWriter, livenessReader liveness.Reader,
+	stakingReader staking.Reader, address crypto.Address) error {
+	power, err := validators.Power(address)
+	if err != nil {
+		return err
+	}
+	nextPower, _, err := validators.NextPower(address)
+	if err != nil {
+		return err
+	}
+	if power.Sign() > 0 || nextPower.Sign() > 0 {
+		return fmt.Errorf("account %v is a validator", address)
+	}
+	l, err := livenessReader.GetLiveness(address)
+	if err != nil {
+		return err
+	}
+	if l != nil && l.Jailed {
+		return fmt.Errorf("account %v is a jailed validator", address)
+	}
+	var bonded bool
+	err = stakingReader.IterateValidatorBonds(address, func(*staking.Bond) error {
+		bonded = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if bonded {
+		return fmt.Errorf("account %v is a validator with bonds held against its key", address)
+	}
+	return nil
+}
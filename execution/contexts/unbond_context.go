@@ -6,19 +6,30 @@ import (
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/execution/staking"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
 type UnbondContext struct {
+	Blockchain   engine.Blockchain
+	Params       staking.Params
+	Upgrades     governance.Reader
 	State        acmstate.ReaderWriter
-	ValidatorSet validator.ReaderWriter
+	ValidatorSet validator.NextReaderWriter
+	Liveness     liveness.ReaderWriter
+	Staking      staking.ReaderWriter
 	Logger       *logging.Logger
 	tx           *payload.UnbondTx
 }
 
-// Execute an UnbondTx to remove a validator
+// Execute an UnbondTx to remove power from a validator, returning the bonded balance once the unbonding period has
+// passed
 func (ctx *UnbondContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
 	var ok bool
 	ctx.tx, ok = p.(*payload.UnbondTx)
@@ -30,21 +41,87 @@ func (ctx *UnbondContext) Execute(txe *exec.TxExecution, p payload.Payload) erro
 		return fmt.Errorf("input and output address must match")
 	}
 
-	power := new(big.Int).SetUint64(ctx.tx.Output.GetAmount())
-	account, err := ctx.State.GetAccount(ctx.tx.Input.Address)
+	delegator := ctx.tx.Output.Address
+	validatorAddress := delegator
+	if ctx.tx.Validator != nil {
+		validatorAddress = *ctx.tx.Validator
+	}
+	amount := ctx.tx.Output.GetAmount()
+	if amount == 0 {
+		return fmt.Errorf("nothing to unbond")
+	}
+
+	// Until the upgrade there are no bonds, so an account unbonds from its own power and is repaid immediately
+	staked, err := governance.Activated(ctx.Upgrades, governance.StakingUpgrade, txe.Height)
 	if err != nil {
 		return err
 	}
+	if !staked {
+		if validatorAddress != delegator {
+			return fmt.Errorf("cannot unbond from %v until the %s upgrade", validatorAddress,
+				governance.StakingUpgrade)
+		}
+		account, err := ctx.State.GetAccount(delegator)
+		if err != nil {
+			return err
+		}
+		err = ctx.subtractPower(validatorAddress, account.PublicKey, amount)
+		if err != nil {
+			return err
+		}
+		return ctx.repay(delegator, amount)
+	}
 
-	err = account.AddToBalance(power.Uint64())
+	if delegator == validatorAddress {
+		_, err := getSelfBond(ctx.Staking, ctx.ValidatorSet, ctx.Liveness, validatorAddress)
+		if err != nil {
+			return err
+		}
+	}
+	bond, err := staking.SubtractBond(ctx.Staking, validatorAddress, delegator, amount)
+	if err != nil {
+		return err
+	}
+	err = ctx.subtractPower(validatorAddress, bond.PublicKey, amount)
 	if err != nil {
 		return err
 	}
 
-	err = validator.SubtractPower(ctx.ValidatorSet, account.PublicKey, power)
+	if ctx.Params.UnbondingBlocks > 0 {
+		releaseHeight := ctx.Blockchain.LastBlockHeight() + 1 + ctx.Params.UnbondingBlocks
+		return staking.AddUnbonding(ctx.Staking, releaseHeight, validatorAddress, delegator, amount)
+	}
+	return ctx.repay(delegator, amount)
+}
+
+// Reduces the power of the validator, or if it is jailed the power it would regain on being unjailed
+func (ctx *UnbondContext) subtractPower(validatorAddress crypto.Address, publicKey crypto.PublicKey,
+	amount uint64) error {
+	l, err := ctx.Liveness.GetLiveness(validatorAddress)
 	if err != nil {
 		return err
 	}
+	if l != nil && l.Jailed {
+		// A jailed validator has already lost its power so we reduce what it would regain on being unjailed
+		if l.JailedPower < amount {
+			l.JailedPower = 0
+		} else {
+			l.JailedPower -= amount
+		}
+		return ctx.Liveness.UpdateLiveness(validatorAddress, l)
+	}
+	return validator.SubtractPower(ctx.ValidatorSet, publicKey, new(big.Int).SetUint64(amount))
+}
 
+// Returns the unbonded balance to the delegator
+func (ctx *UnbondContext) repay(delegator crypto.Address, amount uint64) error {
+	account, err := ctx.State.GetAccount(delegator)
+	if err != nil {
+		return err
+	}
+	err = account.AddToBalance(amount)
+	if err != nil {
+		return err
+	}
 	return ctx.State.UpdateAccount(account)
 }
@@ -0,0 +1,57 @@
+package contexts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type UnjailContext struct {
+	Blockchain   engine.Blockchain
+	Params       liveness.Params
+	Liveness     liveness.ReaderWriter
+	ValidatorSet validator.ReaderWriter
+	Logger       *logging.Logger
+	tx           *payload.UnjailTx
+}
+
+// Execute an UnjailTx to restore the power of a validator jailed for downtime
+func (ctx *UnjailContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.UnjailTx)
+	if !ok {
+		return fmt.Errorf("payload must be UnjailTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+
+	address := ctx.tx.Input.Address
+	l, err := ctx.Liveness.GetLiveness(address)
+	if err != nil {
+		return err
+	}
+	if l == nil || !l.Jailed {
+		return fmt.Errorf("validator %v is not jailed", address)
+	}
+	if l.Tombstoned {
+		return fmt.Errorf("validator %v was jailed for double signing so cannot be unjailed", address)
+	}
+	height := ctx.Blockchain.LastBlockHeight() + 1
+	if height < l.JailedHeight+ctx.Params.JailBlocks {
+		return fmt.Errorf("validator %v was jailed at height %d so cannot be unjailed until height %d",
+			address, l.JailedHeight, l.JailedHeight+ctx.Params.JailBlocks)
+	}
+
+	_, err = ctx.ValidatorSet.SetPower(l.PublicKey, new(big.Int).SetUint64(l.JailedPower))
+	if err != nil {
+		return err
+	}
+	ctx.Logger.InfoMsg("Unjailed validator", "validator_address", address, "height", height,
+		"power", l.JailedPower)
+	l.Unjail(height)
+	return ctx.Liveness.UpdateLiveness(address, l)
+}
@@ -0,0 +1,35 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// UpgradeContext switches from executing transactions with Before to executing them with After once the named
+// upgrade has activated
+type UpgradeContext struct {
+	Upgrade    string
+	Params     governance.Reader
+	Blockchain engine.Blockchain
+	// May be nil if the transaction type is new in the upgrade
+	Before Context
+	After  Context
+}
+
+func (ctx *UpgradeContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	active, err := governance.Activated(ctx.Params, ctx.Upgrade, ctx.Blockchain.LastBlockHeight()+1)
+	if err != nil {
+		return err
+	}
+	if active {
+		return ctx.After.Execute(txe, p)
+	}
+	if ctx.Before == nil {
+		return fmt.Errorf("%v is not supported until upgrade %s has activated", p.Type(), ctx.Upgrade)
+	}
+	return ctx.Before.Execute(txe, p)
+}
@@ -49,6 +49,8 @@ type codes struct {
 	UnresolvedSymbols      *Code
 	InvalidContractCode    *Code
 	NonExistentAccount     *Code
+	InsufficientFee        *Code
+	ExcessiveGasLimit      *Code
 
 	// For lookup
 	codes []*Code
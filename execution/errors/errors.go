@@ -64,6 +64,8 @@ var Codes = codes{
 	UnresolvedSymbols:      code("code has unresolved symbols"),
 	InvalidContractCode:    code("contract being created with unexpected code"),
 	NonExistentAccount:     code("account does not exist"),
+	InsufficientFee:        code("fee is less than the minimum"),
+	ExcessiveGasLimit:      code("gas limit is more than the maximum"),
 }
 
 func init() {
@@ -73,6 +73,8 @@ const (
 	BLOCKHEIGHT
 	DIFFICULTY_DEPRECATED
 	GASLIMIT
+	_           // CHAINID is not supported
+	SELFBALANCE // https://eips.ethereum.org/EIPS/eip-1884
 )
 
 const (
@@ -242,6 +244,7 @@ var opCodeNames = map[OpCode]string{
 	BLOCKHEIGHT:           "BLOCKHEIGHT",
 	DIFFICULTY_DEPRECATED: "DIFFICULTY_DEPRECATED",
 	GASLIMIT:              "GASLIMIT",
+	SELFBALANCE:           "SELFBALANCE",
 
 	// 0x50 range - 'storage' and execution
 	POP:      "POP",
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
@@ -28,6 +29,11 @@ type Contract struct {
 	*Code
 }
 
+// Opcodes introduced by an upgrade, which are unknown opcodes until it is active
+var upgradeOpCodes = map[OpCode]string{
+	SELFBALANCE: governance.SelfBalanceUpgrade,
+}
+
 func (c *Contract) Call(state engine.State, params engine.CallParams) ([]byte, error) {
 	return native.Call(state, params, c.execute)
 }
@@ -69,6 +75,20 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 		// Use BaseOp gas.
 		maybe.PushError(useGasNegative(params.Gas, native.GasBaseOp))
 
+		if upgrade, ok := upgradeOpCodes[op]; ok {
+			active, err := c.upgradeActive(upgrade)
+			if err != nil {
+				maybe.PushError(err)
+				return nil, maybe.Error()
+			}
+			if !active {
+				// Fail exactly as we did before we knew the opcode
+				c.debugf("(pc) %-3v Unknown opcode %v\n", pc, op)
+				maybe.PushError(errors.Errorf(errors.Codes.Generic, "unknown opcode Non-opcode 0x%x", int(op)))
+				return nil, maybe.Error()
+			}
+		}
+
 		switch op {
 
 		case ADD: // 0x01
@@ -485,6 +505,11 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 			stack.Push64(*params.Gas)
 			c.debugf(" => %v\n", *params.Gas)
 
+		case SELFBALANCE: // 0x47
+			balance := mustGetAccount(st.CallFrame, maybe, params.Callee).Balance
+			stack.Push64(balance)
+			c.debugf(" => %v (%v)\n", balance, params.Callee)
+
 		case POP: // 0x50
 			popped := stack.Pop()
 			c.debugf(" => 0x%v\n", popped)
@@ -28,8 +28,13 @@ type EVM struct {
 	externals engine.Dispatcher
 	// User dispatcher.CallableProvider to get access to other VMs
 	logger *logging.Logger
+	// Reports whether a named upgrade is active in the block being executed
+	upgrades Upgrades
 }
 
+// Returns whether the named upgrade (see governance.Activated) is active
+type Upgrades func(name string) (bool, error)
+
 // Options are parameters that are generally stable across a burrow configuration.
 // Defaults will be used for any zero values.
 type Options struct {
@@ -104,6 +109,19 @@ func (vm *EVM) SetLogger(logger *logging.Logger) {
 	vm.logger = logger
 }
 
+// Sets the upgrades in force for the block being executed, which gate the opcodes they introduce. No upgrades are
+// active if this is never called.
+func (vm *EVM) SetUpgrades(upgrades Upgrades) {
+	vm.upgrades = upgrades
+}
+
+func (vm *EVM) upgradeActive(name string) (bool, error) {
+	if vm.upgrades == nil {
+		return false, nil
+	}
+	return vm.upgrades(name)
+}
+
 func (vm *EVM) Dispatch(acc *acm.Account) engine.Callable {
 	// Try external calls then fallback to EVM
 	callable := vm.externals.Dispatch(acc)
@@ -21,6 +21,7 @@ import (
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/solidity"
 	"github.com/hyperledger/burrow/txs"
@@ -1332,6 +1333,29 @@ func TestEVM(t *testing.T) {
 		assert.Equal(t, hex.MustDecodeString("010da270094b5199d3e54f89afe4c66cdd658dd8111a41998714227e14e171bd"), output)
 	})
 
+	t.Run("SELFBALANCE", func(t *testing.T) {
+		vm := New(Options{})
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		addToBalance(t, st, account2, 1337)
+
+		var gas uint64 = 100000
+		bytecode := MustSplice(SELFBALANCE, return1())
+
+		// An unknown opcode until its upgrade is active
+		_, err := call(vm, st, account1, account2, bytecode, nil, &gas)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown opcode Non-opcode 0x47")
+
+		vm.SetUpgrades(func(name string) (bool, error) {
+			return name == governance.SelfBalanceUpgrade, nil
+		})
+		output, err := call(vm, st, account1, account2, bytecode, nil, &gas)
+		require.NoError(t, err)
+		assert.Equal(t, Uint64ToWord256(1337).Bytes(), output)
+	})
+
 	// Tests logs and events.
 	t.Run("TestLogEvents", func(t *testing.T) {
 		expectedData := []byte{0x10}
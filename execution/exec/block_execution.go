@@ -21,12 +21,18 @@ func (be *BlockExecution) StreamEvents() []*StreamEvent {
 			Height:            be.Height,
 			PredecessorHeight: be.PredecessorHeight,
 			NumTxs:            uint64(len(be.TxExecutions)),
+			NumEvents:         uint64(len(be.Events)),
 			Header:            be.Header,
 		},
 	})
 	for _, txe := range be.TxExecutions {
 		ses = append(ses, txe.StreamEvents()...)
 	}
+	for _, ev := range be.Events {
+		ses = append(ses, &StreamEvent{
+			Event: ev,
+		})
+	}
 	return append(ses, &StreamEvent{
 		EndBlock: &EndBlock{
 			Height: be.Height,
@@ -52,6 +58,30 @@ func (be *BlockExecution) AppendTxs(tail ...*TxExecution) {
 	be.TxExecutions = append(be.TxExecutions, tail...)
 }
 
+// Emit an event belonging to the block rather than to any transaction
+func (be *BlockExecution) Proposal(proposal *ProposalEvent) {
+	be.Append(&Event{
+		Header: &Header{
+			EventType: TypeProposal,
+			EventID:   EventStringProposal(proposal.ProposalHash),
+		},
+		Proposal: proposal,
+	})
+}
+
+func (be *BlockExecution) Append(tail ...*Event) {
+	for i, ev := range tail {
+		ev.Header.Index = uint64(len(be.Events) + i)
+		ev.Header.Height = be.Height
+	}
+	be.Events = append(be.Events, tail...)
+}
+
+// Returns true if the block contains anything worth storing
+func (be *BlockExecution) IsEmpty() bool {
+	return len(be.TxExecutions) == 0 && len(be.Events) == 0
+}
+
 // Tags
 
 func (be *BlockExecution) Get(key string) (interface{}, bool) {
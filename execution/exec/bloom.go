@@ -0,0 +1,103 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Length in bytes of a 2048-bit Ethereum logs bloom
+const BloomByteLength = 256
+
+// An Ethereum-compatible logs bloom over the addresses and topics of LogEvents. A negative Test means the value was
+// never added, a positive Test only means it may have been.
+type Bloom [BloomByteLength]byte
+
+func DecodeBloom(bs []byte) (*Bloom, error) {
+	if len(bs) != BloomByteLength {
+		return nil, fmt.Errorf("logs bloom must be %d bytes but got %d", BloomByteLength, len(bs))
+	}
+	bloom := new(Bloom)
+	copy(bloom[:], bs)
+	return bloom, nil
+}
+
+// Sets the 3 bits selected by the first 6 bytes of the Keccak256 hash of data (as in Ethereum's bloom9)
+func (b *Bloom) Add(data []byte) {
+	hash := crypto.Keccak256(data)
+	for i := 0; i < 6; i += 2 {
+		byteIndex, mask := bloomBit(hash[i], hash[i+1])
+		b[byteIndex] |= mask
+	}
+}
+
+func (b *Bloom) Test(data []byte) bool {
+	hash := crypto.Keccak256(data)
+	for i := 0; i < 6; i += 2 {
+		byteIndex, mask := bloomBit(hash[i], hash[i+1])
+		if b[byteIndex]&mask == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Bloom) AddLog(log *LogEvent) {
+	b.Add(log.Address.Bytes())
+	for _, topic := range log.Topics {
+		b.Add(topic.Bytes())
+	}
+}
+
+// Returns true if a LogEvent emitted by address may have been added
+func (b *Bloom) TestAddress(address crypto.Address) bool {
+	return b.Test(address.Bytes())
+}
+
+// Returns true if a LogEvent with topic may have been added
+func (b *Bloom) TestTopic(topic binary.Word256) bool {
+	return b.Test(topic.Bytes())
+}
+
+func (b *Bloom) Or(other *Bloom) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
+
+func (b *Bloom) Bytes() []byte {
+	return b[:]
+}
+
+func bloomBit(hi, lo byte) (int, byte) {
+	bit := (uint(hi)<<8 | uint(lo)) & (BloomByteLength*8 - 1)
+	return BloomByteLength - 1 - int(bit/8), 1 << (bit % 8)
+}
+
+// Returns the bloom of all LogEvents emitted by txe, including those of any transactions it contains
+func (txe *TxExecution) Bloom() *Bloom {
+	bloom := new(Bloom)
+	txe.addToBloom(bloom)
+	return bloom
+}
+
+func (txe *TxExecution) addToBloom(bloom *Bloom) {
+	for _, ev := range txe.Events {
+		if ev.Log != nil {
+			bloom.AddLog(ev.Log)
+		}
+	}
+	for _, child := range txe.TxExecutions {
+		child.addToBloom(bloom)
+	}
+}
+
+// Returns the bloom of all LogEvents emitted by transactions in the block
+func (be *BlockExecution) Bloom() *Bloom {
+	bloom := new(Bloom)
+	for _, txe := range be.TxExecutions {
+		txe.addToBloom(bloom)
+	}
+	return bloom
+}
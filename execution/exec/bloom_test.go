@@ -0,0 +1,50 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloom(t *testing.T) {
+	// keccak256("") = c5d2460186f7...
+	bloom := new(Bloom)
+	bloom.Add(nil)
+	expected := new(Bloom)
+	expected[69] = 0x04
+	expected[63] = 0x02
+	expected[33] = 0x80
+	assert.Equal(t, expected, bloom)
+	assert.True(t, bloom.Test(nil))
+	assert.False(t, bloom.Test([]byte("foo")))
+
+	decoded, err := DecodeBloom(bloom.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, bloom, decoded)
+	_, err = DecodeBloom([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestBlockExecution_Bloom(t *testing.T) {
+	contract := crypto.Address{1, 2, 3}
+	topic := binary.LeftPadWord256([]byte("Transfer"))
+	nested := &TxExecution{TxHeader: &TxHeader{}}
+	nested.Log(&LogEvent{Address: crypto.Address{4}})
+	txe := &TxExecution{TxHeader: &TxHeader{}, TxExecutions: []*TxExecution{nested}}
+	txe.Log(&LogEvent{Address: contract, Topics: []binary.Word256{topic}})
+	be := &BlockExecution{Height: 2}
+	be.AppendTxs(&TxExecution{TxHeader: &TxHeader{}}, txe)
+
+	bloom := be.Bloom()
+	assert.True(t, bloom.TestAddress(contract))
+	assert.True(t, bloom.TestTopic(topic))
+	assert.True(t, bloom.TestAddress(crypto.Address{4}))
+	assert.False(t, bloom.TestAddress(crypto.Address{5}))
+	assert.False(t, bloom.TestTopic(binary.LeftPadWord256([]byte("Approval"))))
+
+	assert.Equal(t, new(Bloom), be.TxExecutions[0].Bloom())
+	assert.Equal(t, bloom, txe.Bloom())
+}
@@ -0,0 +1,78 @@
+package exec
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+// Decode the event name and arguments of log, eventSpec must be the spec identified by log's first topic
+func DecodeLog(eventSpec *abi.EventSpec, log *LogEvent) (*DecodedLog, error) {
+	values := abi.GetPackingTypes(eventSpec.Inputs)
+	err := abi.UnpackEvent(eventSpec, log.Topics, log.Data, values...)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s event: %w", eventSpec.Name, err)
+	}
+	decoded := &DecodedLog{
+		Name:      eventSpec.Name,
+		Arguments: make([]*DecodedArgument, len(eventSpec.Inputs)),
+	}
+	for i, input := range eventSpec.Inputs {
+		bs, err := json.Marshal(jsonValue(values[i]))
+		if err != nil {
+			return nil, fmt.Errorf("could not encode argument %s of %s event: %w", input.Name, eventSpec.Name, err)
+		}
+		argType := input.EVM.GetSignature()
+		if input.IsArray {
+			if input.ArrayLength > 0 {
+				argType += fmt.Sprintf("[%d]", input.ArrayLength)
+			} else {
+				argType += "[]"
+			}
+		}
+		decoded.Arguments[i] = &DecodedArgument{
+			Name:    input.Name,
+			Type:    argType,
+			Indexed: input.Indexed,
+			JSON:    string(bs),
+		}
+	}
+	return decoded, nil
+}
+
+// Big numbers would lose precision in most JSON parsers and bytes are more useful as hex than base64
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case *big.Float:
+		return v.String()
+	case []byte:
+		return binary.HexBytes(v)
+	case *[]byte:
+		return binary.HexBytes(*v)
+	case json.Marshaler, encoding.TextMarshaler:
+		// e.g. crypto.Address
+		return v
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return jsonValue(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		values := make([]interface{}, rv.Len())
+		for i := range values {
+			values[i] = jsonValue(rv.Index(i).Interface())
+		}
+		return values
+	}
+	return value
+}
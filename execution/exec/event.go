@@ -24,6 +24,7 @@ const (
 	TypeEnvelope
 	TypeEndTx
 	TypeEndBlock
+	TypeProposal
 )
 
 var nameFromType = map[EventType]string{
@@ -37,6 +38,7 @@ var nameFromType = map[EventType]string{
 	TypeGovernAccount:  "GovernAccountEvent",
 	TypeBeginBlock:     "BeginBlockEvent",
 	TypeEndBlock:       "EndBlockEvent",
+	TypeProposal:       "ProposalEvent",
 }
 
 var typeFromName = make(map[string]EventType)
@@ -91,5 +93,8 @@ func (ev *Event) Body() string {
 	if ev.Call != nil {
 		return ev.Call.String()
 	}
+	if ev.Proposal != nil {
+		return ev.Proposal.String()
+	}
 	return "<empty>"
 }
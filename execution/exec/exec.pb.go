@@ -15,6 +15,8 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
+	acm "github.com/hyperledger/burrow/acm"
+	validator "github.com/hyperledger/burrow/acm/validator"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 	errors "github.com/hyperledger/burrow/execution/errors"
@@ -24,6 +26,7 @@ import (
 	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
 	txs "github.com/hyperledger/burrow/txs"
 	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
+	payload "github.com/hyperledger/burrow/txs/payload"
 	types "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -89,15 +92,18 @@ func (*StreamEvents) XXX_MessageName() string {
 }
 
 type StreamEvent struct {
-	BeginBlock           *BeginBlock                                 `protobuf:"bytes,1,opt,name=BeginBlock,proto3" json:"BeginBlock,omitempty"`
-	BeginTx              *BeginTx                                    `protobuf:"bytes,2,opt,name=BeginTx,proto3" json:"BeginTx,omitempty"`
-	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,3,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
-	Event                *Event                                      `protobuf:"bytes,4,opt,name=Event,proto3" json:"Event,omitempty"`
-	EndTx                *EndTx                                      `protobuf:"bytes,5,opt,name=EndTx,proto3" json:"EndTx,omitempty"`
-	EndBlock             *EndBlock                                   `protobuf:"bytes,6,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
-	XXX_unrecognized     []byte                                      `json:"-"`
-	XXX_sizecache        int32                                       `json:"-"`
+	BeginBlock *BeginBlock                                 `protobuf:"bytes,1,opt,name=BeginBlock,proto3" json:"BeginBlock,omitempty"`
+	BeginTx    *BeginTx                                    `protobuf:"bytes,2,opt,name=BeginTx,proto3" json:"BeginTx,omitempty"`
+	Envelope   *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,3,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	Event      *Event                                      `protobuf:"bytes,4,opt,name=Event,proto3" json:"Event,omitempty"`
+	EndTx      *EndTx                                      `protobuf:"bytes,5,opt,name=EndTx,proto3" json:"EndTx,omitempty"`
+	EndBlock   *EndBlock                                   `protobuf:"bytes,6,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
+	// Opaque position of this event in the stream, set by the ExecutionEvents service (pass as BlocksRequest.After to
+	// resume after this event)
+	Cursor               *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,7,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
 }
 
 func (m *StreamEvent) Reset()         { *m = StreamEvent{} }
@@ -174,11 +180,13 @@ type BeginBlock struct {
 	// The number of transactions in the block (used as a checksum when consuming StreamEvents)
 	NumTxs uint64 `protobuf:"varint,3,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
 	// The height of the most recent block we stored in state (which is the last non-empty block in current implementation)
-	PredecessorHeight    uint64        `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
-	Header               *types.Header `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	PredecessorHeight uint64        `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
+	Header            *types.Header `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	// The number of events emitted by the block itself (used as a checksum when consuming StreamEvents)
+	NumEvents            uint64   `protobuf:"varint,5,opt,name=NumEvents,proto3" json:"NumEvents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BeginBlock) Reset()         { *m = BeginBlock{} }
@@ -238,6 +246,13 @@ func (m *BeginBlock) GetHeader() *types.Header {
 	return nil
 }
 
+func (m *BeginBlock) GetNumEvents() uint64 {
+	if m != nil {
+		return m.NumEvents
+	}
+	return 0
+}
+
 func (*BeginBlock) XXX_MessageName() string {
 	return "exec.BeginBlock"
 }
@@ -485,12 +500,14 @@ type BlockExecution struct {
 	// The height of this block
 	Height uint64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 	// The height of the most recent block we stored in state (which is the last non-empty block in current implementation)
-	PredecessorHeight    uint64         `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
-	Header               *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
-	TxExecutions         []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PredecessorHeight uint64         `protobuf:"varint,4,opt,name=PredecessorHeight,proto3" json:"PredecessorHeight,omitempty"`
+	Header            *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	TxExecutions      []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// Events emitted by the block itself rather than by any transaction (such as proposals expiring)
+	Events               []*Event `protobuf:"bytes,5,rep,name=Events,proto3" json:"Events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BlockExecution) Reset()         { *m = BlockExecution{} }
@@ -550,6 +567,13 @@ func (m *BlockExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *BlockExecution) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func (*BlockExecution) XXX_MessageName() string {
 	return "exec.BlockExecution"
 }
@@ -874,6 +898,7 @@ type Event struct {
 	Call                 *CallEvent          `protobuf:"bytes,4,opt,name=Call,proto3" json:"Call,omitempty"`
 	Log                  *LogEvent           `protobuf:"bytes,5,opt,name=Log,proto3" json:"Log,omitempty"`
 	GovernAccount        *GovernAccountEvent `protobuf:"bytes,6,opt,name=GovernAccount,proto3" json:"GovernAccount,omitempty"`
+	Proposal             *ProposalEvent      `protobuf:"bytes,7,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -949,6 +974,13 @@ func (m *Event) GetGovernAccount() *GovernAccountEvent {
 	return nil
 }
 
+func (m *Event) GetProposal() *ProposalEvent {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
 func (*Event) XXX_MessageName() string {
 	return "exec.Event"
 }
@@ -1030,12 +1062,14 @@ func (*Result) XXX_MessageName() string {
 }
 
 type LogEvent struct {
-	Address              github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Data                 github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
-	Topics               []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Data    github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
+	Topics  []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
+	// Attached by the ExecutionEvents service when asked to decode events and the contract's ABI is known (never stored)
+	Decoded              *DecodedLog `protobuf:"bytes,4,opt,name=Decoded,proto3" json:"Decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *LogEvent) Reset()         { *m = LogEvent{} }
@@ -1067,10 +1101,147 @@ func (m *LogEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LogEvent proto.InternalMessageInfo
 
+func (m *LogEvent) GetDecoded() *DecodedLog {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
 func (*LogEvent) XXX_MessageName() string {
 	return "exec.LogEvent"
 }
 
+// A LogEvent decoded with the ABI of the contract that emitted it
+type DecodedLog struct {
+	// Name of the Solidity event
+	Name                 string             `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Arguments            []*DecodedArgument `protobuf:"bytes,2,rep,name=Arguments,proto3" json:"Arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DecodedLog) Reset()         { *m = DecodedLog{} }
+func (m *DecodedLog) String() string { return proto.CompactTextString(m) }
+func (*DecodedLog) ProtoMessage()    {}
+func (*DecodedLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{15}
+}
+func (m *DecodedLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DecodedLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedLog.Merge(m, src)
+}
+func (m *DecodedLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedLog proto.InternalMessageInfo
+
+func (m *DecodedLog) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecodedLog) GetArguments() []*DecodedArgument {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (*DecodedLog) XXX_MessageName() string {
+	return "exec.DecodedLog"
+}
+
+type DecodedArgument struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Solidity type of the argument, e.g. uint256, address[] (indexed dynamic types are given as the bytes32 hash)
+	Type    string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Indexed bool   `protobuf:"varint,3,opt,name=Indexed,proto3" json:"Indexed,omitempty"`
+	// The value encoded as JSON, integers wider than 64 bits are given as decimal strings and bytes as hex strings
+	JSON                 string   `protobuf:"bytes,4,opt,name=JSON,proto3" json:"JSON,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodedArgument) Reset()         { *m = DecodedArgument{} }
+func (m *DecodedArgument) String() string { return proto.CompactTextString(m) }
+func (*DecodedArgument) ProtoMessage()    {}
+func (*DecodedArgument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{16}
+}
+func (m *DecodedArgument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DecodedArgument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedArgument.Merge(m, src)
+}
+func (m *DecodedArgument) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedArgument) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedArgument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedArgument proto.InternalMessageInfo
+
+func (m *DecodedArgument) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecodedArgument) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodedArgument) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
+	}
+	return false
+}
+
+func (m *DecodedArgument) GetJSON() string {
+	if m != nil {
+		return m.JSON
+	}
+	return ""
+}
+
+func (*DecodedArgument) XXX_MessageName() string {
+	return "exec.DecodedArgument"
+}
+
 type CallEvent struct {
 	CallType             CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
 	CallData             *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
@@ -1086,7 +1257,7 @@ func (m *CallEvent) Reset()         { *m = CallEvent{} }
 func (m *CallEvent) String() string { return proto.CompactTextString(m) }
 func (*CallEvent) ProtoMessage()    {}
 func (*CallEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{15}
+	return fileDescriptor_4d737c7315c25422, []int{17}
 }
 func (m *CallEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,7 +1318,7 @@ func (m *GovernAccountEvent) Reset()         { *m = GovernAccountEvent{} }
 func (m *GovernAccountEvent) String() string { return proto.CompactTextString(m) }
 func (*GovernAccountEvent) ProtoMessage()    {}
 func (*GovernAccountEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{16}
+	return fileDescriptor_4d737c7315c25422, []int{18}
 }
 func (m *GovernAccountEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,6 +1354,72 @@ func (*GovernAccountEvent) XXX_MessageName() string {
 	return "exec.GovernAccountEvent"
 }
 
+type ProposalEvent struct {
+	ProposalHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash"`
+	// The state of the proposal after this event
+	ProposalState payload.Ballot_ProposalState `protobuf:"varint,2,opt,name=ProposalState,proto3,enum=payload.Ballot_ProposalState" json:"ProposalState,omitempty"`
+	// The vote cast or withdrawn, if any
+	Vote                 *payload.Vote `protobuf:"bytes,3,opt,name=Vote,proto3" json:"Vote,omitempty"`
+	Withdrawn            bool          `protobuf:"varint,4,opt,name=Withdrawn,proto3" json:"Withdrawn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ProposalEvent) Reset()         { *m = ProposalEvent{} }
+func (m *ProposalEvent) String() string { return proto.CompactTextString(m) }
+func (*ProposalEvent) ProtoMessage()    {}
+func (*ProposalEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{19}
+}
+func (m *ProposalEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProposalEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalEvent.Merge(m, src)
+}
+func (m *ProposalEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalEvent proto.InternalMessageInfo
+
+func (m *ProposalEvent) GetProposalState() payload.Ballot_ProposalState {
+	if m != nil {
+		return m.ProposalState
+	}
+	return payload.Ballot_PROPOSED
+}
+
+func (m *ProposalEvent) GetVote() *payload.Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+func (m *ProposalEvent) GetWithdrawn() bool {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return false
+}
+
+func (*ProposalEvent) XXX_MessageName() string {
+	return "exec.ProposalEvent"
+}
+
 type InputEvent struct {
 	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
@@ -1194,7 +1431,7 @@ func (m *InputEvent) Reset()         { *m = InputEvent{} }
 func (m *InputEvent) String() string { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()    {}
 func (*InputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{17}
+	return fileDescriptor_4d737c7315c25422, []int{20}
 }
 func (m *InputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,7 +1471,7 @@ func (m *OutputEvent) Reset()         { *m = OutputEvent{} }
 func (m *OutputEvent) String() string { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()    {}
 func (*OutputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{18}
+	return fileDescriptor_4d737c7315c25422, []int{21}
 }
 func (m *OutputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1515,7 @@ func (m *CallData) Reset()         { *m = CallData{} }
 func (m *CallData) String() string { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()    {}
 func (*CallData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{19}
+	return fileDescriptor_4d737c7315c25422, []int{22}
 }
 func (m *CallData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1320,180 +1557,522 @@ func (m *CallData) GetGas() uint64 {
 func (*CallData) XXX_MessageName() string {
 	return "exec.CallData"
 }
-func init() {
-	proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
-	golang_proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
-	proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
-	golang_proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
-	proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
-	golang_proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
-	proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
-	golang_proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
-	proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
-	golang_proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
-	proto.RegisterType((*EndTx)(nil), "exec.EndTx")
-	golang_proto.RegisterType((*EndTx)(nil), "exec.EndTx")
-	proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
-	golang_proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
-	proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
-	golang_proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
-	proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
-	golang_proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
-	proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
-	golang_proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
-	proto.RegisterType((*Origin)(nil), "exec.Origin")
-	golang_proto.RegisterType((*Origin)(nil), "exec.Origin")
-	proto.RegisterType((*Header)(nil), "exec.Header")
-	golang_proto.RegisterType((*Header)(nil), "exec.Header")
-	proto.RegisterType((*Event)(nil), "exec.Event")
-	golang_proto.RegisterType((*Event)(nil), "exec.Event")
-	proto.RegisterType((*Result)(nil), "exec.Result")
-	golang_proto.RegisterType((*Result)(nil), "exec.Result")
-	proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
-	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
-	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
-	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
-	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
-	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
-	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
-	golang_proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
-	proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
-	golang_proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
-	proto.RegisterType((*CallData)(nil), "exec.CallData")
-	golang_proto.RegisterType((*CallData)(nil), "exec.CallData")
-}
 
-func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
-func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
-
-var fileDescriptor_4d737c7315c25422 = []byte{
-	// 1311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xef, 0xda, 0x6b, 0xc7, 0x7e, 0x76, 0xfa, 0x6d, 0x47, 0xf9, 0x22, 0xab, 0xaa, 0xec, 0xb2,
-	0x45, 0xa5, 0x94, 0xb2, 0xae, 0x02, 0x45, 0xa8, 0x48, 0x88, 0xba, 0x09, 0x6d, 0xa0, 0xa4, 0x65,
-	0xea, 0x16, 0x81, 0xe0, 0xb0, 0xf1, 0x4e, 0x37, 0xab, 0xda, 0xbb, 0xab, 0xdd, 0xd9, 0xb2, 0xfe,
-	0x17, 0x38, 0xc1, 0xad, 0x48, 0x08, 0xf5, 0xcc, 0xbf, 0xc0, 0x01, 0x8e, 0xb9, 0xd1, 0x23, 0xea,
-	0xc1, 0xa0, 0xf4, 0x2f, 0x40, 0x9c, 0xe8, 0x09, 0xcd, 0xcc, 0x9b, 0xf5, 0x2e, 0x49, 0x93, 0x8a,
-	0x04, 0x89, 0x4b, 0x34, 0xef, 0xbd, 0xcf, 0x3e, 0xbf, 0x1f, 0x9f, 0xf7, 0x66, 0x02, 0xc0, 0x32,
-	0x36, 0xb2, 0xa3, 0x38, 0xe4, 0x21, 0x31, 0xc5, 0xf9, 0xc4, 0x92, 0x17, 0x7a, 0xa1, 0x54, 0xf4,
-	0xc5, 0x49, 0xd9, 0x4e, 0x9c, 0xe4, 0x2c, 0x70, 0x59, 0x3c, 0xf1, 0x03, 0xde, 0xe7, 0xd3, 0x88,
-	0x25, 0xea, 0x2f, 0x5a, 0x7b, 0x5e, 0x18, 0x7a, 0x63, 0xd6, 0x97, 0xd2, 0x46, 0x7a, 0xb7, 0xcf,
-	0xfd, 0x09, 0x4b, 0xb8, 0x33, 0x89, 0x10, 0xd0, 0x66, 0x71, 0x1c, 0xc6, 0x1a, 0xde, 0x0a, 0x9c,
-	0x49, 0xfe, 0x6d, 0x93, 0x67, 0xfa, 0x78, 0x2c, 0x12, 0xbf, 0x90, 0x24, 0x7e, 0x18, 0xa0, 0x06,
-	0x92, 0x48, 0x87, 0x67, 0xad, 0x42, 0xfb, 0x16, 0x8f, 0x99, 0x33, 0x59, 0xbd, 0xcf, 0x02, 0x9e,
-	0x90, 0x8b, 0x65, 0xb9, 0x63, 0x9c, 0xaa, 0x9e, 0x6d, 0x2d, 0x1f, 0xb7, 0x65, 0x46, 0x05, 0x0b,
-	0x2d, 0xc1, 0xac, 0x1f, 0x2a, 0xd0, 0x2a, 0x28, 0xc8, 0x05, 0x80, 0x01, 0xf3, 0xfc, 0x60, 0x30,
-	0x0e, 0x47, 0xf7, 0x3a, 0xc6, 0x29, 0xe3, 0x6c, 0x6b, 0xf9, 0x98, 0x72, 0x32, 0xd7, 0xd3, 0x02,
-	0x86, 0xbc, 0x0c, 0x0b, 0x52, 0x1a, 0x66, 0x9d, 0x8a, 0x84, 0x2f, 0x16, 0xe0, 0xc3, 0x8c, 0x6a,
-	0x2b, 0xf9, 0x04, 0x1a, 0xab, 0xc1, 0x7d, 0x36, 0x0e, 0x23, 0xd6, 0xa9, 0x22, 0x52, 0x64, 0xab,
-	0x95, 0x03, 0xfb, 0xf1, 0xac, 0x77, 0xce, 0xf3, 0xf9, 0x66, 0xba, 0x61, 0x8f, 0xc2, 0x49, 0x7f,
-	0x73, 0x1a, 0xb1, 0x78, 0xcc, 0x5c, 0x8f, 0xc5, 0xfd, 0x8d, 0x34, 0x8e, 0xc3, 0x2f, 0xfa, 0x45,
-	0x3c, 0xcd, 0xdd, 0x91, 0x17, 0xa1, 0x26, 0xc3, 0xef, 0x98, 0xd2, 0x6f, 0x4b, 0x45, 0xa0, 0xf2,
-	0x55, 0x16, 0x09, 0x09, 0xdc, 0x61, 0xd6, 0xa9, 0x95, 0x20, 0x42, 0x45, 0x95, 0x85, 0x9c, 0x13,
-	0x01, 0xba, 0x2a, 0xf3, 0xba, 0x44, 0x1d, 0xcd, 0x51, 0x2a, 0xef, 0xdc, 0x7e, 0xc9, 0xdc, 0x7a,
-	0xd8, 0x33, 0xac, 0x6f, 0x8d, 0x62, 0xb9, 0xc8, 0x0b, 0x50, 0xbf, 0xc6, 0x7c, 0x6f, 0x93, 0xcb,
-	0xc2, 0x99, 0x14, 0x25, 0xa1, 0x5f, 0x4f, 0x27, 0xc3, 0x2c, 0x91, 0x79, 0x9b, 0x14, 0x25, 0x72,
-	0x1e, 0x8e, 0xdf, 0x8c, 0x99, 0xcb, 0x46, 0x2c, 0x49, 0xc2, 0x18, 0x3f, 0x35, 0x25, 0x64, 0xa7,
-	0x81, 0x5c, 0x10, 0xde, 0x1d, 0x97, 0xc5, 0x58, 0xe7, 0x8e, 0x3d, 0x67, 0xa1, 0xad, 0xf8, 0xa7,
-	0xec, 0x14, 0x71, 0x96, 0x35, 0x4f, 0xe8, 0x59, 0xb1, 0x59, 0xdf, 0x1b, 0x79, 0xff, 0x44, 0x01,
-	0x86, 0x19, 0xfe, 0x86, 0x51, 0x2c, 0x80, 0xd6, 0xd2, 0xdc, 0x4e, 0x4e, 0x42, 0x73, 0x3d, 0xd5,
-	0x64, 0xab, 0x49, 0x97, 0x73, 0x05, 0x79, 0x09, 0xea, 0x94, 0x25, 0xe9, 0x98, 0x63, 0xac, 0x6d,
-	0xe5, 0x47, 0xe9, 0x28, 0xda, 0x48, 0x1f, 0x9a, 0xab, 0xd9, 0x88, 0x45, 0xdc, 0x0f, 0x03, 0x6c,
-	0xdd, 0x71, 0x1b, 0x67, 0x23, 0x37, 0xd0, 0x39, 0xc6, 0xba, 0x83, 0x4d, 0x24, 0x1f, 0x42, 0x7d,
-	0x98, 0x5d, 0x73, 0x92, 0x4d, 0x59, 0xd1, 0xf6, 0xe0, 0xe2, 0xd6, 0xac, 0x77, 0xe4, 0xf1, 0xac,
-	0xf7, 0xda, 0xde, 0xf4, 0xd9, 0xf0, 0x03, 0x27, 0x9e, 0xda, 0xd7, 0x58, 0x36, 0x98, 0x72, 0x96,
-	0x50, 0x74, 0x62, 0xfd, 0x69, 0xcc, 0x33, 0x27, 0xef, 0x0b, 0xdf, 0xc3, 0x69, 0xc4, 0x64, 0x0d,
-	0x16, 0x07, 0xcb, 0x4f, 0x67, 0x3d, 0x7b, 0x5f, 0x5a, 0xf6, 0x23, 0x67, 0x3a, 0x0e, 0x1d, 0xd7,
-	0x16, 0x5f, 0x52, 0xf4, 0x50, 0x88, 0xb3, 0x72, 0x08, 0x71, 0x16, 0x9a, 0x58, 0x2d, 0x11, 0x6c,
-	0x09, 0x6a, 0x6b, 0x81, 0xcb, 0x32, 0x24, 0x8f, 0x12, 0x44, 0x13, 0x6e, 0xc4, 0xbe, 0xe7, 0x07,
-	0xc8, 0x79, 0x6c, 0x82, 0xd2, 0x51, 0xb4, 0x59, 0x3f, 0x1a, 0x70, 0x54, 0x52, 0x64, 0x35, 0x63,
-	0xa3, 0x54, 0x94, 0xf9, 0x99, 0x3c, 0xfe, 0x97, 0xf9, 0x2a, 0x76, 0xd8, 0x30, 0xcb, 0xc3, 0x10,
-	0xd3, 0x52, 0xd8, 0x61, 0x05, 0x0b, 0x2d, 0xc1, 0xac, 0x77, 0xe1, 0x68, 0x41, 0xfe, 0x80, 0x4d,
-	0xf7, 0x1a, 0xc4, 0x1b, 0x77, 0xef, 0x26, 0x4c, 0xd1, 0xd2, 0xa4, 0x28, 0x59, 0xbf, 0x57, 0xa0,
-	0x55, 0x70, 0x41, 0xce, 0xe7, 0xa1, 0xef, 0x3a, 0x06, 0x03, 0xf3, 0xd1, 0xac, 0x67, 0xe4, 0x61,
-	0x17, 0x17, 0x5b, 0xfd, 0x70, 0x17, 0xdb, 0x69, 0xa8, 0xe3, 0x88, 0x2d, 0xc8, 0x5a, 0x94, 0x36,
-	0x5b, 0x7d, 0xc7, 0xb0, 0x35, 0xf6, 0x18, 0xb6, 0x33, 0xb0, 0x40, 0xd9, 0x88, 0xf9, 0x11, 0xef,
-	0x34, 0x11, 0x26, 0x7e, 0x14, 0x75, 0x54, 0x1b, 0xcb, 0x43, 0x09, 0xfb, 0x0f, 0xe5, 0x8e, 0xae,
-	0xb5, 0x9e, 0xaf, 0x6b, 0x5f, 0x1a, 0x9a, 0x9e, 0xa4, 0x03, 0x0b, 0x57, 0x36, 0x1d, 0x3f, 0x58,
-	0x5b, 0x91, 0xf5, 0x6e, 0x52, 0x2d, 0x16, 0x1a, 0x59, 0xd9, 0x9d, 0xf0, 0xd5, 0x22, 0xe1, 0xdf,
-	0x02, 0x73, 0xe8, 0x4f, 0x18, 0xae, 0x92, 0x13, 0xb6, 0xba, 0x87, 0x6d, 0x7d, 0x0f, 0xdb, 0x43,
-	0x7d, 0x0f, 0x0f, 0x1a, 0x62, 0x0e, 0xbf, 0xfa, 0xb5, 0x67, 0x50, 0xf9, 0x85, 0xf5, 0x73, 0x45,
-	0x77, 0xfc, 0xbf, 0x3c, 0xfe, 0xaf, 0x42, 0x53, 0xb6, 0x5c, 0x46, 0x57, 0x95, 0xd1, 0x2d, 0x3e,
-	0x9d, 0xf5, 0xe6, 0x4a, 0x3a, 0x3f, 0x8a, 0xa2, 0x4a, 0x61, 0x6d, 0x45, 0xd6, 0xa3, 0x49, 0xb5,
-	0x58, 0x28, 0x6a, 0x6d, 0xf7, 0xa2, 0xd6, 0x8b, 0x45, 0x2d, 0xf1, 0x61, 0x61, 0x7f, 0x3e, 0x5c,
-	0x32, 0x1f, 0x3c, 0xec, 0x1d, 0xb1, 0xbe, 0xae, 0xe0, 0x9d, 0x2c, 0xe8, 0x59, 0x1a, 0x26, 0xa4,
-	0xe7, 0xdf, 0x66, 0xff, 0x8c, 0xf8, 0xf1, 0x28, 0xd5, 0x17, 0x06, 0xbe, 0x39, 0xa4, 0x0a, 0xef,
-	0x71, 0x79, 0x26, 0xaf, 0x40, 0xfd, 0x46, 0xca, 0x05, 0xb0, 0xaa, 0x63, 0x91, 0x4b, 0x4d, 0xea,
-	0x70, 0x2e, 0x94, 0x40, 0x4e, 0x83, 0x79, 0xc5, 0x19, 0x8f, 0x91, 0x0e, 0xff, 0x53, 0x40, 0xa1,
-	0x51, 0x30, 0x69, 0x24, 0xa7, 0xa0, 0x7a, 0x3d, 0xf4, 0x70, 0x43, 0xe2, 0x9c, 0x5f, 0x0f, 0x3d,
-	0x05, 0x11, 0x26, 0xf2, 0x0e, 0x2c, 0x5e, 0x0d, 0xef, 0xb3, 0x38, 0xb8, 0x3c, 0x1a, 0x85, 0x69,
-	0xc0, 0x71, 0xc6, 0x3b, 0x0a, 0x5b, 0x32, 0xa9, 0xaf, 0xca, 0xf0, 0x4b, 0x0d, 0x51, 0x0f, 0xf9,
-	0x5c, 0x78, 0x60, 0xe8, 0x49, 0x15, 0x3d, 0xa0, 0x8c, 0xa7, 0x71, 0x20, 0x8b, 0xd2, 0xa6, 0x28,
-	0x89, 0xae, 0x5d, 0x75, 0x92, 0xdb, 0x09, 0x73, 0x91, 0xf1, 0x5a, 0x24, 0xe7, 0xa0, 0xb9, 0xee,
-	0x4c, 0xd8, 0x6a, 0xc0, 0xe3, 0x29, 0xe6, 0xde, 0xb6, 0xd5, 0xd3, 0x51, 0xea, 0xe8, 0xdc, 0x4c,
-	0x2e, 0x40, 0xe3, 0x26, 0x8b, 0x27, 0x97, 0x63, 0x2f, 0xc1, 0xec, 0x97, 0xec, 0xc2, 0x6b, 0x52,
-	0xdb, 0x68, 0x8e, 0xb2, 0xfe, 0x30, 0xa0, 0xa1, 0xd3, 0x26, 0xeb, 0xb0, 0x70, 0xd9, 0x75, 0x63,
-	0x96, 0x24, 0x2a, 0xba, 0xc1, 0x1b, 0xc8, 0xdb, 0xf3, 0x7b, 0xf3, 0x76, 0x14, 0x4f, 0x23, 0x1e,
-	0xda, 0xf8, 0x2d, 0xd5, 0x4e, 0xc8, 0x1a, 0x98, 0x2b, 0x0e, 0x77, 0x0e, 0x36, 0x04, 0xd2, 0x05,
-	0xb9, 0x0e, 0xf5, 0x61, 0x18, 0xf9, 0x23, 0x75, 0x39, 0x3c, 0x77, 0x64, 0xe8, 0xec, 0xe3, 0x30,
-	0x76, 0x97, 0x2f, 0xbe, 0x49, 0xd1, 0x87, 0xf5, 0x5d, 0x05, 0x9a, 0x39, 0x21, 0xc8, 0x59, 0x68,
-	0x08, 0x41, 0x4e, 0x57, 0x4d, 0x4e, 0x57, 0xfb, 0xe9, 0xac, 0x97, 0xeb, 0x68, 0x7e, 0x12, 0x0f,
-	0x25, 0x71, 0x96, 0x49, 0x95, 0x6e, 0x08, 0xad, 0xa5, 0xb9, 0x5d, 0x44, 0x8c, 0xb7, 0x70, 0xe5,
-	0x00, 0xb5, 0xd4, 0xab, 0xb2, 0x0b, 0x70, 0x8b, 0x3b, 0xa3, 0x7b, 0x2b, 0x2c, 0xe2, 0x9b, 0xb8,
-	0xfd, 0x0a, 0x1a, 0xb1, 0x71, 0x90, 0x57, 0xe6, 0x81, 0x36, 0x8e, 0x72, 0x62, 0x7d, 0x04, 0x64,
-	0x27, 0xc1, 0xc9, 0xdb, 0xb0, 0x88, 0xf2, 0xed, 0xc8, 0x75, 0x38, 0xc3, 0x1a, 0xfc, 0xdf, 0x96,
-	0xff, 0x9f, 0x0c, 0xd9, 0x24, 0x1a, 0x3b, 0x9c, 0x21, 0x84, 0x96, 0xb1, 0xd6, 0x67, 0x00, 0xf3,
-	0xa9, 0x3e, 0x6c, 0xaa, 0x59, 0x9f, 0x43, 0xab, 0xb0, 0x0a, 0x0e, 0xdd, 0xfd, 0x37, 0x15, 0x28,
-	0x75, 0x56, 0x9c, 0x71, 0xb1, 0xfd, 0xe3, 0xce, 0x2a, 0x1f, 0xb9, 0x37, 0x76, 0x30, 0x9e, 0x28,
-	0x1f, 0xf9, 0xc8, 0x55, 0x0f, 0x3e, 0x72, 0x4b, 0x50, 0xbb, 0xe3, 0x8c, 0x53, 0xa6, 0x1f, 0x97,
-	0x52, 0x20, 0xc7, 0xa0, 0x7a, 0xd5, 0xd1, 0x2f, 0x7f, 0x71, 0x1c, 0xbc, 0xb7, 0xb5, 0xdd, 0x35,
-	0x1e, 0x6d, 0x77, 0x8d, 0x5f, 0xb6, 0xbb, 0xc6, 0x6f, 0xdb, 0x5d, 0xe3, 0xa7, 0x27, 0x5d, 0x63,
-	0xeb, 0x49, 0xd7, 0xf8, 0x74, 0x9f, 0x14, 0x98, 0x7e, 0x14, 0xc8, 0xd3, 0x46, 0x5d, 0xde, 0xd7,
-	0xaf, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xc4, 0xac, 0x30, 0x8d, 0x0f, 0x00, 0x00,
+// The changes made to state by a block, each with its value before and after the block (absent if unset)
+type StateDiff struct {
+	Height               uint64           `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Accounts             []*AccountDiff   `protobuf:"bytes,2,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+	Storage              []*StorageDiff   `protobuf:"bytes,3,rep,name=Storage,proto3" json:"Storage,omitempty"`
+	Names                []*NameDiff      `protobuf:"bytes,4,rep,name=Names,proto3" json:"Names,omitempty"`
+	Validators           []*ValidatorDiff `protobuf:"bytes,5,rep,name=Validators,proto3" json:"Validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *StreamEvents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{23}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(m, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
 }
 
-func (m *StreamEvents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *StreamEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StateDiff) GetAccounts() []*AccountDiff {
+	if m != nil {
+		return m.Accounts
 	}
-	if len(m.StreamEvents) > 0 {
-		for iNdEx := len(m.StreamEvents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StreamEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintExec(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *StateDiff) GetStorage() []*StorageDiff {
+	if m != nil {
+		return m.Storage
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *StreamEvent) Marshal() (dAtA []byte, err error) {
+func (m *StateDiff) GetNames() []*NameDiff {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *StateDiff) GetValidators() []*ValidatorDiff {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (*StateDiff) XXX_MessageName() string {
+	return "exec.StateDiff"
+}
+
+type AccountDiff struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Before               *acm.Account                                 `protobuf:"bytes,2,opt,name=Before,proto3" json:"Before,omitempty"`
+	After                *acm.Account                                 `protobuf:"bytes,3,opt,name=After,proto3" json:"After,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *AccountDiff) Reset()         { *m = AccountDiff{} }
+func (m *AccountDiff) String() string { return proto.CompactTextString(m) }
+func (*AccountDiff) ProtoMessage()    {}
+func (*AccountDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{24}
+}
+func (m *AccountDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDiff.Merge(m, src)
+}
+func (m *AccountDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDiff proto.InternalMessageInfo
+
+func (m *AccountDiff) GetBefore() *acm.Account {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *AccountDiff) GetAfter() *acm.Account {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (*AccountDiff) XXX_MessageName() string {
+	return "exec.AccountDiff"
+}
+
+type StorageDiff struct {
+	Address              github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key                  github_com_hyperledger_burrow_binary.Word256  `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Before               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Before,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Before"`
+	After                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"After"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageDiff) Reset()         { *m = StorageDiff{} }
+func (m *StorageDiff) String() string { return proto.CompactTextString(m) }
+func (*StorageDiff) ProtoMessage()    {}
+func (*StorageDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{25}
+}
+func (m *StorageDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StorageDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDiff.Merge(m, src)
+}
+func (m *StorageDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDiff proto.InternalMessageInfo
+
+func (*StorageDiff) XXX_MessageName() string {
+	return "exec.StorageDiff"
+}
+
+type NameDiff struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Before               *names.Entry `protobuf:"bytes,2,opt,name=Before,proto3" json:"Before,omitempty"`
+	After                *names.Entry `protobuf:"bytes,3,opt,name=After,proto3" json:"After,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *NameDiff) Reset()         { *m = NameDiff{} }
+func (m *NameDiff) String() string { return proto.CompactTextString(m) }
+func (*NameDiff) ProtoMessage()    {}
+func (*NameDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{26}
+}
+func (m *NameDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NameDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameDiff.Merge(m, src)
+}
+func (m *NameDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameDiff proto.InternalMessageInfo
+
+func (m *NameDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameDiff) GetBefore() *names.Entry {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *NameDiff) GetAfter() *names.Entry {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (*NameDiff) XXX_MessageName() string {
+	return "exec.NameDiff"
+}
+
+type ValidatorDiff struct {
+	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Before               *validator.Validator                         `protobuf:"bytes,2,opt,name=Before,proto3" json:"Before,omitempty"`
+	After                *validator.Validator                         `protobuf:"bytes,3,opt,name=After,proto3" json:"After,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *ValidatorDiff) Reset()         { *m = ValidatorDiff{} }
+func (m *ValidatorDiff) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDiff) ProtoMessage()    {}
+func (*ValidatorDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{27}
+}
+func (m *ValidatorDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDiff.Merge(m, src)
+}
+func (m *ValidatorDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDiff proto.InternalMessageInfo
+
+func (m *ValidatorDiff) GetBefore() *validator.Validator {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *ValidatorDiff) GetAfter() *validator.Validator {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (*ValidatorDiff) XXX_MessageName() string {
+	return "exec.ValidatorDiff"
+}
+func init() {
+	proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
+	golang_proto.RegisterType((*StreamEvents)(nil), "exec.StreamEvents")
+	proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
+	golang_proto.RegisterType((*StreamEvent)(nil), "exec.StreamEvent")
+	proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
+	golang_proto.RegisterType((*BeginBlock)(nil), "exec.BeginBlock")
+	proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
+	golang_proto.RegisterType((*EndBlock)(nil), "exec.EndBlock")
+	proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
+	golang_proto.RegisterType((*BeginTx)(nil), "exec.BeginTx")
+	proto.RegisterType((*EndTx)(nil), "exec.EndTx")
+	golang_proto.RegisterType((*EndTx)(nil), "exec.EndTx")
+	proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
+	golang_proto.RegisterType((*TxHeader)(nil), "exec.TxHeader")
+	proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
+	golang_proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
+	proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
+	golang_proto.RegisterType((*TxExecutionKey)(nil), "exec.TxExecutionKey")
+	proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
+	golang_proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
+	proto.RegisterType((*Origin)(nil), "exec.Origin")
+	golang_proto.RegisterType((*Origin)(nil), "exec.Origin")
+	proto.RegisterType((*Header)(nil), "exec.Header")
+	golang_proto.RegisterType((*Header)(nil), "exec.Header")
+	proto.RegisterType((*Event)(nil), "exec.Event")
+	golang_proto.RegisterType((*Event)(nil), "exec.Event")
+	proto.RegisterType((*Result)(nil), "exec.Result")
+	golang_proto.RegisterType((*Result)(nil), "exec.Result")
+	proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
+	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
+	proto.RegisterType((*DecodedLog)(nil), "exec.DecodedLog")
+	golang_proto.RegisterType((*DecodedLog)(nil), "exec.DecodedLog")
+	proto.RegisterType((*DecodedArgument)(nil), "exec.DecodedArgument")
+	golang_proto.RegisterType((*DecodedArgument)(nil), "exec.DecodedArgument")
+	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
+	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
+	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
+	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
+	proto.RegisterType((*ProposalEvent)(nil), "exec.ProposalEvent")
+	golang_proto.RegisterType((*ProposalEvent)(nil), "exec.ProposalEvent")
+	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
+	golang_proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
+	proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
+	golang_proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
+	proto.RegisterType((*CallData)(nil), "exec.CallData")
+	golang_proto.RegisterType((*CallData)(nil), "exec.CallData")
+	proto.RegisterType((*StateDiff)(nil), "exec.StateDiff")
+	golang_proto.RegisterType((*StateDiff)(nil), "exec.StateDiff")
+	proto.RegisterType((*AccountDiff)(nil), "exec.AccountDiff")
+	golang_proto.RegisterType((*AccountDiff)(nil), "exec.AccountDiff")
+	proto.RegisterType((*StorageDiff)(nil), "exec.StorageDiff")
+	golang_proto.RegisterType((*StorageDiff)(nil), "exec.StorageDiff")
+	proto.RegisterType((*NameDiff)(nil), "exec.NameDiff")
+	golang_proto.RegisterType((*NameDiff)(nil), "exec.NameDiff")
+	proto.RegisterType((*ValidatorDiff)(nil), "exec.ValidatorDiff")
+	golang_proto.RegisterType((*ValidatorDiff)(nil), "exec.ValidatorDiff")
+}
+
+func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
+func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
+
+var fileDescriptor_4d737c7315c25422 = []byte{
+	// 1745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0x4f, 0xcf, 0xf4, 0x7c, 0xbd, 0x99, 0xf1, 0x47, 0xb1, 0x41, 0x2d, 0x2b, 0xec, 0x38, 0x1d,
+	0x2b, 0x98, 0x8d, 0xd3, 0x63, 0xd6, 0x18, 0x21, 0x23, 0x21, 0x76, 0xbc, 0x1b, 0x7b, 0xe3, 0x65,
+	0x6d, 0x6a, 0xc7, 0x8e, 0x82, 0xe0, 0xd0, 0x3b, 0x5d, 0xdb, 0xd3, 0xca, 0x4c, 0x57, 0xab, 0xba,
+	0xc6, 0x99, 0xf9, 0x07, 0x38, 0x70, 0xe2, 0x18, 0x2e, 0x90, 0x1b, 0x12, 0xff, 0x01, 0x9c, 0x80,
+	0x93, 0x6f, 0xe4, 0x88, 0x7c, 0x58, 0x90, 0xc3, 0x1f, 0x00, 0x47, 0x7c, 0x42, 0xf5, 0xd5, 0x1f,
+	0xeb, 0xfd, 0x08, 0x99, 0x8d, 0x94, 0xcb, 0xa8, 0xde, 0x7b, 0xbf, 0x7a, 0xfd, 0xea, 0x7d, 0x56,
+	0x0d, 0x00, 0x99, 0x93, 0x91, 0x97, 0x30, 0xca, 0x29, 0xb2, 0xc5, 0xfa, 0xca, 0x4a, 0x48, 0x43,
+	0x2a, 0x19, 0x7d, 0xb1, 0x52, 0xb2, 0x2b, 0x6f, 0x70, 0x12, 0x07, 0x84, 0x4d, 0xa3, 0x98, 0xf7,
+	0xf9, 0x22, 0x21, 0xa9, 0xfa, 0xd5, 0xd2, 0x5e, 0x48, 0x69, 0x38, 0x21, 0x7d, 0x49, 0xed, 0xcf,
+	0x0e, 0xfa, 0x3c, 0x9a, 0x92, 0x94, 0xfb, 0xd3, 0x44, 0x03, 0x5a, 0xfe, 0x68, 0xaa, 0x97, 0x1d,
+	0xc2, 0x18, 0x65, 0x66, 0x67, 0x3b, 0xf6, 0xa7, 0x99, 0x9a, 0x16, 0x9f, 0x9b, 0xe5, 0xa5, 0x44,
+	0x7c, 0x2c, 0x4d, 0x23, 0x1a, 0x6b, 0x0e, 0xa4, 0x89, 0xb1, 0xf4, 0x4a, 0x37, 0xf1, 0x17, 0x13,
+	0xea, 0x07, 0x9a, 0xbc, 0xf8, 0xd4, 0x9f, 0x44, 0x81, 0xcf, 0x29, 0x53, 0x0c, 0x77, 0x0b, 0x3a,
+	0x7b, 0x9c, 0x11, 0x7f, 0xba, 0xf5, 0x94, 0xc4, 0x3c, 0x45, 0xb7, 0xcb, 0xb4, 0x63, 0x5d, 0xad,
+	0x5e, 0x6f, 0xaf, 0x5f, 0xf6, 0xe4, 0xe1, 0x0b, 0x12, 0x5c, 0x82, 0xb9, 0xbf, 0xac, 0x42, 0xbb,
+	0xc0, 0x40, 0x37, 0x01, 0x06, 0x24, 0x8c, 0xe2, 0xc1, 0x84, 0x8e, 0x3e, 0x72, 0xac, 0xab, 0xd6,
+	0xf5, 0xf6, 0xfa, 0x25, 0xa5, 0x24, 0xe7, 0xe3, 0x02, 0x06, 0x7d, 0x1b, 0x1a, 0x92, 0x1a, 0xce,
+	0x9d, 0x8a, 0x84, 0x77, 0x0b, 0xf0, 0xe1, 0x1c, 0x1b, 0x29, 0xfa, 0x10, 0x9a, 0x5b, 0xf1, 0x53,
+	0x32, 0xa1, 0x09, 0x71, 0xaa, 0x1a, 0x29, 0xbc, 0x61, 0x98, 0x03, 0xef, 0xf9, 0x61, 0x6f, 0x2d,
+	0x8c, 0xf8, 0x78, 0xb6, 0xef, 0x8d, 0xe8, 0xb4, 0x3f, 0x5e, 0x24, 0x84, 0x4d, 0x48, 0x10, 0x12,
+	0xd6, 0xdf, 0x9f, 0x31, 0x46, 0x3f, 0xee, 0x17, 0xf1, 0x38, 0x53, 0x87, 0xde, 0x84, 0x9a, 0x34,
+	0xdf, 0xb1, 0xa5, 0xde, 0xb6, 0xb2, 0x40, 0x9d, 0x57, 0x49, 0x24, 0x24, 0x0e, 0x86, 0x73, 0xa7,
+	0x56, 0x82, 0x08, 0x16, 0x56, 0x12, 0xb4, 0x26, 0x0c, 0x0c, 0xd4, 0xc9, 0xeb, 0x12, 0x75, 0x21,
+	0x43, 0xa9, 0x73, 0x67, 0x72, 0xb4, 0x0d, 0xf5, 0xbb, 0x33, 0x96, 0x52, 0xe6, 0x34, 0xae, 0x5a,
+	0xd7, 0x3b, 0x83, 0xef, 0x3e, 0x3f, 0xec, 0xbd, 0x7b, 0xba, 0xed, 0xfb, 0x51, 0xec, 0xb3, 0x85,
+	0x77, 0x9f, 0xcc, 0x07, 0x0b, 0x4e, 0x52, 0xac, 0x15, 0xdc, 0xb1, 0x9f, 0x7d, 0xda, 0xb3, 0xdc,
+	0x3f, 0x59, 0x45, 0xcf, 0xa3, 0x6f, 0x42, 0xfd, 0x3e, 0x89, 0xc2, 0x31, 0x97, 0x31, 0xb0, 0xb1,
+	0xa6, 0x04, 0x7f, 0x77, 0x36, 0x1d, 0xce, 0x53, 0xe9, 0x42, 0x1b, 0x6b, 0x0a, 0xdd, 0x80, 0xcb,
+	0x8f, 0x18, 0x09, 0xc8, 0x88, 0xa4, 0x29, 0x65, 0x7a, 0xab, 0x2d, 0x21, 0xaf, 0x0a, 0xd0, 0x4d,
+	0xa1, 0xdd, 0x0f, 0x08, 0xd3, 0x21, 0x73, 0xbc, 0x3c, 0xf7, 0x3d, 0x95, 0xf5, 0x4a, 0x8e, 0x35,
+	0x0e, 0xbd, 0x01, 0xad, 0xdd, 0x99, 0xc9, 0xad, 0x9a, 0xd4, 0x9b, 0x33, 0x5c, 0x37, 0xf7, 0xdc,
+	0x49, 0x96, 0xbb, 0x7f, 0xb0, 0xb2, 0x44, 0x11, 0x9e, 0x1e, 0xce, 0xb5, 0x05, 0x56, 0xd1, 0xd3,
+	0x86, 0x8b, 0x33, 0xf9, 0xe9, 0x5f, 0x46, 0xd7, 0xa0, 0x8e, 0x49, 0x3a, 0x9b, 0x70, 0x7d, 0x92,
+	0x8e, 0xd2, 0xa3, 0x78, 0x58, 0xcb, 0x50, 0x1f, 0x5a, 0x5b, 0xf3, 0x11, 0x49, 0x78, 0x44, 0x63,
+	0x9d, 0x23, 0x97, 0x3d, 0x5d, 0xa4, 0x99, 0x00, 0xe7, 0x18, 0xf7, 0x89, 0xce, 0x16, 0xf4, 0x13,
+	0xa8, 0x0f, 0xe7, 0xf7, 0xfd, 0x74, 0x2c, 0xfd, 0xdd, 0x19, 0xdc, 0x7e, 0x76, 0xd8, 0x7b, 0xed,
+	0x4b, 0xc4, 0x5a, 0x29, 0x71, 0xff, 0x6b, 0xe5, 0x27, 0x47, 0xef, 0x0b, 0xdd, 0xc3, 0x45, 0x42,
+	0xa4, 0x0f, 0xba, 0x83, 0xf5, 0x97, 0x87, 0x3d, 0xef, 0xcc, 0xfc, 0xef, 0x9b, 0xc6, 0x20, 0x76,
+	0x62, 0xad, 0xa1, 0x60, 0x67, 0xe5, 0x1c, 0xec, 0x2c, 0x04, 0xb1, 0x5a, 0x4a, 0xbf, 0x15, 0xa8,
+	0x6d, 0xc7, 0x01, 0x99, 0xeb, 0xd4, 0x52, 0x84, 0x08, 0xc2, 0x43, 0x16, 0x85, 0x51, 0xec, 0xd4,
+	0x8a, 0x41, 0x50, 0x3c, 0xac, 0x65, 0xee, 0xbf, 0x2c, 0xb8, 0x20, 0x53, 0x64, 0x6b, 0x4e, 0x46,
+	0x33, 0xe1, 0xe6, 0x13, 0xb3, 0xfc, 0xab, 0xce, 0xe6, 0xdb, 0xd0, 0x19, 0xce, 0x33, 0x33, 0x44,
+	0x2d, 0x15, 0x9a, 0x65, 0x41, 0x82, 0x4b, 0x30, 0xf4, 0x16, 0xd4, 0xb3, 0x3c, 0xac, 0x1e, 0xed,
+	0x33, 0x5a, 0xe4, 0xfe, 0x18, 0x2e, 0x14, 0x36, 0x3d, 0x20, 0x8b, 0xd3, 0x6a, 0xf9, 0xe1, 0xc1,
+	0x41, 0x4a, 0x54, 0xee, 0xda, 0x58, 0x53, 0xee, 0x7f, 0x2a, 0xd0, 0x2e, 0xa8, 0x40, 0x37, 0xb2,
+	0xf3, 0x1d, 0x5b, 0x2b, 0x03, 0xfb, 0xb3, 0xc3, 0x9e, 0x95, 0x9d, 0xad, 0xd8, 0x66, 0xeb, 0xe7,
+	0xdb, 0x66, 0xf3, 0xf3, 0x37, 0x4e, 0x3c, 0x7f, 0xa1, 0x22, 0x9b, 0xa7, 0x54, 0xe4, 0xdb, 0xd0,
+	0xc0, 0x64, 0x44, 0xa2, 0x84, 0x3b, 0x2d, 0x0d, 0x13, 0x1f, 0xd5, 0x3c, 0x6c, 0x84, 0xe5, 0xca,
+	0x85, 0xb3, 0x2b, 0xf7, 0x95, 0xd0, 0xb6, 0xbf, 0x50, 0x68, 0xdd, 0x5f, 0x59, 0x26, 0x87, 0x91,
+	0x03, 0x8d, 0xbb, 0x63, 0x3f, 0x8a, 0xb7, 0x37, 0xa5, 0xbf, 0x5b, 0xd8, 0x90, 0x85, 0x40, 0x56,
+	0x8e, 0xaf, 0x8a, 0x6a, 0xb1, 0x2a, 0x7e, 0x00, 0xf6, 0x30, 0x9a, 0x12, 0xdd, 0x6f, 0xae, 0x78,
+	0xea, 0x02, 0xe1, 0x99, 0x0b, 0x84, 0x37, 0x34, 0x17, 0x88, 0x41, 0x53, 0x14, 0xeb, 0xaf, 0xff,
+	0xd1, 0xb3, 0xb0, 0xdc, 0xe1, 0xfe, 0xad, 0x02, 0xf5, 0xaf, 0x7f, 0x8f, 0x78, 0x07, 0x5a, 0x32,
+	0xe4, 0xd2, 0xba, 0xaa, 0xb4, 0xae, 0xfb, 0xf2, 0xb0, 0x97, 0x33, 0x71, 0xbe, 0x14, 0x4e, 0x95,
+	0xc4, 0xf6, 0xa6, 0xf4, 0x47, 0x0b, 0x1b, 0xb2, 0xe0, 0xd4, 0xda, 0xf1, 0x4e, 0xad, 0x17, 0x9d,
+	0x5a, 0xca, 0x87, 0xc6, 0xd9, 0xf9, 0x70, 0xc7, 0xfe, 0xe4, 0xd3, 0xde, 0x6b, 0xee, 0x5f, 0x2b,
+	0xfa, 0x86, 0x80, 0xae, 0x19, 0xd7, 0x3a, 0x56, 0x31, 0x3d, 0x8f, 0x34, 0x88, 0xb7, 0xc5, 0xc7,
+	0x93, 0x99, 0x99, 0x2a, 0xfa, 0x06, 0x24, 0x59, 0xfa, 0x56, 0x21, 0xd7, 0xe8, 0x3b, 0x50, 0x7f,
+	0x38, 0xe3, 0x02, 0x58, 0x35, 0xb6, 0xc8, 0xce, 0x37, 0xe3, 0x19, 0x52, 0x03, 0xd0, 0x5b, 0x60,
+	0xdf, 0xf5, 0x27, 0x13, 0x9d, 0x0e, 0x17, 0x15, 0x50, 0x70, 0x14, 0x4c, 0x0a, 0xd1, 0x55, 0xa8,
+	0xee, 0xd0, 0xd0, 0xa9, 0x15, 0xeb, 0x7c, 0x87, 0x86, 0x0a, 0x22, 0x44, 0xe8, 0x47, 0xd0, 0xbd,
+	0x47, 0x9f, 0x12, 0x16, 0x6f, 0x8c, 0x46, 0x74, 0x16, 0x73, 0x5d, 0xe3, 0x8e, 0xc2, 0x96, 0x44,
+	0x6a, 0x57, 0x19, 0x8e, 0xfa, 0xd0, 0x7c, 0xc4, 0x68, 0x42, 0x53, 0x7f, 0xa2, 0xfd, 0xf7, 0x0d,
+	0xb5, 0xd5, 0x70, 0xd5, 0xae, 0x0c, 0x74, 0xa7, 0x29, 0x1c, 0x28, 0xaf, 0x28, 0x9f, 0x58, 0xa6,
+	0xb4, 0x45, 0xd0, 0x30, 0xe1, 0x33, 0x16, 0x4b, 0x2f, 0x76, 0xb0, 0xa6, 0x44, 0x98, 0xef, 0xf9,
+	0xe9, 0xe3, 0x94, 0x04, 0xba, 0x44, 0x0c, 0x89, 0xd6, 0xa0, 0xb5, 0xeb, 0x4f, 0xc9, 0x56, 0xcc,
+	0xd9, 0x42, 0x3b, 0xab, 0xe3, 0xa9, 0x9b, 0xb1, 0xe4, 0xe1, 0x5c, 0x8c, 0x6e, 0x42, 0xf3, 0x11,
+	0x61, 0xd3, 0x0d, 0x16, 0xa6, 0xda, 0x5d, 0x2b, 0x5e, 0xe1, 0xb2, 0x6c, 0x64, 0x38, 0x43, 0xb9,
+	0xbf, 0xab, 0x40, 0xd3, 0xf8, 0x09, 0xed, 0x42, 0x63, 0x23, 0x08, 0x18, 0x49, 0x53, 0x65, 0xdd,
+	0xe0, 0x7b, 0x3a, 0xd1, 0x6f, 0x9c, 0x9e, 0xe8, 0x23, 0xb6, 0x48, 0x38, 0xf5, 0xf4, 0x5e, 0x6c,
+	0x94, 0xa0, 0x6d, 0xb0, 0x37, 0x7d, 0xee, 0x2f, 0x57, 0x35, 0x52, 0x05, 0xda, 0x81, 0xfa, 0x90,
+	0x26, 0xd1, 0x48, 0x8d, 0x9c, 0x2f, 0x6c, 0x99, 0x56, 0xf6, 0x01, 0x65, 0xc1, 0xfa, 0xed, 0xef,
+	0x63, 0xad, 0x03, 0xad, 0x41, 0x63, 0x93, 0x8c, 0x68, 0x40, 0x02, 0xc7, 0x2e, 0xe6, 0xa9, 0x66,
+	0xee, 0xd0, 0x10, 0x1b, 0x80, 0xfb, 0x18, 0x20, 0x67, 0x23, 0x04, 0xb6, 0x70, 0xb7, 0x6e, 0x70,
+	0x72, 0x8d, 0x6e, 0x41, 0x6b, 0x83, 0x85, 0xb3, 0xa9, 0x6c, 0xf0, 0x15, 0xd9, 0x36, 0x5f, 0x2f,
+	0xe9, 0x33, 0x52, 0x9c, 0xe3, 0xdc, 0x10, 0x2e, 0x1e, 0x91, 0x1e, 0xab, 0x1b, 0x81, 0x2d, 0xdb,
+	0x44, 0x45, 0xf1, 0x4c, 0x4b, 0x90, 0x35, 0x4d, 0x02, 0x99, 0x0f, 0x4d, 0x6c, 0x48, 0x81, 0x7e,
+	0x7f, 0xef, 0xe1, 0xae, 0xee, 0x14, 0x72, 0xed, 0xfe, 0xb6, 0x02, 0xad, 0xac, 0x5a, 0xd0, 0x75,
+	0x68, 0x0a, 0x42, 0xea, 0xac, 0xc9, 0xd6, 0xd3, 0x79, 0x79, 0xd8, 0xcb, 0x78, 0x38, 0x5b, 0x89,
+	0xab, 0xa6, 0x58, 0xcb, 0x00, 0x96, 0xc6, 0xa7, 0xe1, 0xe2, 0x4c, 0x8e, 0x76, 0xcc, 0x0c, 0xd0,
+	0xa1, 0xfe, 0x72, 0x79, 0x63, 0xe6, 0xc8, 0x2a, 0xc0, 0x1e, 0xf7, 0x47, 0x1f, 0x6d, 0x92, 0x84,
+	0x8f, 0xf5, 0x68, 0x28, 0x70, 0x44, 0x3b, 0xd6, 0x35, 0x64, 0x2f, 0xd5, 0x8e, 0x95, 0x12, 0xf7,
+	0xa7, 0x80, 0x5e, 0xad, 0x7e, 0xf4, 0x43, 0xe8, 0x6a, 0xfa, 0x71, 0x12, 0xf8, 0x9c, 0x68, 0x1f,
+	0xbc, 0xee, 0xc9, 0xa7, 0xe6, 0x90, 0x4c, 0x93, 0x89, 0xcf, 0x89, 0x86, 0xe0, 0x32, 0xd6, 0xfd,
+	0xb7, 0x05, 0xdd, 0x52, 0x5b, 0x40, 0x1f, 0x42, 0xc7, 0x30, 0xe4, 0x20, 0xb1, 0x96, 0xb1, 0xbc,
+	0xa4, 0x0a, 0xdd, 0xcd, 0xbf, 0xb5, 0xc7, 0x85, 0xa5, 0x22, 0x06, 0x17, 0xd6, 0xbf, 0xe5, 0x99,
+	0x59, 0x36, 0xf0, 0x27, 0x13, 0xca, 0xbd, 0x12, 0x08, 0x97, 0xf7, 0xa0, 0x37, 0xc1, 0x7e, 0x42,
+	0x79, 0xfe, 0xbe, 0x34, 0x7b, 0x05, 0x13, 0x4b, 0x91, 0x78, 0x4f, 0x7c, 0x10, 0xf1, 0x71, 0xc0,
+	0xfc, 0x8f, 0x95, 0xe7, 0x9b, 0x38, 0x67, 0xb8, 0x3f, 0x07, 0xc8, 0xbb, 0xfc, 0x79, 0x77, 0x12,
+	0xf7, 0x17, 0xd0, 0x2e, 0x8c, 0x86, 0x73, 0x57, 0xff, 0x9b, 0x0a, 0x94, 0x92, 0x59, 0xac, 0x09,
+	0x5b, 0x4a, 0xb7, 0xd6, 0x91, 0x69, 0x23, 0xcb, 0x95, 0x86, 0xd2, 0x91, 0x75, 0xd4, 0xea, 0xf2,
+	0x1d, 0x75, 0x05, 0x6a, 0x4f, 0xfc, 0xc9, 0x8c, 0x98, 0x17, 0x89, 0x24, 0xd0, 0x25, 0xa8, 0xde,
+	0xf3, 0xcd, 0x73, 0x51, 0x2c, 0xdd, 0xe7, 0x16, 0xb4, 0x64, 0x8e, 0x6c, 0x46, 0x07, 0x07, 0x27,
+	0x5e, 0xc9, 0xdf, 0x85, 0xa6, 0x2e, 0x01, 0xd3, 0x02, 0xf5, 0x44, 0xd7, 0x5c, 0xb1, 0x19, 0x67,
+	0x10, 0xf4, 0x0e, 0x34, 0xf6, 0x38, 0x65, 0x7e, 0x48, 0xca, 0x4f, 0x08, 0xcd, 0x94, 0x68, 0x83,
+	0x40, 0xd7, 0xa0, 0x26, 0x7a, 0xa1, 0x18, 0x69, 0xd5, 0xbc, 0x0d, 0x09, 0x96, 0xc4, 0x29, 0x21,
+	0xba, 0x05, 0xf0, 0xc4, 0xfc, 0xd5, 0x63, 0xde, 0x19, 0x7a, 0x42, 0x67, 0x7c, 0x89, 0x2f, 0xc0,
+	0xdc, 0xdf, 0x5b, 0xd0, 0x2e, 0x58, 0x78, 0xee, 0x13, 0xf0, 0x1a, 0xd4, 0x07, 0xe4, 0x80, 0x32,
+	0x92, 0xbd, 0xb2, 0xc5, 0x9f, 0x5d, 0xa6, 0x6b, 0x68, 0x19, 0x72, 0xa1, 0xb6, 0x71, 0xc0, 0x09,
+	0x73, 0xaa, 0xc7, 0x80, 0x94, 0xc8, 0xfd, 0x4b, 0x05, 0xda, 0xda, 0x21, 0x5f, 0x89, 0xa5, 0xef,
+	0x41, 0xf5, 0x01, 0x59, 0xfc, 0x7f, 0x49, 0x7a, 0x64, 0xba, 0x0a, 0x05, 0xa2, 0x39, 0xeb, 0x13,
+	0x2f, 0xf7, 0xee, 0xd7, 0xae, 0x79, 0x60, 0x5c, 0xb3, 0x54, 0xab, 0xd7, 0x3e, 0x1c, 0x43, 0xd3,
+	0x64, 0xcd, 0xb1, 0xc3, 0xf6, 0xd5, 0x68, 0x15, 0xef, 0x59, 0x27, 0x46, 0xab, 0x08, 0xd2, 0x5f,
+	0xfa, 0xa3, 0x05, 0xdd, 0x52, 0xd6, 0x9d, 0x7b, 0xbc, 0x6e, 0x1c, 0xb1, 0x75, 0xc5, 0xcb, 0xff,
+	0xe8, 0xcc, 0xbe, 0x9c, 0xd9, 0xbc, 0x56, 0xb6, 0xf9, 0x78, 0xb0, 0x82, 0x0c, 0xde, 0x7b, 0xf6,
+	0x62, 0xd5, 0xfa, 0xec, 0xc5, 0xaa, 0xf5, 0xf7, 0x17, 0xab, 0xd6, 0x3f, 0x5f, 0xac, 0x5a, 0x7f,
+	0xfe, 0x7c, 0xd5, 0x7a, 0xf6, 0xf9, 0xaa, 0xf5, 0xb3, 0x33, 0x4c, 0x25, 0xe6, 0x55, 0x28, 0x57,
+	0xfb, 0x75, 0xf9, 0x60, 0xbb, 0xf5, 0xbf, 0x01, 0x00, 0x54, 0xa7, 0xe9, 0x5e, 0x47, 0x16, 0x00,
+	0x00,
+}
+
+func (m *StreamEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StreamEvents) > 0 {
+		for iNdEx := len(m.StreamEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1517,6 +2096,18 @@ func (m *StreamEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Cursor != nil {
+		{
+			size := m.Cursor.Size()
+			i -= size
+			if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.EndBlock != nil {
 		{
 			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
@@ -1616,6 +2207,11 @@ func (m *BeginBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NumEvents != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.NumEvents))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PredecessorHeight != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.PredecessorHeight))
 		i--
@@ -1871,6 +2467,20 @@ func (m *BlockExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.PredecessorHeight != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.PredecessorHeight))
 		i--
@@ -2214,7 +2824,19 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GovernAccount != nil {
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GovernAccount != nil {
 		{
 			size, err := m.GovernAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -2376,6 +2998,18 @@ func (m *LogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decoded != nil {
+		{
+			size, err := m.Decoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Topics) > 0 {
 		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2413,6 +3047,112 @@ func (m *LogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DecodedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Arguments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedArgument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedArgument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedArgument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JSON) > 0 {
+		i -= len(m.JSON)
+		copy(dAtA[i:], m.JSON)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.JSON)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Indexed {
+		i--
+		if m.Indexed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CallEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2521,6 +3261,70 @@ func (m *GovernAccountEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Withdrawn {
+		i--
+		if m.Withdrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Vote != nil {
+		{
+			size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalState != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.ProposalState))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ProposalHash.Size()
+		i -= size
+		if _, err := m.ProposalHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *InputEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2662,165 +3466,507 @@ func (m *CallData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintExec(dAtA []byte, offset int, v uint64) int {
-	offset -= sovExec(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StreamEvents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.StreamEvents) > 0 {
-		for _, e := range m.StreamEvents {
-			l = e.Size()
-			n += 1 + l + sovExec(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *StateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BeginBlock != nil {
-		l = m.BeginBlock.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if m.BeginTx != nil {
-		l = m.BeginTx.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Envelope != nil {
-		l = m.Envelope.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.EndTx != nil {
-		l = m.EndTx.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.EndBlock != nil {
-		l = m.EndBlock.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Height != 0 {
+		i = encodeVarintExec(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BeginBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NumTxs != 0 {
-		n += 1 + sovExec(uint64(m.NumTxs))
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PredecessorHeight != 0 {
-		n += 1 + sovExec(uint64(m.PredecessorHeight))
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StorageDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size := m.After.Size()
+		i -= size
+		if _, err := m.After.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Before.Size()
+		i -= size
+		if _, err := m.Before.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Key.Size()
+		i -= size
+		if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BeginTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NameDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NameDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TxHeader != nil {
-		l = m.TxHeader.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Exception != nil {
-		l = m.Exception.Size()
-		n += 1 + l + sovExec(uint64(l))
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.NumEvents != 0 {
-		n += 1 + sovExec(uint64(m.NumEvents))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.After != nil {
+		{
+			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Before != nil {
+		{
+			size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EndTx) Size() (n int) {
+func encodeVarintExec(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExec(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamEvents) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TxHash.Size()
-	n += 1 + l + sovExec(uint64(l))
+	if len(m.StreamEvents) > 0 {
+		for _, e := range m.StreamEvents {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TxHeader) Size() (n int) {
+func (m *StreamEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TxType != 0 {
-		n += 1 + sovExec(uint64(m.TxType))
-	}
-	l = m.TxHash.Size()
-	n += 1 + l + sovExec(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
+	if m.BeginBlock != nil {
+		l = m.BeginBlock.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.BeginTx != nil {
+		l = m.BeginTx.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.EndTx != nil {
+		l = m.EndTx.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.EndBlock != nil {
+		l = m.EndBlock.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeginBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovExec(uint64(m.NumTxs))
+	}
+	if m.PredecessorHeight != 0 {
+		n += 1 + sovExec(uint64(m.PredecessorHeight))
+	}
+	if m.NumEvents != 0 {
+		n += 1 + sovExec(uint64(m.NumEvents))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EndBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeginTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxHeader != nil {
+		l = m.TxHeader.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.NumEvents != 0 {
+		n += 1 + sovExec(uint64(m.NumEvents))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EndTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovExec(uint64(m.TxType))
+	}
+	l = m.TxHash.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
 	}
 	if m.Index != 0 {
 		n += 1 + sovExec(uint64(m.Index))
@@ -2857,6 +4003,12 @@ func (m *BlockExecution) Size() (n int) {
 	if m.PredecessorHeight != 0 {
 		n += 1 + sovExec(uint64(m.PredecessorHeight))
 	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3013,6 +4165,10 @@ func (m *Event) Size() (n int) {
 		l = m.GovernAccount.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3062,6 +4218,59 @@ func (m *LogEvent) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecodedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, e := range m.Arguments {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecodedArgument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Indexed {
+		n += 2
+	}
+	l = len(m.JSON)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3110,6 +4319,30 @@ func (m *GovernAccountEvent) Size() (n int) {
 	return n
 }
 
+func (m *ProposalEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProposalHash.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.ProposalState != 0 {
+		n += 1 + sovExec(uint64(m.ProposalState))
+	}
+	if m.Vote != nil {
+		l = m.Vote.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Withdrawn {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *InputEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3162,55 +4395,187 @@ func (m *CallData) Size() (n int) {
 	return n
 }
 
-func sovExec(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozExec(x uint64) (n int) {
-	return sovExec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *StreamEvent) GetValue() interface{} {
-	if this.BeginBlock != nil {
-		return this.BeginBlock
+func (m *StateDiff) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if this.BeginTx != nil {
-		return this.BeginTx
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
 	}
-	if this.Envelope != nil {
-		return this.Envelope
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
 	}
-	if this.Event != nil {
-		return this.Event
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
 	}
-	if this.EndTx != nil {
-		return this.EndTx
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
 	}
-	if this.EndBlock != nil {
-		return this.EndBlock
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
 	}
-	return nil
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
-func (this *StreamEvent) SetValue(value interface{}) bool {
-	switch vt := value.(type) {
-	case *BeginBlock:
-		this.BeginBlock = vt
-	case *BeginTx:
-		this.BeginTx = vt
-	case *github_com_hyperledger_burrow_txs.Envelope:
-		this.Envelope = vt
-	case *Event:
-		this.Event = vt
-	case *EndTx:
-		this.EndTx = vt
-	case *EndBlock:
-		this.EndBlock = vt
-	default:
-		this.Event = new(Event)
-		if set := this.Event.SetValue(value); set {
-			return true
-		}
-		this.Event = nil
-		return false
+func (m *AccountDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Before.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NameDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Before != nil {
+		l = m.Before.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovExec(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExec(x uint64) (n int) {
+	return sovExec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StreamEvent) GetValue() interface{} {
+	if this.BeginBlock != nil {
+		return this.BeginBlock
+	}
+	if this.BeginTx != nil {
+		return this.BeginTx
+	}
+	if this.Envelope != nil {
+		return this.Envelope
+	}
+	if this.Event != nil {
+		return this.Event
+	}
+	if this.EndTx != nil {
+		return this.EndTx
+	}
+	if this.EndBlock != nil {
+		return this.EndBlock
+	}
+	if this.Cursor != nil {
+		return this.Cursor
+	}
+	return nil
+}
+
+func (this *StreamEvent) SetValue(value interface{}) bool {
+	switch vt := value.(type) {
+	case *BeginBlock:
+		this.BeginBlock = vt
+	case *BeginTx:
+		this.BeginTx = vt
+	case *github_com_hyperledger_burrow_txs.Envelope:
+		this.Envelope = vt
+	case *Event:
+		this.Event = vt
+	case *EndTx:
+		this.EndTx = vt
+	case *EndBlock:
+		this.EndBlock = vt
+	case *github_com_hyperledger_burrow_binary.HexBytes:
+		this.Cursor = vt
+	default:
+		this.Event = new(Event)
+		if set := this.Event.SetValue(value); set {
+			return true
+		}
+		this.Event = nil
+		return false
 	}
 	return true
 }
@@ -3233,6 +4598,9 @@ func (this *Event) GetValue() interface{} {
 	if this.GovernAccount != nil {
 		return this.GovernAccount
 	}
+	if this.Proposal != nil {
+		return this.Proposal
+	}
 	return nil
 }
 
@@ -3250,6 +4618,8 @@ func (this *Event) SetValue(value interface{}) bool {
 		this.Log = vt
 	case *GovernAccountEvent:
 		this.GovernAccount = vt
+	case *ProposalEvent:
+		this.Proposal = vt
 	default:
 		return false
 	}
@@ -3286,7 +4656,1942 @@ func (m *StreamEvents) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamEvents = append(m.StreamEvents, &StreamEvent{})
+			if err := m.StreamEvents[len(m.StreamEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeginBlock == nil {
+				m.BeginBlock = &BeginBlock{}
+			}
+			if err := m.BeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeginTx == nil {
+				m.BeginTx = &BeginTx{}
+			}
+			if err := m.BeginTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTx == nil {
+				m.EndTx = &EndTx{}
+			}
+			if err := m.EndTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndBlock == nil {
+				m.EndBlock = &EndBlock{}
+			}
+			if err := m.EndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.HexBytes
+			m.Cursor = &v
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
+			}
+			m.PredecessorHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PredecessorHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
+			}
+			m.NumEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxHeader == nil {
+				m.TxHeader = &TxHeader{}
+			}
+			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
+			}
+			m.NumEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEvents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &Origin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
+			}
+			m.PredecessorHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PredecessorHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxExecutionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxExecutionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxHeader == nil {
+				m.TxHeader = &TxHeader{}
+			}
+			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &txs.Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Origin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Origin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Origin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3313,8 +6618,10 @@ func (m *StreamEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamEvents = append(m.StreamEvents, &StreamEvent{})
-			if err := m.StreamEvents[len(m.StreamEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3340,7 +6647,7 @@ func (m *StreamEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamEvent) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3363,15 +6670,15 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3398,16 +6705,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeginBlock == nil {
-				m.BeginBlock = &BeginBlock{}
+			if m.Header == nil {
+				m.Header = &Header{}
 			}
-			if err := m.BeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3434,16 +6741,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeginTx == nil {
-				m.BeginTx = &BeginTx{}
+			if m.Input == nil {
+				m.Input = &InputEvent{}
 			}
-			if err := m.BeginTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3470,16 +6777,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			if m.Output == nil {
+				m.Output = &OutputEvent{}
 			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3506,16 +6813,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &Event{}
+			if m.Call == nil {
+				m.Call = &CallEvent{}
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3542,16 +6849,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTx == nil {
-				m.EndTx = &EndTx{}
+			if m.Log == nil {
+				m.Log = &LogEvent{}
 			}
-			if err := m.EndTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3578,86 +6885,16 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndBlock == nil {
-				m.EndBlock = &EndBlock{}
+			if m.GovernAccount == nil {
+				m.GovernAccount = &GovernAccountEvent{}
 			}
-			if err := m.EndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BeginBlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BeginBlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginBlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3679,126 +6916,18 @@ func (m *BeginBlock) Unmarshal(dAtA []byte) error {
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &types.Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
-			}
-			m.NumTxs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumTxs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
-			}
-			m.PredecessorHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PredecessorHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EndBlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EndBlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndBlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Proposal == nil {
+				m.Proposal = &ProposalEvent{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -3821,7 +6950,7 @@ func (m *EndBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeginTx) Unmarshal(dAtA []byte) error {
+func (m *Result) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3844,17 +6973,17 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeginTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3864,31 +6993,48 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxHeader == nil {
-				m.TxHeader = &TxHeader{}
-			}
-			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
+			if m.Return == nil {
+				m.Return = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3915,16 +7061,16 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &Result{}
+			if m.NameEntry == nil {
+				m.NameEntry = &names.Entry{}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3951,32 +7097,13 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
+			if m.PermArgs == nil {
+				m.PermArgs = &permission.PermArgs{}
 			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
-			}
-			m.NumEvents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumEvents |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -3999,7 +7126,7 @@ func (m *BeginTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndTx) Unmarshal(dAtA []byte) error {
+func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4022,15 +7149,15 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4057,83 +7184,13 @@ func (m *EndTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxHeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxHeader: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxHeader: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
-			}
-			m.TxType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4160,15 +7217,15 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4178,33 +7235,30 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.Topics = append(m.Topics, v)
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4231,10 +7285,10 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &Origin{}
+			if m.Decoded == nil {
+				m.Decoded = &DecodedLog{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4260,7 +7314,7 @@ func (m *TxHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockExecution) Unmarshal(dAtA []byte) error {
+func (m *DecodedLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4283,36 +7337,17 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4322,31 +7357,27 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &types.Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4373,30 +7404,11 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
-			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Arguments = append(m.Arguments, &DecodedArgument{})
+			if err := m.Arguments[len(m.Arguments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorHeight", wireType)
-			}
-			m.PredecessorHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PredecessorHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4419,7 +7431,7 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
+func (m *DecodedArgument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4442,17 +7454,17 @@ func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxExecutionKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedArgument: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxExecutionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedArgument: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4462,16 +7474,61 @@ func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexed", wireType)
 			}
-			m.Offset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4481,11 +7538,44 @@ func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Indexed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4508,7 +7598,7 @@ func (m *TxExecutionKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxExecution) Unmarshal(dAtA []byte) error {
+func (m *CallEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4531,15 +7621,15 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4566,18 +7656,18 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxHeader == nil {
-				m.TxHeader = &TxHeader{}
+			if m.CallData == nil {
+				m.CallData = &CallData{}
 			}
-			if err := m.TxHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CallData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4587,33 +7677,30 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
-			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
 			}
-			var msglen int
+			m.StackDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4623,31 +7710,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StackDepth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, &Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4657,33 +7729,30 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
 			}
-			var msglen int
+			m.CallType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4693,67 +7762,65 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CallType |= CallType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExec
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Receipt == nil {
-				m.Receipt = &txs.Receipt{}
-			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
-			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernAccountEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernAccountEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4780,8 +7847,10 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
-			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccountUpdate == nil {
+				m.AccountUpdate = &spec.TemplateAccount{}
+			}
+			if err := m.AccountUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4807,7 +7876,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Origin) Unmarshal(dAtA []byte) error {
+func (m *ProposalEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4830,17 +7899,17 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Origin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Origin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4850,29 +7919,30 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if err := m.ProposalHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalState", wireType)
 			}
-			m.Height = 0
+			m.ProposalState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -4882,33 +7952,14 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.ProposalState |= payload.Ballot_ProposalState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4935,10 +7986,33 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vote == nil {
+				m.Vote = &payload.Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawn = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -4961,7 +8035,7 @@ func (m *Origin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *InputEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4984,34 +8058,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: InputEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
-			}
-			m.TxType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5038,104 +8093,66 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
-			}
-			m.EventType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventType |= EventType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExec
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthExec
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5145,25 +8162,22 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
-			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5189,7 +8203,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *CallData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5212,17 +8226,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5232,33 +8246,30 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5268,33 +8279,30 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &InputEvent{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5304,33 +8312,30 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &OutputEvent{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5340,69 +8345,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Call == nil {
-				m.Call = &CallEvent{}
-			}
-			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Log == nil {
-				m.Log = &LogEvent{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			var msglen int
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5412,28 +8364,11 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GovernAccount == nil {
-				m.GovernAccount = &GovernAccountEvent{}
-			}
-			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5456,7 +8391,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *StateDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5479,17 +8414,36 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5499,31 +8453,31 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
-			if m.Return == nil {
-				m.Return = []byte{}
+			m.Accounts = append(m.Accounts, &AccountDiff{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			m.GasUsed = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5533,14 +8487,29 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, &StorageDiff{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5567,16 +8536,14 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NameEntry == nil {
-				m.NameEntry = &names.Entry{}
-			}
-			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Names = append(m.Names, &NameDiff{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5603,10 +8570,8 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PermArgs == nil {
-				m.PermArgs = &permission.PermArgs{}
-			}
-			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, &ValidatorDiff{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5632,7 +8597,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEvent) Unmarshal(dAtA []byte) error {
+func (m *AccountDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5655,10 +8620,10 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5696,9 +8661,9 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5708,30 +8673,33 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Before == nil {
+				m.Before = &acm.Account{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5741,24 +8709,25 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_hyperledger_burrow_binary.Word256
-			m.Topics = append(m.Topics, v)
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.After == nil {
+				m.After = &acm.Account{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5784,7 +8753,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallEvent) Unmarshal(dAtA []byte) error {
+func (m *StorageDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5807,17 +8776,17 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5827,31 +8796,28 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CallData == nil {
-				m.CallData = &CallData{}
-			}
-			if err := m.CallData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5878,15 +8844,15 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
-			m.StackDepth = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -5896,14 +8862,28 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StackDepth |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5930,29 +8910,10 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
-			}
-			m.CallType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CallType |= CallType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5975,7 +8936,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
+func (m *NameDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5998,17 +8959,17 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernAccountEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: NameDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernAccountEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NameDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6018,84 +8979,29 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccountUpdate == nil {
-				m.AccountUpdate = &spec.TemplateAccount{}
-			}
-			if err := m.AccountUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6105,81 +9011,33 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Before == nil {
+				m.Before = &names.Entry{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OutputEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutputEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6189,22 +9047,25 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.After == nil {
+				m.After = &names.Entry{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6230,7 +9091,7 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallData) Unmarshal(dAtA []byte) error {
+func (m *ValidatorDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6253,15 +9114,15 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6288,15 +9149,15 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6306,30 +9167,33 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Before == nil {
+				m.Before = &validator.Validator{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -6339,63 +9203,28 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.After == nil {
+				m.After = &validator.Validator{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -0,0 +1,70 @@
+package exec
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bin "github.com/hyperledger/burrow/binary"
+)
+
+const (
+	streamCursorVersion = 1
+	streamCursorLength  = 1 + 8 + 8
+)
+
+// StreamCursor is the position of a StreamEvent in the stream of all StreamEvents: the height of its block and its
+// offset within that block's StreamEvents (BeginBlock has offset 0). Clients should treat the encoded form as opaque.
+type StreamCursor struct {
+	Height uint64
+	Offset uint64
+}
+
+func (sc StreamCursor) Bytes() bin.HexBytes {
+	bs := make([]byte, streamCursorLength)
+	bs[0] = streamCursorVersion
+	binary.BigEndian.PutUint64(bs[1:], sc.Height)
+	binary.BigEndian.PutUint64(bs[9:], sc.Offset)
+	return bs
+}
+
+// Returns true if the event at sc comes after the event at other in the stream
+func (sc StreamCursor) After(other StreamCursor) bool {
+	return sc.Height > other.Height || (sc.Height == other.Height && sc.Offset > other.Offset)
+}
+
+func (sc StreamCursor) String() string {
+	return fmt.Sprintf("StreamCursor{Height: %d, Offset: %d}", sc.Height, sc.Offset)
+}
+
+func DecodeStreamCursor(bs []byte) (StreamCursor, error) {
+	if len(bs) != streamCursorLength || bs[0] != streamCursorVersion {
+		return StreamCursor{}, fmt.Errorf("invalid stream cursor %X", bs)
+	}
+	return StreamCursor{
+		Height: binary.BigEndian.Uint64(bs[1:]),
+		Offset: binary.BigEndian.Uint64(bs[9:]),
+	}, nil
+}
+
+// Tracks the cursor of each StreamEvent consumed from a stream of whole blocks
+type StreamCursorTracker struct {
+	cursor StreamCursor
+}
+
+// Returns the cursor for ev which must be the next StreamEvent in the stream
+func (tracker *StreamCursorTracker) Next(ev *StreamEvent) StreamCursor {
+	if ev.BeginBlock != nil {
+		tracker.cursor = StreamCursor{Height: ev.BeginBlock.Height}
+	} else {
+		tracker.cursor.Offset++
+	}
+	return tracker.cursor
+}
+
+// Returns a copy of ev with its Cursor set, ev itself is not modified since it may be shared with other subscribers
+func (ev *StreamEvent) WithCursor(cursor StreamCursor) *StreamEvent {
+	evCopy := *ev
+	bs := cursor.Bytes()
+	evCopy.Cursor = &bs
+	return &evCopy
+}
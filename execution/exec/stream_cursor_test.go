@@ -0,0 +1,75 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestStreamCursor(t *testing.T) {
+	cursor := StreamCursor{Height: 34, Offset: 7}
+	decoded, err := DecodeStreamCursor(cursor.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = DecodeStreamCursor([]byte{1, 2, 3})
+	require.Error(t, err)
+
+	assert.True(t, StreamCursor{Height: 34, Offset: 8}.After(cursor))
+	assert.True(t, StreamCursor{Height: 35}.After(cursor))
+	assert.False(t, cursor.After(cursor))
+	assert.False(t, StreamCursor{Height: 33, Offset: 100}.After(cursor))
+}
+
+func TestStreamCursorTracker(t *testing.T) {
+	be := &BlockExecution{Height: 3}
+	be.AppendTxs(&TxExecution{TxHeader: &TxHeader{}}, &TxExecution{TxHeader: &TxHeader{}})
+	var tracker StreamCursorTracker
+	for i, ev := range be.StreamEvents() {
+		assert.Equal(t, StreamCursor{Height: 3, Offset: uint64(i)}, tracker.Next(ev))
+	}
+	next := &BlockExecution{Height: 5}
+	assert.Equal(t, StreamCursor{Height: 5}, tracker.Next(next.StreamEvents()[0]))
+}
+
+func TestBlockAccumulator_Cursor(t *testing.T) {
+	be := &BlockExecution{Height: 3}
+	var tracker StreamCursorTracker
+	var evs []*StreamEvent
+	for _, ev := range be.StreamEvents() {
+		evs = append(evs, ev.WithCursor(tracker.Next(ev)))
+	}
+	ba := NewBlockAccumulator()
+	block, err := ba.ConsumeBlockExecution(&StreamEvents{StreamEvents: evs})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), block.Height)
+	assert.Equal(t, *evs[len(evs)-1].Cursor, ba.Cursor())
+
+	// Starting mid-block is an error
+	_, err = NewBlockAccumulator().ConsumeBlockExecution(&StreamEvents{StreamEvents: evs[1:]})
+	require.Error(t, err)
+}
+
+func TestStreamEvent_StoredEncoding(t *testing.T) {
+	// StreamEvents are stored in state so their encoding must not change when no cursor is set
+	bs, err := (&StreamEvent{BeginBlock: &BeginBlock{Height: 5, NumTxs: 2, PredecessorHeight: 3}}).Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, "0A06080518022003", hex.EncodeUpperToString(bs))
+
+	ev := &StreamEvent{EndBlock: &EndBlock{Height: 5}}
+	bs, err = ev.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, "32020805", hex.EncodeUpperToString(bs))
+
+	// But it is carried on the stream
+	bs, err = ev.WithCursor(StreamCursor{Height: 5, Offset: 1}).Marshal()
+	require.NoError(t, err)
+	evOut := new(StreamEvent)
+	require.NoError(t, evOut.Unmarshal(bs))
+	require.NotNil(t, evOut.Cursor)
+	cursor, err := DecodeStreamCursor(*evOut.Cursor)
+	require.NoError(t, err)
+	assert.Equal(t, StreamCursor{Height: 5, Offset: 1}, cursor)
+}
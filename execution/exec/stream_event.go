@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
 )
@@ -80,12 +81,16 @@ type BlockAccumulator struct {
 	block *BlockExecution
 	// Number of txs expected in current block
 	numTxs uint64
+	// Number of block-level events expected in current block
+	numEvents uint64
 	// Height of last block consumed that contained transactions
 	previousNonEmptyBlockHeight uint64
 	// Accumulator for Txs
 	stack TxStack
 	// Continuity requirements for the stream
 	continuity ContinuityOpt
+	// Cursor of the last EndBlock consumed
+	cursor binary.HexBytes
 }
 
 func NewBlockAccumulator(continuityOptions ...ContinuityOpt) *BlockAccumulator {
@@ -129,16 +134,27 @@ func (ba *BlockAccumulator) Consume(ev *StreamEvent) (*BlockExecution, error) {
 		}
 		// If we are consuming blocks over the event stream (rather than from state) we may see empty blocks
 		// by definition empty blocks will not be a predecessor
-		if ev.BeginBlock.NumTxs > 0 {
+		if ev.BeginBlock.NumTxs > 0 || ev.BeginBlock.NumEvents > 0 {
 			ba.previousNonEmptyBlockHeight = ev.BeginBlock.Height
 		}
 		ba.numTxs = ev.BeginBlock.NumTxs
+		ba.numEvents = ev.BeginBlock.NumEvents
 		ba.block = &BlockExecution{
 			Height:            ev.BeginBlock.Height,
 			PredecessorHeight: ev.BeginBlock.PredecessorHeight,
 			Header:            ev.BeginBlock.Header,
 			TxExecutions:      make([]*TxExecution, 0, ba.numTxs),
 		}
+	case ba.block == nil:
+		return nil, fmt.Errorf("BlockAccumulator.Consume received %v before any BeginBlock, a stream consumed as "+
+			"blocks must start at a block boundary (resume from the cursor of an EndBlock)", ev.EventType())
+	case ev.Event != nil && ba.stack.Length() == 0:
+		// An event outside of any transaction belongs to the block itself
+		if !ba.continuity.Allows(NonConsecutiveEvents) && uint64(len(ba.block.Events)) != ev.Event.Header.Index {
+			return nil, fmt.Errorf("BlockAccumulator.Consume recieved block event with index %d at "+
+				"position %d in the event stream", ev.Event.GetHeader().GetIndex(), len(ba.block.Events))
+		}
+		ba.block.Events = append(ba.block.Events, ev.Event)
 	case ev.BeginTx != nil, ev.Envelope != nil, ev.Event != nil, ev.EndTx != nil:
 		txe, err := ba.stack.Consume(ev)
 		if err != nil {
@@ -157,11 +173,24 @@ func (ba *BlockAccumulator) Consume(ev *StreamEvent) (*BlockExecution, error) {
 				"transactions for block %d, expected: %d, received: %d",
 				ba.block.Height, ba.numTxs, len(ba.block.TxExecutions))
 		}
+		if !ba.continuity.Allows(NonConsecutiveEvents) && uint64(len(ba.block.Events)) != ba.numEvents {
+			return nil, fmt.Errorf("BlockAccumulator.Consume did not receive the expected number of "+
+				"block events for block %d, expected: %d, received: %d",
+				ba.block.Height, ba.numEvents, len(ba.block.Events))
+		}
+		if ev.Cursor != nil {
+			ba.cursor = *ev.Cursor
+		}
 		return ba.block, nil
 	}
 	return nil, nil
 }
 
+// Returns the cursor of the end of the last block returned by Consume, or nil if the stream did not provide cursors
+func (ba *BlockAccumulator) Cursor() binary.HexBytes {
+	return ba.cursor
+}
+
 // TxStack is able to consume potentially nested txs
 type TxStack struct {
 	// Stack of TxExecutions, top of stack is TxExecution receiving innermost events
@@ -224,6 +253,10 @@ func (stack *TxStack) Consume(ev *StreamEvent) (*TxExecution, error) {
 		txe.Envelope = ev.Envelope
 		txe.Receipt = txe.Envelope.Tx.GenerateReceipt()
 	case ev.Event != nil:
+		if stack.Length() == 0 {
+			// Events outside of any transaction belong to the block so are not our concern
+			return nil, nil
+		}
 		txe, err := stack.Peek()
 		if err != nil {
 			return nil, err
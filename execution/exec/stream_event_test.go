@@ -50,6 +50,10 @@ func TestConsumeBlockExecution(t *testing.T) {
 		NewTxExecution(txs.Enclose(genesisDoc.ChainID(), newCallTx(0, 2))),
 		NewTxExecution(txs.Enclose(genesisDoc.ChainID(), newCallTx(2, 1))),
 	)
+	be.Proposal(&ProposalEvent{
+		ProposalHash:  crypto.Keccak256([]byte("proposal")),
+		ProposalState: payload.Ballot_EXPIRED,
+	})
 
 	stack := NewBlockAccumulator()
 	var beOut *BlockExecution
@@ -22,6 +22,7 @@ func EventStringAccountCall(addr crypto.Address) string    { return fmt.Sprintf(
 func EventStringLogEvent(addr crypto.Address) string       { return fmt.Sprintf("Log/%s", addr) }
 func EventStringTxExecution(txHash []byte) string          { return fmt.Sprintf("Execution/Tx/%X", txHash) }
 func EventStringGovernAccount(addr *crypto.Address) string { return fmt.Sprintf("Govern/Acc/%v", addr) }
+func EventStringProposal(proposalHash []byte) string       { return fmt.Sprintf("Proposal/%X", proposalHash) }
 
 func NewTxExecution(txEnv *txs.Envelope) *TxExecution {
 	return &TxExecution{
@@ -128,6 +129,13 @@ func (txe *TxExecution) GovernAccount(governAccount *GovernAccountEvent, excepti
 	})
 }
 
+func (txe *TxExecution) Proposal(proposal *ProposalEvent, exception *errors.Exception) {
+	txe.Append(&Event{
+		Header:   txe.Header(TypeProposal, EventStringProposal(proposal.ProposalHash), exception),
+		Proposal: proposal,
+	})
+}
+
 // Errors pushed to TxExecutions end up in merkle state so it is essential that they are deterministic and independent
 // of the code path taken to execution (e.g. replay takes a different path to that of normal consensus reactor so stack
 // traces may differ - as they may across architectures)
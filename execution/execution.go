@@ -6,6 +6,8 @@ package execution
 import (
 	"context"
 	"fmt"
+	"io"
+	"math/big"
 	"runtime/debug"
 	"sync"
 
@@ -20,9 +22,13 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/fees"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/liveness"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/execution/staking"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
@@ -30,6 +36,7 @@ import (
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -50,8 +57,12 @@ type ExecutorState interface {
 	acmstate.MetadataReader
 	names.Reader
 	registry.Reader
-	proposal.Reader
+	proposal.IterableReader
+	proposal.ExpiryIterable
+	liveness.Reader
+	staking.IterableReader
 	validator.IterableReader
+	governance.Reader
 }
 type BatchExecutor interface {
 	// Provides access to write lock for a BatchExecutor so reads can be prevented for the duration of a commit
@@ -67,8 +78,12 @@ type BatchExecutor interface {
 // Executes transactions
 type BatchCommitter interface {
 	BatchExecutor
+	// Record which validators signed the previous block and any evidence of misbehaviour, jailing validators as needed
+	RecordLiveness(lastCommit abci.LastCommitInfo, byzantineValidators []abci.Evidence) error
 	// Commit execution results to underlying State and provide opportunity to mutate state before it is saved
 	Commit(header *types.Header) (stateHash []byte, err error)
+	// Returns the consensus parameters changed by governance in the current block, or nil if they have not changed
+	ConsensusParamUpdates() *abci.ConsensusParams
 }
 
 type executor struct {
@@ -81,23 +96,39 @@ type executor struct {
 	nameRegCache     *names.Cache
 	nodeRegCache     *registry.Cache
 	proposalRegCache *proposal.Cache
+	livenessCache    *liveness.Cache
+	stakingCache     *staking.Cache
+	feePool          *fees.Pool
+	governanceCache  *governance.Cache
 	validatorCache   *validator.Cache
 	emitter          *event.Emitter
 	block            *exec.BlockExecution
 	logger           *logging.Logger
 	vmOptions        evm.Options
 	contexts         map[payload.Type]contexts.Context
+	upgradeContexts  []upgradeContext
 }
 
 type Params struct {
-	ChainID           string
-	ProposalThreshold uint64
+	ChainID  string
+	Liveness liveness.Params
+	Staking  staking.Params
+	// The execution parameters in force until changed by governance
+	Execution *governance.ExecutionParams
 }
 
 func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) Params {
 	return Params{
-		ChainID:           genesisDoc.ChainID(),
-		ProposalThreshold: genesisDoc.Params.ProposalThreshold,
+		ChainID: genesisDoc.ChainID(),
+		Liveness: liveness.Params{
+			Window:          genesisDoc.Params.LivenessWindow,
+			MaxMissedBlocks: genesisDoc.Params.MaxMissedBlocks,
+			JailBlocks:      genesisDoc.Params.JailBlocks,
+		},
+		Staking: staking.Params{
+			UnbondingBlocks: genesisDoc.Params.UnbondingBlocks,
+		},
+		Execution: governance.ExecutionParamsFromGenesis(genesisDoc),
 	}
 }
 
@@ -135,6 +166,10 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		nameRegCache:     names.NewCache(backend),
 		nodeRegCache:     registry.NewCache(backend),
 		proposalRegCache: proposal.NewCache(backend),
+		livenessCache:    liveness.NewCache(backend),
+		stakingCache:     staking.NewCache(backend),
+		feePool:          new(fees.Pool),
+		governanceCache:  governance.NewCache(backend, params.Execution),
 		validatorCache:   validator.NewCache(backend),
 		emitter:          emitter,
 		block: &exec.BlockExecution{
@@ -153,6 +188,8 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			Blockchain:    blockchain,
 			State:         exe.stateCache,
 			MetadataState: exe.metadataCache,
+			Params:        exe.governanceCache,
+			Fees:          exe.feePool,
 			RunCall:       runCall,
 			Logger:        exe.logger,
 		},
@@ -164,6 +201,8 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			Blockchain: blockchain,
 			State:      exe.stateCache,
 			NameReg:    exe.nameRegCache,
+			Params:     exe.governanceCache,
+			Fees:       exe.feePool,
 			Logger:     exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
@@ -171,18 +210,38 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			Logger: exe.logger,
 		},
 		payload.TypeGovernance: &contexts.GovernanceContext{
+			Blockchain:   blockchain,
 			ValidatorSet: exe.validatorCache,
+			Liveness:     exe.livenessCache,
+			Staking:      exe.stakingCache,
 			State:        exe.stateCache,
+			Params:       exe.governanceCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeBond: &contexts.BondContext{
+			ValidatorSet:   exe.validatorCache,
+			State:          exe.stateCache,
+			Liveness:       exe.livenessCache,
+			LivenessParams: params.Liveness,
+			Upgrades:       exe.governanceCache,
+			Staking:        exe.stakingCache,
+			Logger:         exe.logger,
+		},
+		payload.TypeUnbond: &contexts.UnbondContext{
+			Blockchain:   blockchain,
+			Params:       params.Staking,
+			Upgrades:     exe.governanceCache,
 			ValidatorSet: exe.validatorCache,
 			State:        exe.stateCache,
+			Liveness:     exe.livenessCache,
+			Staking:      exe.stakingCache,
 			Logger:       exe.logger,
 		},
-		payload.TypeUnbond: &contexts.UnbondContext{
+		payload.TypeUnjail: &contexts.UnjailContext{
+			Blockchain:   blockchain,
+			Params:       params.Liveness,
+			Liveness:     exe.livenessCache,
 			ValidatorSet: exe.validatorCache,
-			State:        exe.stateCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeIdentify: &contexts.IdentifyContext{
@@ -190,16 +249,28 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			StateReader: exe.stateCache,
 			Logger:      exe.logger,
 		},
+		payload.TypeMultiSig: &contexts.MultiSigContext{
+			State:  exe.stateCache,
+			Logger: exe.logger,
+		},
+		payload.TypeRotateKey: &contexts.RotateKeyContext{
+			State:        exe.stateCache,
+			ValidatorSet: exe.validatorCache,
+			Liveness:     exe.livenessCache,
+			Staking:      exe.stakingCache,
+			Logger:       exe.logger,
+		},
 	}
 
 	exe.contexts = map[payload.Type]contexts.Context{
 		payload.TypeProposal: &contexts.ProposalContext{
-			ChainID:           params.ChainID,
-			ProposalThreshold: params.ProposalThreshold,
-			State:             exe.stateCache,
-			ProposalReg:       exe.proposalRegCache,
-			Logger:            exe.logger,
-			Contexts:          baseContexts,
+			ChainID:      params.ChainID,
+			Params:       exe.governanceCache,
+			State:        exe.stateCache,
+			ValidatorSet: exe.validatorCache,
+			ProposalReg:  exe.proposalRegCache,
+			Logger:       exe.logger,
+			Contexts:     baseContexts,
 		},
 	}
 
@@ -208,6 +279,14 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		exe.contexts[k] = v
 	}
 
+	// Switch to contexts introduced by upgrades once they activate
+	for _, uc := range exe.upgradeContexts {
+		uc.Params = exe.governanceCache
+		uc.Blockchain = blockchain
+		uc.Before = exe.contexts[uc.Type]
+		exe.contexts[uc.Type] = uc.UpgradeContext
+	}
+
 	return exe, nil
 }
 
@@ -233,13 +312,28 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 
 	logger.InfoMsg("Executing transaction", "tx", txEnv.String())
 
-	// Verify transaction signature against inputs
-	err = txEnv.Verify(exe.params.ChainID)
+	payingFees, err := exe.payingFees()
+	if err != nil {
+		return nil, err
+	}
+	// Verify transaction signature against inputs and the current keys of their accounts
+	if payingFees {
+		err = txEnv.VerifyPayingFees(exe.params.ChainID, exe.stateCache)
+	} else {
+		err = txEnv.Verify(exe.params.ChainID, exe.stateCache)
+	}
 	if err != nil {
 		logger.InfoMsg("Transaction Verify failed", structure.ErrorKey, err)
 		return nil, err
 	}
 
+	// Reject transactions offering too little to pay for themselves or asking for too much gas
+	err = exe.checkLimits(txEnv.Tx.Payload)
+	if err != nil {
+		logger.InfoMsg("Transaction limits check failed", structure.ErrorKey, err)
+		return nil, err
+	}
+
 	if txExecutor, ok := exe.contexts[txEnv.Tx.Type()]; ok {
 		// Establish new TxExecution
 		txe := exe.block.Tx(txEnv)
@@ -330,7 +424,32 @@ func (exe *executor) updateSignatory(sig txs.Signatory) error {
 	} else if acc == nil {
 		return fmt.Errorf("account %s does not exist", sig.Address)
 	}
+	if sig.MultiSig != nil {
+		switch {
+		case acc.MultiSig != nil:
+			if !acc.MultiSig.Equal(sig.MultiSig) {
+				return fmt.Errorf("signatory members %v do not match the current members of account %v: %v",
+					sig.MultiSig, acc.Address, acc.MultiSig)
+			}
+		case !acc.PublicKey.IsSet() && sig.MultiSig.Address() == acc.Address:
+			// First use of the multi-signature account so record its members as we do public keys
+			acc.MultiSig = sig.MultiSig
+		default:
+			return fmt.Errorf("account %v is not a multi-signature account for %v", acc.Address, sig.MultiSig)
+		}
+		return exe.stateCache.UpdateAccount(acc)
+	}
+	if acc.MultiSig != nil {
+		return fmt.Errorf("account %v is a multi-signature account so must be signed by its members", acc.Address)
+	}
 	// Important that verify has been run against signatories at this point
+	if acc.PublicKey.IsSet() {
+		if !acc.PublicKey.Equal(*sig.PublicKey) {
+			return fmt.Errorf("unexpected mismatch between current key %v of account %v and supplied public key %v",
+				acc.PublicKey, acc.Address, sig.PublicKey)
+		}
+		return nil
+	}
 	if sig.PublicKey.GetAddress() != acc.Address {
 		return fmt.Errorf("unexpected mismatch between address %v and supplied public key %v",
 			acc.Address, sig.PublicKey)
@@ -339,6 +458,203 @@ func (exe *executor) updateSignatory(sig txs.Signatory) error {
 	return exe.stateCache.UpdateAccount(acc)
 }
 
+// Checks the transaction against the minimum fees and maximum gas limit of the execution parameters in force
+func (exe *executor) checkLimits(pay payload.Payload) error {
+	params, err := exe.governanceCache.GetExecutionParams()
+	if err != nil {
+		return err
+	}
+	err = fees.Params{
+		MinimumFee:      params.MinimumFee,
+		MinimumGasPrice: params.MinimumGasPrice,
+	}.Check(pay)
+	if err != nil {
+		return err
+	}
+	if tx, ok := pay.(*payload.CallTx); ok && params.MaxGasLimit > 0 && tx.GasLimit > params.MaxGasLimit {
+		return errors.Errorf(errors.Codes.ExcessiveGasLimit, "CallTx has gas limit %d but the maximum is %d",
+			tx.GasLimit, params.MaxGasLimit)
+	}
+	return nil
+}
+
+// RecordLiveness tracks whether each validator signed the previous block, jailing those that have missed too many
+// blocks, and once governance.JailingUpgrade has activated permanently jails any validator that consensus has reported
+// as misbehaving
+func (exe *executor) RecordLiveness(lastCommit abci.LastCommitInfo, byzantineValidators []abci.Evidence) error {
+	height := exe.block.Height
+	tracker := &liveness.Tracker{
+		Params:     exe.params.Liveness,
+		Liveness:   exe.livenessCache,
+		Validators: exe.validatorCache,
+		Logger:     exe.logger.WithScope("RecordLiveness"),
+	}
+	// Validators that have since lost all their power cannot be jailed so we need only look up current validators
+	publicKeys := make(map[crypto.Address]crypto.PublicKey)
+	err := exe.validatorCache.CurrentSet().IterateValidators(func(id crypto.Addressable, _ *big.Int) error {
+		publicKeys[id.GetAddress()] = id.GetPublicKey()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	jailing, err := governance.Activated(exe.governanceCache, governance.JailingUpgrade, height)
+	if err != nil {
+		return err
+	}
+	if !jailing {
+		// Evidence was ignored before the upgrade so must continue to be in blocks before it
+		byzantineValidators = nil
+	}
+	for _, ev := range byzantineValidators {
+		address, err := crypto.AddressFromBytes(ev.Validator.Address)
+		if err != nil {
+			return fmt.Errorf("could not read address of misbehaving validator: %w", err)
+		}
+		var publicKey *crypto.PublicKey
+		if pk, ok := publicKeys[address]; ok {
+			publicKey = &pk
+		}
+		err = tracker.Tombstone(height, address, publicKey)
+		if err != nil {
+			return err
+		}
+	}
+	for _, vote := range lastCommit.Votes {
+		address, err := crypto.AddressFromBytes(vote.Validator.Address)
+		if err != nil {
+			return fmt.Errorf("could not read address of voting validator: %w", err)
+		}
+		publicKey, ok := publicKeys[address]
+		if !ok {
+			continue
+		}
+		err = tracker.Signed(height, publicKey, vote.SignedLastBlock)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unbondings are only ever released at a later height than the one at which they were made so those due are all in
+// committed state
+func (exe *executor) releaseUnbondings(height uint64) error {
+	var released []*staking.Unbonding
+	// Unbondings are iterated in order of release height so we can stop at the first still to be released
+	err := exe.state.IterateUnbondings(func(unbonding *staking.Unbonding) error {
+		if unbonding.ReleaseHeight > height {
+			return io.EOF
+		}
+		released = append(released, unbonding)
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	for _, unbonding := range released {
+		account, err := exe.stateCache.GetAccount(unbonding.Delegator)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("could not find account %v to release %v", unbonding.Delegator, unbonding)
+		}
+		err = account.AddToBalance(unbonding.Amount)
+		if err != nil {
+			return err
+		}
+		err = exe.stateCache.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		exe.logger.InfoMsg("Released unbonding", "delegator", unbonding.Delegator,
+			"validator", unbonding.Validator, "amount", unbonding.Amount, "height", height)
+		unbonding.Amount = 0
+		err = exe.stakingCache.UpdateUnbonding(unbonding)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exe *executor) expireProposals(height uint64) error {
+	var expired [][]byte
+	err := exe.state.IterateExpiringProposals(height, func(proposalHash []byte) error {
+		expired = append(expired, proposalHash)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, proposalHash := range expired {
+		// The proposal may have been executed in this block
+		ballot, err := exe.proposalRegCache.GetProposal(proposalHash)
+		if err != nil {
+			return err
+		}
+		if ballot == nil || ballot.ProposalState != payload.Ballot_PROPOSED {
+			continue
+		}
+		ballot.ProposalState = payload.Ballot_EXPIRED
+		err = exe.proposalRegCache.UpdateProposal(proposalHash, ballot)
+		if err != nil {
+			return err
+		}
+		exe.block.Proposal(&exec.ProposalEvent{
+			ProposalHash:  proposalHash,
+			ProposalState: payload.Ballot_EXPIRED,
+		})
+		exe.logger.InfoMsg("Proposal expired", "proposal_hash", binary.HexBytes(proposalHash),
+			"height", height)
+	}
+	return nil
+}
+
+// Returns whether transaction fees are paid to validators in the current block, before which they are burnt
+func (exe *executor) payingFees() (bool, error) {
+	return governance.Activated(exe.governanceCache, governance.FeesUpgrade, exe.block.Height)
+}
+
+// Fees are shared between the validators with power in the block in which they were paid
+func (exe *executor) distributeFees(height uint64) error {
+	total := exe.feePool.Take()
+	payingFees, err := exe.payingFees()
+	if err != nil || !payingFees {
+		return err
+	}
+	shares, err := fees.Distribute(total, exe.validatorCache.CurrentSet())
+	if err != nil {
+		return err
+	}
+	for _, share := range shares {
+		address := share.PublicKey.GetAddress()
+		account, err := exe.stateCache.GetAccount(address)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			// Validators need not have an account until they are paid
+			account = &acm.Account{
+				Address:   address,
+				PublicKey: share.PublicKey,
+			}
+		}
+		err = account.AddToBalance(share.Amount)
+		if err != nil {
+			return err
+		}
+		err = exe.stateCache.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		exe.logger.TraceMsg("Paid fees to validator", "validator", address, "amount", share.Amount,
+			"height", height)
+	}
+	return nil
+}
+
 // Commit the current state - optionally pass in the tendermint ABCI header for that to be included with the BeginBlock
 // StreamEvent
 func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error) {
@@ -352,6 +668,21 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 	// Capture height
 	height := exe.block.Height
 	exe.logger.InfoMsg("Executor committing", "height", exe.block.Height)
+	// Return balances whose unbonding period ends at this height
+	err = exe.releaseUnbondings(height)
+	if err != nil {
+		return nil, err
+	}
+	// Pay this block's fees to the validators
+	err = exe.distributeFees(height)
+	if err != nil {
+		return nil, err
+	}
+	// Expire proposals that can no longer be voted on
+	err = exe.expireProposals(height)
+	if err != nil {
+		return nil, err
+	}
 	// Form BlockExecution for this block from TxExecutions and Tendermint block header
 	blockExecution, err := exe.finaliseBlockExecution(header)
 	if err != nil {
@@ -381,6 +712,18 @@ func (exe *executor) Commit(header *types.Header) (stateHash []byte, err error)
 		if err != nil {
 			return err
 		}
+		err = exe.livenessCache.Sync(ws)
+		if err != nil {
+			return err
+		}
+		err = exe.stakingCache.Sync(ws)
+		if err != nil {
+			return err
+		}
+		err = exe.governanceCache.Sync(ws)
+		if err != nil {
+			return err
+		}
 		err = exe.validatorCache.Sync(ws)
 		if err != nil {
 			return err
@@ -417,10 +760,22 @@ func (exe *executor) Reset() error {
 	exe.nameRegCache.Reset(exe.state)
 	exe.nodeRegCache.Reset(exe.state)
 	exe.proposalRegCache.Reset(exe.state)
+	exe.livenessCache.Reset(exe.state)
+	exe.stakingCache.Reset(exe.state)
+	exe.feePool.Reset()
+	exe.governanceCache.Reset(exe.state)
 	exe.validatorCache.Reset(exe.state)
 	return nil
 }
 
+func (exe *executor) ConsensusParamUpdates() *abci.ConsensusParams {
+	params := exe.governanceCache.ConsensusParamsUpdate()
+	if params == nil {
+		return nil
+	}
+	return params.ABCI()
+}
+
 // executor exposes access to the underlying state cache protected by a RWMutex that prevents access while locked
 // (during an ABCI commit). while access can occur (and needs to continue for CheckTx/DeliverTx to make progress)
 // through calls to Execute() external readers will be blocked until the executor is unlocked that allows the Transactor
@@ -454,11 +809,11 @@ func (exe *executor) finaliseBlockExecution(header *types.Header) (*exec.BlockEx
 	// Set the header when provided
 	be.Header = header
 	// My default the predecessor of the next block is the is the predecessor of the current block
-	// (in case the current block has no transactions - since we do not currently store empty blocks in state, see
+	// (in case the current block is empty - since we do not currently store empty blocks in state, see
 	// /execution/state/events.go)
 	predecessor := be.PredecessorHeight
-	if len(be.TxExecutions) > 0 {
-		// If the current block has transactions then it will be the predecessor of the next block
+	if !be.IsEmpty() {
+		// If the current block has transactions or events then it will be the predecessor of the next block
 		predecessor = be.Height
 	}
 	// Start new execution for the next height
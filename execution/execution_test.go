@@ -1578,6 +1578,10 @@ type testExecutor struct {
 }
 
 func makeExecutor(state *state.State) *testExecutor {
+	return makeExecutorWithParams(state, ParamsFromGenesis(testGenesisDoc))
+}
+
+func makeExecutorWithParams(state *state.State, params Params, options ...Option) *testExecutor {
 	testDB, err := dbm.NewDB("test", dbBackend, ".")
 	if err != nil {
 		panic(err)
@@ -1587,8 +1591,7 @@ func makeExecutor(state *state.State) *testExecutor {
 	if err != nil {
 		panic(err)
 	}
-	executor, err := newExecutor("makeExecutorCache", true, ParamsFromGenesis(testGenesisDoc), state,
-		blockchain, nil, logger)
+	executor, err := newExecutor("makeExecutorCache", true, params, state, blockchain, nil, logger, options...)
 	if err != nil {
 		panic(err)
 	}
@@ -1649,6 +1652,10 @@ func (te *testExecutor) signExecuteCommit(tx payload.Payload, signers ...acm.Add
 	if err != nil {
 		return err
 	}
+	return te.executeCommit(txEnv)
+}
+
+func (te *testExecutor) executeCommit(txEnv *txs.Envelope) error {
 	txe, err := te.Execute(txEnv)
 	if err != nil {
 		return err
@@ -0,0 +1,150 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package fees
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Params setting the least a transaction must pay to be accepted
+type Params struct {
+	// The minimum fee a CallTx or NameTx must offer
+	MinimumFee uint64
+	// The minimum price per unit of gas a CallTx must offer, where the price is its fee divided by its gas limit
+	MinimumGasPrice uint64
+}
+
+// MinimumFor returns the least fee the transaction must offer, which is zero for transactions that carry no fee
+func (p Params) MinimumFor(pay payload.Payload) uint64 {
+	switch tx := pay.(type) {
+	case *payload.CallTx:
+		gasFee := new(big.Int).Mul(new(big.Int).SetUint64(tx.GasLimit), new(big.Int).SetUint64(p.MinimumGasPrice))
+		if !gasFee.IsUint64() {
+			return ^uint64(0)
+		}
+		if gasFee.Uint64() > p.MinimumFee {
+			return gasFee.Uint64()
+		}
+		return p.MinimumFee
+	case *payload.NameTx:
+		return p.MinimumFee
+	}
+	return 0
+}
+
+// Check returns an error if the transaction offers less than its minimum fee
+func (p Params) Check(pay payload.Payload) error {
+	minimum := p.MinimumFor(pay)
+	if minimum == 0 {
+		return nil
+	}
+	if fee := Of(pay); fee < minimum {
+		return errors.Errorf(errors.Codes.InsufficientFee, "%v offers a fee of %d but must offer at least %d",
+			pay.Type(), fee, minimum)
+	}
+	return nil
+}
+
+// Of returns the fee offered by the transaction
+func Of(pay payload.Payload) uint64 {
+	switch tx := pay.(type) {
+	case *payload.CallTx:
+		return tx.Fee
+	case *payload.NameTx:
+		return tx.Fee
+	}
+	return 0
+}
+
+// Pool accumulates the fees paid by the transactions in a block
+type Pool struct {
+	sync.Mutex
+	total uint64
+}
+
+func (pool *Pool) Add(fee uint64) {
+	pool.Lock()
+	defer pool.Unlock()
+	pool.total += fee
+}
+
+// Reset empties the pool of fees from transactions that will not be committed
+func (pool *Pool) Reset() {
+	pool.Lock()
+	defer pool.Unlock()
+	pool.total = 0
+}
+
+// Take returns the fees pooled so far and empties the pool
+func (pool *Pool) Take() uint64 {
+	pool.Lock()
+	defer pool.Unlock()
+	total := pool.total
+	pool.total = 0
+	return total
+}
+
+type Share struct {
+	PublicKey crypto.PublicKey
+	Amount    uint64
+}
+
+// Distribute divides amount between validators in proportion to their power. Each share is rounded down and what is
+// left over goes to the validator with the most power. Shares are returned in validator address order.
+func Distribute(amount uint64, validators validator.Iterable) ([]*Share, error) {
+	var shares []*Share
+	var powers []*big.Int
+	totalPower := new(big.Int)
+	err := validators.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		if power.Sign() > 0 {
+			shares = append(shares, &Share{PublicKey: id.GetPublicKey()})
+			powers = append(powers, power)
+			totalPower.Add(totalPower, power)
+		}
+		return nil
+	})
+	if err != nil || amount == 0 || len(shares) == 0 {
+		return nil, err
+	}
+	sort.Sort(byAddress{shares, powers})
+	total := new(big.Int).SetUint64(amount)
+	remainder := amount
+	largest := 0
+	for i, power := range powers {
+		share := new(big.Int).Mul(total, power)
+		shares[i].Amount = share.Div(share, totalPower).Uint64()
+		remainder -= shares[i].Amount
+		if power.Cmp(powers[largest]) > 0 {
+			largest = i
+		}
+	}
+	shares[largest].Amount += remainder
+	return shares, nil
+}
+
+type byAddress struct {
+	shares []*Share
+	powers []*big.Int
+}
+
+func (ba byAddress) Len() int {
+	return len(ba.shares)
+}
+
+func (ba byAddress) Less(i, j int) bool {
+	return bytes.Compare(ba.shares[i].PublicKey.GetAddress().Bytes(), ba.shares[j].PublicKey.GetAddress().Bytes()) < 0
+}
+
+func (ba byAddress) Swap(i, j int) {
+	ba.shares[i], ba.shares[j] = ba.shares[j], ba.shares[i]
+	ba.powers[i], ba.powers[j] = ba.powers[j], ba.powers[i]
+}
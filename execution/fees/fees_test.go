@@ -0,0 +1,70 @@
+package fees
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	params := Params{MinimumFee: 10, MinimumGasPrice: 2}
+	from := acm.GeneratePrivateAccountFromSecret("from").GetPublicKey()
+
+	tx := payload.NewCallTxWithSequence(from, nil, nil, 100, 3, 9, 1)
+	assert.Equal(t, uint64(10), params.MinimumFor(tx))
+	assert.Equal(t, errors.Codes.InsufficientFee, errors.GetCode(params.Check(tx)))
+	tx.Fee = 10
+	require.NoError(t, params.Check(tx))
+
+	// The gas price applies once it asks for more than the minimum fee
+	tx.GasLimit = 100
+	assert.Equal(t, uint64(200), params.MinimumFor(tx))
+	require.Error(t, params.Check(tx))
+
+	tx.GasLimit = ^uint64(0)
+	assert.Equal(t, ^uint64(0), params.MinimumFor(tx))
+
+	assert.Equal(t, uint64(0), params.MinimumFor(payload.NewSendTx()))
+	require.NoError(t, Params{}.Check(payload.NewCallTxWithSequence(from, nil, nil, 0, 100, 0, 1)))
+}
+
+func TestDistribute(t *testing.T) {
+	vs := validator.NewSet()
+	var keys []*acm.PrivateAccount
+	for i, secret := range []string{"a", "b", "c", "d"} {
+		key := acm.GeneratePrivateAccountFromSecret(secret)
+		keys = append(keys, key)
+		vs.ChangePower(key.GetPublicKey(), big.NewInt(int64(i)))
+	}
+
+	shares, err := Distribute(100, vs)
+	require.NoError(t, err)
+	// The validator without power gets nothing
+	require.Len(t, shares, 3)
+	amounts := make(map[string]uint64)
+	var total uint64
+	for i, share := range shares {
+		if i > 0 {
+			assert.True(t, bytes.Compare(shares[i-1].PublicKey.GetAddress().Bytes(),
+				share.PublicKey.GetAddress().Bytes()) < 0)
+		}
+		amounts[share.PublicKey.GetAddress().String()] = share.Amount
+		total += share.Amount
+	}
+	assert.Equal(t, uint64(100), total)
+	assert.Equal(t, uint64(16), amounts[keys[1].GetAddress().String()])
+	assert.Equal(t, uint64(33), amounts[keys[2].GetAddress().String()])
+	// The largest validator gets the remainder
+	assert.Equal(t, uint64(51), amounts[keys[3].GetAddress().String()])
+
+	shares, err = Distribute(0, vs)
+	require.NoError(t, err)
+	assert.Empty(t, shares)
+}
@@ -0,0 +1,138 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeesBeforeUpgrade(t *testing.T) {
+	exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+	sender := accounts[0]
+	receiver := accounts[1].GetAddress()
+	senderBalance := exe.getAccount(t, sender.GetAddress()).Balance
+	receiverBalance := exe.getAccount(t, receiver).Balance
+	validatorBalances := make([]uint64, len(validators))
+	for i, val := range validators {
+		validatorBalances[i] = exe.getAccount(t, val.GetAddress()).Balance
+	}
+
+	// An Ethereum transaction sent before fees were paid signs for its whole input amount and pays no fee
+	tx := payload.NewCallTxWithSequence(sender.GetPublicKey(), &receiver, nil, 10, 2, 0,
+		exe.getAccount(t, sender.GetAddress()).Sequence+1)
+	tx.GasPrice = balance.NativeToWei(1).Uint64()
+	txEnv := txs.Enclose(testChainID, tx)
+	txEnv.Encoding = txs.Envelope_RLP
+	require.NoError(t, txEnv.Sign(sender))
+	require.NoError(t, exe.executeCommit(txEnv))
+	assert.Equal(t, senderBalance-10, exe.getAccount(t, sender.GetAddress()).Balance)
+
+	// The fee of a CallTx is burnt
+	tx = payload.NewCallTxWithSequence(sender.GetPublicKey(), &receiver, nil, 10, 2, 3,
+		exe.getAccount(t, sender.GetAddress()).Sequence+1)
+	require.NoError(t, exe.signExecuteCommit(tx, sender))
+	assert.Equal(t, senderBalance-20, exe.getAccount(t, sender.GetAddress()).Balance)
+	assert.Equal(t, uint64(10+7), exe.getAccount(t, receiver).Balance-receiverBalance)
+
+	// And a NameTx is only charged its value
+	nameTx := payload.NewNameTxWithSequence(sender.GetPublicKey(), "foo", "bar", 1000, 4,
+		exe.getAccount(t, sender.GetAddress()).Sequence+1)
+	require.NoError(t, exe.signExecuteCommit(nameTx, sender))
+	assert.Equal(t, senderBalance-1016, exe.getAccount(t, sender.GetAddress()).Balance)
+
+	for i, val := range validators {
+		assert.Equal(t, validatorBalances[i], exe.getAccount(t, val.GetAddress()).Balance)
+	}
+}
+
+func TestFees(t *testing.T) {
+	exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+	exe.activateUpgrade(t, accounts[0], governance.FeesUpgrade)
+	exe.params.Execution.MinimumFee = 5
+	exe.params.Execution.MinimumGasPrice = 1
+	sender := accounts[0]
+	receiver := accounts[1].GetAddress()
+	senderBalance := exe.getAccount(t, sender.GetAddress()).Balance
+	validatorBalances := make([]uint64, len(validators))
+	for i, val := range validators {
+		validatorBalances[i] = exe.getAccount(t, val.GetAddress()).Balance
+	}
+
+	// A gas limit of 10 at a gas price of 1 requires a fee of at least 10
+	tx := payload.NewCallTxWithSequence(sender.GetPublicKey(), &receiver, nil, 109, 10, 9,
+		exe.getAccount(t, sender.GetAddress()).Sequence+1)
+	err := exe.signExecuteCommit(tx, sender)
+	require.Error(t, err)
+	assert.Equal(t, errors.Codes.InsufficientFee, errors.GetCode(err))
+
+	tx.Fee = 22
+	tx.Input.Amount = 122
+	require.NoError(t, exe.signExecuteCommit(tx, sender))
+	assert.Equal(t, senderBalance-122, exe.getAccount(t, sender.GetAddress()).Balance)
+
+	// Validators of equal power share the fee with the remainder going to one of them
+	var paid uint64
+	for i, val := range validators {
+		share := exe.getAccount(t, val.GetAddress()).Balance - validatorBalances[i]
+		assert.Contains(t, []uint64{7, 8}, share)
+		paid += share
+	}
+	assert.Equal(t, uint64(22), paid)
+
+	// Fees are only paid once
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	paid = 0
+	for i, val := range validators {
+		paid += exe.getAccount(t, val.GetAddress()).Balance - validatorBalances[i]
+	}
+	assert.Equal(t, uint64(22), paid)
+}
+
+func TestFeesEthereumTx(t *testing.T) {
+	exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+	exe.activateUpgrade(t, accounts[0], governance.FeesUpgrade)
+	sender := accounts[0]
+	receiver := accounts[1].GetAddress()
+	senderBalance := exe.getAccount(t, sender.GetAddress()).Balance
+
+	// Once fees are paid an Ethereum transaction must pay the fee implied by its gas price
+	tx := payload.NewCallTxWithSequence(sender.GetPublicKey(), &receiver, nil, 10, 2, 0,
+		exe.getAccount(t, sender.GetAddress()).Sequence+1)
+	tx.GasPrice = balance.NativeToWei(1).Uint64()
+	txEnv := txs.Enclose(testChainID, tx)
+	txEnv.Encoding = txs.Envelope_RLP
+	require.NoError(t, txEnv.Sign(sender))
+	err := exe.executeCommit(txEnv)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match fee 2 implied by gas price")
+
+	// And signs for the value it transfers net of that fee
+	tx.Fee = 2
+	tx.Input.Amount = 12
+	signBytes, err := txEnv.Tx.FeeSignBytes(txs.Envelope_RLP)
+	require.NoError(t, err)
+	signature, err := sender.Sign(signBytes)
+	require.NoError(t, err)
+	txEnv.Signatories[0].Signature = signature
+	require.NoError(t, exe.executeCommit(txEnv))
+	assert.Equal(t, senderBalance-12, exe.getAccount(t, sender.GetAddress()).Balance)
+}
+
+// Schedules the named upgrade and commits blocks until it is active
+func (te *testExecutor) activateUpgrade(t *testing.T, root acm.AddressableSigner, name string) {
+	height := te.LastBlockHeight() + 2
+	require.NoError(t, te.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), name, height, "0.0.1")))
+	for te.LastBlockHeight()+1 < height {
+		_, err := te.Commit(nil)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"sort"
+	"sync"
+)
+
+// Cache buffers changes to parameters for the duration of a block
+type Cache struct {
+	sync.RWMutex
+	backend         Reader
+	defaults        *ExecutionParams
+	execution       *ExecutionParams
+	executionHeight uint64
+	consensus       *ConsensusParams
+	consensusHeight uint64
+	upgrades        map[string]*Upgrade
+}
+
+var _ ReaderWriter = &Cache{}
+
+// NewCache returns a Cache which can write to an output Writer via Sync. Unlike the backend it never returns nil
+// parameters, falling back to defaults for execution parameters and DefaultConsensusParams for consensus parameters
+// that have never been changed.
+func NewCache(backend Reader, defaults *ExecutionParams) *Cache {
+	return &Cache{
+		backend:  backend,
+		defaults: defaults,
+		upgrades: make(map[string]*Upgrade),
+	}
+}
+
+func (cache *Cache) GetExecutionParams() (*ExecutionParams, error) {
+	cache.RLock()
+	defer cache.RUnlock()
+	params := cache.execution
+	if params == nil {
+		var err error
+		params, err = cache.backend.GetExecutionParams()
+		if err != nil {
+			return nil, err
+		}
+		if params == nil {
+			params = cache.defaults
+		}
+	}
+	// Return a copy so the parameters are only changed by UpdateExecutionParams
+	p := *params
+	return &p, nil
+}
+
+func (cache *Cache) GetConsensusParams() (*ConsensusParams, error) {
+	cache.RLock()
+	defer cache.RUnlock()
+	if cache.consensus != nil {
+		params := *cache.consensus
+		return &params, nil
+	}
+	params, err := cache.backend.GetConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return DefaultConsensusParams(), nil
+	}
+	return params, nil
+}
+
+func (cache *Cache) GetUpgrade(name string) (*Upgrade, error) {
+	cache.RLock()
+	defer cache.RUnlock()
+	upgrade, ok := cache.upgrades[name]
+	if !ok {
+		return cache.backend.GetUpgrade(name)
+	}
+	if upgrade.Height == 0 {
+		// Cancelled
+		return nil, nil
+	}
+	u := *upgrade
+	return &u, nil
+}
+
+func (cache *Cache) UpdateExecutionParams(height uint64, params *ExecutionParams) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.execution = params
+	cache.executionHeight = height
+	return nil
+}
+
+func (cache *Cache) UpdateConsensusParams(height uint64, params *ConsensusParams) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.consensus = params
+	cache.consensusHeight = height
+	return nil
+}
+
+func (cache *Cache) UpdateUpgrade(upgrade *Upgrade) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.upgrades[upgrade.Name] = upgrade
+	return nil
+}
+
+// ConsensusParamsUpdate returns the consensus parameters changed since the cache was last reset, or nil if they have
+// not changed
+func (cache *Cache) ConsensusParamsUpdate() *ConsensusParams {
+	cache.RLock()
+	defer cache.RUnlock()
+	return cache.consensus
+}
+
+// Sync writes whatever parameters have changed to the output state. Does not flush the cache, to do that call Reset()
+// after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	if cache.execution != nil {
+		err := state.UpdateExecutionParams(cache.executionHeight, cache.execution)
+		if err != nil {
+			return err
+		}
+	}
+	if cache.consensus != nil {
+		err := state.UpdateConsensusParams(cache.consensusHeight, cache.consensus)
+		if err != nil {
+			return err
+		}
+	}
+	names := make([]string, 0, len(cache.upgrades))
+	for name := range cache.upgrades {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := state.UpdateUpgrade(cache.upgrades[name])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reset the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.execution = nil
+	cache.consensus = nil
+	cache.upgrades = make(map[string]*Upgrade)
+}
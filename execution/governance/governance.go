@@ -0,0 +1,158 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/genesis"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+type Reader interface {
+	// Returns the execution parameters in force, or nil if they have never been changed from those of the GenesisDoc
+	GetExecutionParams() (*ExecutionParams, error)
+	// Returns the consensus parameters in force, or nil if they have never been changed from Burrow's defaults
+	GetConsensusParams() (*ConsensusParams, error)
+	// Returns the upgrade with name, or nil if none is scheduled
+	GetUpgrade(name string) (*Upgrade, error)
+}
+
+type Writer interface {
+	// Sets the execution parameters changed by the block at height
+	UpdateExecutionParams(height uint64, params *ExecutionParams) error
+	// Sets the consensus parameters changed by the block at height
+	UpdateConsensusParams(height uint64, params *ConsensusParams) error
+	// Schedules an upgrade, or removes it if its height is zero
+	UpdateUpgrade(upgrade *Upgrade) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type UpgradeIterable interface {
+	IterateUpgrades(consumer func(*Upgrade) error) error
+}
+
+// ExecutionParamsFromGenesis returns the execution parameters a chain starts with
+func ExecutionParamsFromGenesis(genesisDoc *genesis.GenesisDoc) *ExecutionParams {
+	return &ExecutionParams{
+		ProposalThreshold:       genesisDoc.Params.ProposalThreshold,
+		NameByteCostMultiplier:  names.NameByteCostMultiplier,
+		NameBlockCostMultiplier: names.NameBlockCostMultiplier,
+		MinimumFee:              genesisDoc.Params.MinimumFee,
+		MinimumGasPrice:         genesisDoc.Params.MinimumGasPrice,
+	}
+}
+
+func (p *ExecutionParams) Validate() error {
+	if p.NameByteCostMultiplier == 0 || p.NameBlockCostMultiplier == 0 {
+		return fmt.Errorf("name cost multipliers must be greater than zero in %v", p)
+	}
+	return nil
+}
+
+// NameCostPerBlock returns the cost of holding a name for a block given its base cost (see names.NameBaseCost)
+func (p *ExecutionParams) NameCostPerBlock(baseCost uint64) uint64 {
+	return p.NameBlockCostMultiplier * p.NameByteCostMultiplier * baseCost
+}
+
+func (p *ExecutionParams) String() string {
+	return fmt.Sprintf("ExecutionParams{ProposalThreshold: %d, MaxGasLimit: %d, NameByteCostMultiplier: %d, "+
+		"NameBlockCostMultiplier: %d, MinimumFee: %d, MinimumGasPrice: %d}", p.ProposalThreshold, p.MaxGasLimit,
+		p.NameByteCostMultiplier, p.NameBlockCostMultiplier, p.MinimumFee, p.MinimumGasPrice)
+}
+
+// DefaultTendermintConsensusParams returns the consensus parameters Burrow starts Tendermint with
+func DefaultTendermintConsensusParams() *tmproto.ConsensusParams {
+	params := tmTypes.DefaultConsensusParams()
+	// This is the smallest increment we can use to get a strictly increasing sequence
+	// of block time - we set it low to avoid skew
+	// if the BlockTimeIota is longer than the average block time
+	params.Block.TimeIotaMs = 1
+	return params
+}
+
+// DefaultConsensusParams returns the consensus parameters a chain starts with
+func DefaultConsensusParams() *ConsensusParams {
+	params := DefaultTendermintConsensusParams()
+	return &ConsensusParams{
+		Block: &BlockParams{
+			MaxBytes: params.Block.MaxBytes,
+			MaxGas:   params.Block.MaxGas,
+		},
+		Evidence: &EvidenceParams{
+			MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  params.Evidence.MaxAgeDuration,
+			MaxBytes:        params.Evidence.MaxBytes,
+		},
+		Validator: &ValidatorParams{
+			PubKeyTypes: params.Validator.PubKeyTypes,
+		},
+	}
+}
+
+// Update returns the consensus parameters that result from replacing those groups of parameters set in update
+func (cp *ConsensusParams) Update(update *ConsensusParams) *ConsensusParams {
+	params := *cp
+	if update.Block != nil {
+		params.Block = update.Block
+	}
+	if update.Evidence != nil {
+		params.Evidence = update.Evidence
+	}
+	if update.Validator != nil {
+		params.Validator = update.Validator
+	}
+	return &params
+}
+
+// Validate checks that Tendermint would accept the consensus parameters, which must also allow the ed25519 keys
+// Burrow's validators use
+func (cp *ConsensusParams) Validate() error {
+	params := tmTypes.UpdateConsensusParams(*DefaultTendermintConsensusParams(), cp.ABCI())
+	err := tmTypes.ValidateConsensusParams(params)
+	if err != nil {
+		return fmt.Errorf("invalid consensus parameters: %w", err)
+	}
+	if !tmTypes.IsValidPubkeyType(params.Validator, tmTypes.ABCIPubKeyTypeEd25519) {
+		return fmt.Errorf("invalid consensus parameters: validators must be allowed %s public keys",
+			tmTypes.ABCIPubKeyTypeEd25519)
+	}
+	return nil
+}
+
+// ABCI returns the consensus parameters as an update to be passed to Tendermint from EndBlock
+func (cp *ConsensusParams) ABCI() *abci.ConsensusParams {
+	params := new(abci.ConsensusParams)
+	if cp.Block != nil {
+		params.Block = &abci.BlockParams{
+			MaxBytes: cp.Block.MaxBytes,
+			MaxGas:   cp.Block.MaxGas,
+		}
+	}
+	if cp.Evidence != nil {
+		params.Evidence = &tmproto.EvidenceParams{
+			MaxAgeNumBlocks: cp.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  cp.Evidence.MaxAgeDuration,
+			MaxBytes:        cp.Evidence.MaxBytes,
+		}
+	}
+	if cp.Validator != nil {
+		params.Validator = &tmproto.ValidatorParams{
+			PubKeyTypes: cp.Validator.PubKeyTypes,
+		}
+	}
+	return params
+}
+
+func (cp *ConsensusParams) String() string {
+	return fmt.Sprintf("ConsensusParams{Block: %v, Evidence: %v, Validator: %v}", cp.Block, cp.Evidence,
+		cp.Validator)
+}
@@ -0,0 +1,101 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"fmt"
+
+	"github.com/monax/relic"
+)
+
+// Upgrades gating behaviour in this version of Burrow, each of which is off until scheduled by a GovTx
+const (
+	// Pays transaction fees to the validators rather than burning them, charges a NameTx its whole input amount, and
+	// has an Ethereum transaction pay the fee implied by its gas price
+	FeesUpgrade = "fees"
+	// Enforces the expiry, role, quorum and threshold of a proposal, allows votes to be withdrawn, rejects votes on
+	// proposals that are no longer open, and emits ProposalEvents
+	ProposalsUpgrade = "proposals"
+	// Adds the EVM's SELFBALANCE opcode, which is an unknown opcode until then
+	SelfBalanceUpgrade = "selfbalance"
+	// Permanently jails validators that consensus reports as having double signed, and refuses bonds to jailed
+	// validators even when downtime jailing is disabled
+	JailingUpgrade = "jailing"
+	// Records bonds so that accounts may delegate to validators, and holds unbonded balances for the unbonding period,
+	// before which a BondTx or UnbondTx only moves the account's own balance to and from its power
+	StakingUpgrade = "staking"
+)
+
+// Activated returns whether the named upgrade is active at height. Code introducing new behaviour should use this
+// so that every node switches to it at the same height.
+func Activated(upgrades Reader, name string, height uint64) (bool, error) {
+	upgrade, err := upgrades.GetUpgrade(name)
+	if err != nil {
+		return false, err
+	}
+	return upgrade.ActiveAt(height), nil
+}
+
+// CheckUpgrades returns an error if an upgrade that is active at height requires a later version of Burrow than
+// version
+func CheckUpgrades(upgrades UpgradeIterable, height uint64, version relic.Version) error {
+	return upgrades.IterateUpgrades(func(upgrade *Upgrade) error {
+		if !upgrade.ActiveAt(height) {
+			return nil
+		}
+		required, err := upgrade.RequiredVersion()
+		if err != nil {
+			return err
+		}
+		if versionLess(version, required) {
+			return fmt.Errorf("upgrade %s active from height %d requires Burrow %v or later but this is Burrow %v",
+				upgrade.Name, upgrade.Height, required, version)
+		}
+		return nil
+	})
+}
+
+// ActiveAt returns whether the upgrade is scheduled at or before height
+func (u *Upgrade) ActiveAt(height uint64) bool {
+	return u != nil && u.Height > 0 && u.Height <= height
+}
+
+func (u *Upgrade) RequiredVersion() (relic.Version, error) {
+	version, err := relic.ParseVersion(u.Version)
+	if err != nil {
+		return relic.Version{}, fmt.Errorf("upgrade %s has invalid version: %w", u.Name, err)
+	}
+	return version, nil
+}
+
+// Validate checks the upgrade could be scheduled to activate at a height no earlier than height
+func (u *Upgrade) Validate(height uint64) error {
+	if u.Name == "" {
+		return fmt.Errorf("upgrade must have a name")
+	}
+	if u.Height == 0 {
+		// Cancels a scheduled upgrade
+		return nil
+	}
+	if u.Height < height {
+		return fmt.Errorf("upgrade %s cannot activate at height %d since that is before height %d", u.Name,
+			u.Height, height)
+	}
+	_, err := u.RequiredVersion()
+	return err
+}
+
+func (u *Upgrade) String() string {
+	return fmt.Sprintf("Upgrade{Name: %s, Height: %d, Version: %s}", u.Name, u.Height, u.Version)
+}
+
+func versionLess(a, b relic.Version) bool {
+	if a.Major != b.Major {
+		return a.Major < b.Major
+	}
+	if a.Minor != b.Minor {
+		return a.Minor < b.Minor
+	}
+	return a.Patch < b.Patch
+}
@@ -0,0 +1,48 @@
+package governance
+
+import (
+	"testing"
+
+	"github.com/monax/relic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckUpgrades(t *testing.T) {
+	upgrades := upgradeList{
+		{Name: "first", Height: 10, Version: "0.31.0"},
+		{Name: "second", Height: 20, Version: "1.0.0"},
+	}
+	version, err := relic.ParseVersion("0.31.2")
+	require.NoError(t, err)
+
+	assert.NoError(t, CheckUpgrades(upgrades, 9, version))
+	assert.NoError(t, CheckUpgrades(upgrades, 19, version))
+	err = CheckUpgrades(upgrades, 20, version)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "upgrade second active from height 20 requires Burrow 1.0.0")
+
+	old, err := relic.ParseVersion("0.30.9")
+	require.NoError(t, err)
+	assert.Error(t, CheckUpgrades(upgrades, 10, old))
+}
+
+func TestUpgradeValidate(t *testing.T) {
+	assert.Error(t, (&Upgrade{Height: 10, Version: "1.0.0"}).Validate(5))
+	assert.Error(t, (&Upgrade{Name: "late", Height: 4, Version: "1.0.0"}).Validate(5))
+	assert.Error(t, (&Upgrade{Name: "bad", Height: 10, Version: "1.0"}).Validate(5))
+	assert.NoError(t, (&Upgrade{Name: "good", Height: 5, Version: "1.0.0"}).Validate(5))
+	assert.NoError(t, (&Upgrade{Name: "cancel"}).Validate(5))
+}
+
+type upgradeList []*Upgrade
+
+func (ul upgradeList) IterateUpgrades(consumer func(*Upgrade) error) error {
+	for _, upgrade := range ul {
+		err := consumer(upgrade)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernance(t *testing.T) {
+	t.Run("ExecutionParams", func(t *testing.T) {
+		exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+		root := accounts[0]
+		receiver := accounts[1].GetAddress()
+
+		params := *exe.params.Execution
+		params.MaxGasLimit = 100
+		require.NoError(t, exe.govern(t, root, payload.UpdateExecutionParamsTx(root.GetAddress(), &params)))
+		stored, err := exe.state.GetExecutionParams()
+		require.NoError(t, err)
+		assert.Equal(t, &params, stored)
+
+		tx := payload.NewCallTxWithSequence(root.GetPublicKey(), &receiver, nil, 10, 101, 0,
+			exe.getAccount(t, root.GetAddress()).Sequence+1)
+		err = exe.signExecuteCommit(tx, root)
+		require.Error(t, err)
+		assert.Equal(t, errors.Codes.ExcessiveGasLimit, errors.GetCode(err))
+		tx.GasLimit = 100
+		require.NoError(t, exe.signExecuteCommit(tx, root))
+
+		// Names must always cost something
+		params.NameBlockCostMultiplier = 0
+		err = exe.govern(t, root, payload.UpdateExecutionParamsTx(root.GetAddress(), &params))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "name cost multipliers")
+	})
+
+	t.Run("ConsensusParams", func(t *testing.T) {
+		exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+		root := accounts[0]
+		assert.Nil(t, exe.ConsensusParamUpdates())
+
+		update := &governance.ConsensusParams{
+			Block: &governance.BlockParams{MaxBytes: 1 << 20, MaxGas: 1 << 30},
+		}
+		tx := payload.UpdateConsensusParamsTx(root.GetAddress(), update)
+		tx.Inputs[0].Sequence = exe.getAccount(t, root.GetAddress()).Sequence + 1
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(root))
+		_, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+
+		// Tendermint is passed the update at the end of the block containing the GovTx
+		updates := exe.ConsensusParamUpdates()
+		require.NotNil(t, updates)
+		assert.Equal(t, int64(1<<20), updates.Block.MaxBytes)
+		assert.Equal(t, int64(1<<30), updates.Block.MaxGas)
+		require.NotNil(t, updates.Validator)
+		assert.Equal(t, governance.DefaultConsensusParams().Validator.PubKeyTypes, updates.Validator.PubKeyTypes)
+
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		assert.Nil(t, exe.ConsensusParamUpdates())
+		stored, err := exe.state.GetConsensusParams()
+		require.NoError(t, err)
+		assert.Equal(t, update.Block, stored.Block)
+		assert.Equal(t, governance.DefaultConsensusParams().Evidence, stored.Evidence)
+
+		// Validators must be able to keep using ed25519 keys
+		err = exe.govern(t, root, payload.UpdateConsensusParamsTx(root.GetAddress(), &governance.ConsensusParams{
+			Validator: &governance.ValidatorParams{PubKeyTypes: []string{"secp256k1"}},
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid consensus parameters")
+		assert.Nil(t, exe.ConsensusParamUpdates())
+	})
+
+	t.Run("InvalidParamsWriteNothing", func(t *testing.T) {
+		exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+		root := accounts[0]
+		receiver := accounts[1].GetAddress()
+		before := *exe.params.Execution
+		balanceBefore := exe.getAccount(t, receiver).Balance
+
+		params := before
+		params.MaxGasLimit = 100
+		tx := payload.UpdateExecutionParamsTx(root.GetAddress(), &params)
+		tx.AccountUpdates = []*spec.TemplateAccount{{
+			Address: &receiver,
+			Amounts: balance.New().Native(balanceBefore + 1000),
+		}}
+		tx.ConsensusParams = &governance.ConsensusParams{
+			Validator: &governance.ValidatorParams{PubKeyTypes: []string{"secp256k1"}},
+		}
+		err := exe.govern(t, root, tx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid consensus parameters")
+
+		// No parameters have been stored since genesis
+		stored, err := exe.state.GetExecutionParams()
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+		assert.Equal(t, before, *exe.params.Execution)
+		assert.Equal(t, balanceBefore, exe.getAccount(t, receiver).Balance)
+		assert.Nil(t, exe.ConsensusParamUpdates())
+
+		// Likewise for an upgrade that cannot be scheduled
+		tx = payload.ScheduleUpgradeTx(root.GetAddress(), "hasty", exe.LastBlockHeight()+1, "1.0.0")
+		tx.ExecutionParams = &params
+		err = exe.govern(t, root, tx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot activate at height")
+		stored, err = exe.state.GetExecutionParams()
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+	})
+
+	t.Run("Upgrade", func(t *testing.T) {
+		exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+		root := accounts[0]
+		// Executed in the next block so activates in the block after that
+		height := exe.LastBlockHeight() + 2
+		require.NoError(t, exe.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), "shiny", height, "1.0.0")))
+		stored, err := exe.state.GetUpgrade("shiny")
+		require.NoError(t, err)
+		assert.Equal(t, &governance.Upgrade{Name: "shiny", Height: height, Version: "1.0.0"}, stored)
+
+		// Cannot activate in the block that schedules it
+		err = exe.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), "hasty", exe.LastBlockHeight()+1,
+			"1.0.0"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot activate at height")
+
+		active, err := governance.Activated(exe.governanceCache, "shiny", exe.LastBlockHeight()+1)
+		require.NoError(t, err)
+		assert.True(t, active)
+
+		// Nor be changed once active
+		err = exe.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), "shiny", 0, ""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already activated")
+
+		// But can be cancelled until then
+		require.NoError(t, exe.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), "later",
+			exe.LastBlockHeight()+10, "1.0.0")))
+		require.NoError(t, exe.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), "later", 0, "")))
+		stored, err = exe.state.GetUpgrade("later")
+		require.NoError(t, err)
+		assert.Nil(t, stored)
+		err = exe.govern(t, root, payload.ScheduleUpgradeTx(root.GetAddress(), "later", 0, ""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not scheduled")
+	})
+
+	t.Run("UpgradeOpCode", func(t *testing.T) {
+		exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+		root := accounts[0]
+		contract := &acm.Account{
+			Address: crypto.Address{0xC0, 0xDE},
+			Balance: 1337,
+			EVMCode: bc.MustSplice(asm.SELFBALANCE, asm.PUSH1, 0, asm.MSTORE, asm.PUSH1, 32, asm.PUSH1, 0, asm.RETURN),
+		}
+		exe.updateAccounts(t, contract)
+		call := func() (*exec.TxExecution, error) {
+			tx, err := payload.NewCallTx(exe.stateCache, root.GetPublicKey(), &contract.Address, nil, 0, 10000, 0)
+			require.NoError(t, err)
+			txEnv := txs.Enclose(testChainID, tx)
+			require.NoError(t, txEnv.Sign(root))
+			txe, err := exe.Execute(txEnv)
+			require.NoError(t, err)
+			_, err = exe.Commit(nil)
+			require.NoError(t, err)
+			return txe, txe.Exception.AsError()
+		}
+
+		_, err := call()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown opcode")
+
+		exe.activateUpgrade(t, root, governance.SelfBalanceUpgrade)
+		txe, err := call()
+		require.NoError(t, err)
+		assert.Equal(t, binary.Uint64ToWord256(1337).Bytes(), txe.Result.Return)
+	})
+
+	t.Run("UpgradeContext", func(t *testing.T) {
+		st, privAccounts := makeGenesisState(2, 1)
+		exe := makeExecutorWithParams(st, ParamsFromGenesis(testGenesisDoc),
+			UpgradeContext("strict", payload.TypeSend, contextFunc(func(*exec.TxExecution, payload.Payload) error {
+				return fmt.Errorf("sends are forbidden")
+			})))
+		sender := privAccounts[0]
+		receiver := privAccounts[1].GetAddress()
+		send := func() error {
+			tx := payload.NewSendTx()
+			require.NoError(t, tx.AddInputWithSequence(sender.GetPublicKey(), 1,
+				exe.getAccount(t, sender.GetAddress()).Sequence+1))
+			tx.AddOutput(receiver, 1)
+			return exe.signExecuteCommit(tx, sender)
+		}
+		require.NoError(t, send())
+
+		// Each transaction is committed in its own block so one more send is made before the upgrade activates
+		tx := payload.ScheduleUpgradeTx(sender.GetAddress(), "strict", exe.LastBlockHeight()+3, "0.0.1")
+		tx.Inputs[0].Sequence = exe.getAccount(t, sender.GetAddress()).Sequence + 1
+		require.NoError(t, exe.signExecuteCommit(tx, sender))
+		require.NoError(t, send())
+
+		err := send()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "sends are forbidden")
+	})
+}
+
+type contextFunc func(txe *exec.TxExecution, p payload.Payload) error
+
+func (cf contextFunc) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	return cf(txe, p)
+}
+
+func (te *testExecutor) govern(t *testing.T, signer acm.AddressableSigner, tx *payload.GovTx) error {
+	tx.Inputs[0].Sequence = te.getAccount(t, signer.GetAddress()).Sequence + 1
+	return te.signExecuteCommit(tx, signer)
+}
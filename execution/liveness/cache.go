@@ -0,0 +1,112 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package liveness
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Cache buffers updates to liveness records for the duration of a block
+type Cache struct {
+	sync.RWMutex
+	backend  Reader
+	liveness map[crypto.Address]*livenessInfo
+}
+
+type livenessInfo struct {
+	liveness *Liveness
+	updated  bool
+}
+
+var _ ReaderWriter = &Cache{}
+
+// NewCache returns a Cache which can write to an output Writer via Sync.
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend:  backend,
+		liveness: make(map[crypto.Address]*livenessInfo),
+	}
+}
+
+func (cache *Cache) GetLiveness(id crypto.Address) (*Liveness, error) {
+	info, err := cache.get(id)
+	if err != nil {
+		return nil, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if info.liveness == nil {
+		return nil, nil
+	}
+	// Return a copy so the cached record is only changed by UpdateLiveness
+	l := *info.liveness
+	l.MissedBlocks = append([]byte(nil), info.liveness.MissedBlocks...)
+	return &l, nil
+}
+
+func (cache *Cache) UpdateLiveness(id crypto.Address, liveness *Liveness) error {
+	info, err := cache.get(id)
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	info.liveness = liveness
+	info.updated = true
+	return nil
+}
+
+// Sync writes whatever is in the cache to the output state in address order. Does not flush the cache, to do that
+// call Reset() after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	addresses := make(crypto.Addresses, 0, len(cache.liveness))
+	for id, info := range cache.liveness {
+		if info.updated {
+			addresses = append(addresses, id)
+		}
+	}
+	sort.Sort(addresses)
+	for _, id := range addresses {
+		err := state.UpdateLiveness(id, cache.liveness[id].liveness)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reset the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.liveness = make(map[crypto.Address]*livenessInfo)
+}
+
+func (cache *Cache) get(id crypto.Address) (*livenessInfo, error) {
+	cache.RLock()
+	info := cache.liveness[id]
+	cache.RUnlock()
+	if info == nil {
+		cache.Lock()
+		defer cache.Unlock()
+		info = cache.liveness[id]
+		if info == nil {
+			liveness, err := cache.backend.GetLiveness(id)
+			if err != nil {
+				return nil, err
+			}
+			info = &livenessInfo{
+				liveness: liveness,
+			}
+			cache.liveness[id] = info
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,189 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package liveness
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+// Params governing when validators are jailed for downtime
+type Params struct {
+	// The number of most recent blocks over which missed blocks are counted, zero disables downtime tracking
+	Window uint64
+	// A validator is jailed once it has missed more than this many of the last Window blocks
+	MaxMissedBlocks uint64
+	// The number of blocks a jailed validator must wait before it may be unjailed
+	JailBlocks uint64
+}
+
+func (p Params) Enabled() bool {
+	return p.Window > 0
+}
+
+type Reader interface {
+	// Returns nil if we hold no liveness record for the validator
+	GetLiveness(id crypto.Address) (*Liveness, error)
+}
+
+type Writer interface {
+	// Updates the liveness record of the validator, creating it if it does not exist
+	UpdateLiveness(id crypto.Address, liveness *Liveness) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	IterateLiveness(consumer func(*Liveness) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
+
+func New(publicKey crypto.PublicKey, height uint64) *Liveness {
+	return &Liveness{
+		Address:     publicKey.GetAddress(),
+		PublicKey:   publicKey,
+		StartHeight: height,
+	}
+}
+
+func (l *Liveness) GetAddress() crypto.Address {
+	return l.Address
+}
+
+// Record whether the validator signed the block at height, the window over which missed blocks are counted restarts
+// from height if its size has changed
+func (l *Liveness) Record(height, window uint64, missed bool) {
+	if l.Window != window {
+		l.Window = window
+		l.MissedBlocks = make([]byte, (window+7)/8)
+		l.MissedCount = 0
+		l.StartHeight = height
+	}
+	index := height % window
+	byteIndex, mask := index/8, byte(1)<<(index%8)
+	wasMissed := l.MissedBlocks[byteIndex]&mask != 0
+	switch {
+	case missed && !wasMissed:
+		l.MissedBlocks[byteIndex] |= mask
+		l.MissedCount++
+	case !missed && wasMissed:
+		l.MissedBlocks[byteIndex] &^= mask
+		l.MissedCount--
+	}
+}
+
+// Jail records that the validator lost power at height, remembering that power so it can be restored
+func (l *Liveness) Jail(height uint64, power *big.Int) {
+	l.Jailed = true
+	l.JailedHeight = height
+	l.JailedPower = power.Uint64()
+}
+
+// Unjail clears the validator's jailed status and its missed blocks so that tracking restarts from height
+func (l *Liveness) Unjail(height uint64) {
+	l.Jailed = false
+	l.JailedPower = 0
+	l.Window = 0
+	l.MissedBlocks = nil
+	l.MissedCount = 0
+	l.StartHeight = height
+}
+
+func (l *Liveness) String() string {
+	return fmt.Sprintf("Liveness{%v; Missed: %d since %d; Jailed: %t at %d with power %d; Tombstoned: %t}",
+		l.Address, l.MissedCount, l.StartHeight, l.Jailed, l.JailedHeight, l.JailedPower, l.Tombstoned)
+}
+
+// Tracker records which validators sign each block and jails those that are offline or have been caught double
+// signing by setting their power to zero
+type Tracker struct {
+	Params     Params
+	Liveness   ReaderWriter
+	Validators validator.ReaderWriter
+	Logger     *logging.Logger
+}
+
+// Signed records whether the validator with publicKey signed the block at height, jailing it if it has now missed too
+// many blocks or has previously been tombstoned but could not then be jailed
+func (t *Tracker) Signed(height uint64, publicKey crypto.PublicKey, signed bool) error {
+	address := publicKey.GetAddress()
+	l, err := t.Liveness.GetLiveness(address)
+	if err != nil {
+		return err
+	}
+	if !t.Params.Enabled() && (l == nil || !l.Tombstoned) {
+		return nil
+	}
+	if l == nil {
+		l = New(publicKey, height)
+	}
+	if l.Jailed {
+		// Its votes may still be reported for a few blocks after it has lost its power
+		return nil
+	}
+	if t.Params.Enabled() {
+		l.Record(height, t.Params.Window, !signed)
+	}
+	if l.Tombstoned || l.MissedCount > t.Params.MaxMissedBlocks {
+		t.jail(height, l)
+	}
+	return t.Liveness.UpdateLiveness(address, l)
+}
+
+// Tombstone jails the validator at address permanently on evidence of it double signing, publicKey may be nil if the
+// validator no longer has power
+func (t *Tracker) Tombstone(height uint64, address crypto.Address, publicKey *crypto.PublicKey) error {
+	l, err := t.Liveness.GetLiveness(address)
+	if err != nil {
+		return err
+	}
+	if l == nil {
+		l = &Liveness{
+			Address:     address,
+			StartHeight: height,
+		}
+		if publicKey != nil {
+			l.PublicKey = *publicKey
+		}
+	}
+	if l.Tombstoned {
+		return nil
+	}
+	l.Tombstoned = true
+	t.Logger.InfoMsg("Tombstoning validator on evidence of double signing", "validator_address", address,
+		"height", height)
+	if !l.Jailed && publicKey != nil {
+		t.jail(height, l)
+	}
+	return t.Liveness.UpdateLiveness(address, l)
+}
+
+// Set the validator's power to zero, if this is not currently possible (because it would move too much power in a
+// single block) we try again when its next vote is recorded
+func (t *Tracker) jail(height uint64, l *Liveness) {
+	power, err := t.Validators.Power(l.Address)
+	if err == nil {
+		_, err = t.Validators.SetPower(l.PublicKey, new(big.Int))
+	}
+	if err != nil {
+		t.Logger.InfoMsg("Could not jail validator", "validator_address", l.Address, "height", height,
+			structure.ErrorKey, err)
+		return
+	}
+	l.Jail(height, power)
+	t.Logger.InfoMsg("Jailed validator", "validator_address", l.Address, "height", height,
+		"missed_blocks", l.MissedCount, "tombstoned", l.Tombstoned, "jailed_power", power)
+}
@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: liveness.proto
+
+package liveness
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	crypto "github.com/hyperledger/burrow/crypto"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Liveness records whether a validator has been signing blocks over a sliding window of recent heights and whether it
+// has been jailed (had its power set to zero) for missing too many of them or for signing conflicting votes
+type Liveness struct {
+	// The validator's address
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The validator's public key, needed to restore its power when unjailed
+	PublicKey crypto.PublicKey `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey"`
+	// The height from which the validator's signatures have been tracked
+	StartHeight uint64 `protobuf:"varint,3,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	// The number of most recent blocks over which missed blocks are counted
+	Window uint64 `protobuf:"varint,4,opt,name=Window,proto3" json:"Window,omitempty"`
+	// A bitmap over the window with the bit for height h at h modulo the window size set if the validator missed the
+	// block at h
+	MissedBlocks []byte `protobuf:"bytes,5,opt,name=MissedBlocks,proto3" json:"MissedBlocks,omitempty"`
+	// The number of bits set in MissedBlocks
+	MissedCount uint64 `protobuf:"varint,6,opt,name=MissedCount,proto3" json:"MissedCount,omitempty"`
+	// Whether the validator is currently jailed
+	Jailed bool `protobuf:"varint,7,opt,name=Jailed,proto3" json:"Jailed,omitempty"`
+	// The height at which the validator was last jailed
+	JailedHeight uint64 `protobuf:"varint,8,opt,name=JailedHeight,proto3" json:"JailedHeight,omitempty"`
+	// The power the validator had when it was jailed, which is restored when it is unjailed
+	JailedPower uint64 `protobuf:"varint,9,opt,name=JailedPower,proto3" json:"JailedPower,omitempty"`
+	// Set when evidence of the validator double signing has been committed, a tombstoned validator can never be unjailed
+	Tombstoned           bool     `protobuf:"varint,10,opt,name=Tombstoned,proto3" json:"Tombstoned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Liveness) Reset()      { *m = Liveness{} }
+func (*Liveness) ProtoMessage() {}
+func (*Liveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ace8490e4a3c672, []int{0}
+}
+func (m *Liveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Liveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Liveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Liveness.Merge(m, src)
+}
+func (m *Liveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *Liveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_Liveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Liveness proto.InternalMessageInfo
+
+func (m *Liveness) GetPublicKey() crypto.PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (m *Liveness) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Liveness) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *Liveness) GetMissedBlocks() []byte {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *Liveness) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *Liveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Liveness) GetJailedHeight() uint64 {
+	if m != nil {
+		return m.JailedHeight
+	}
+	return 0
+}
+
+func (m *Liveness) GetJailedPower() uint64 {
+	if m != nil {
+		return m.JailedPower
+	}
+	return 0
+}
+
+func (m *Liveness) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (*Liveness) XXX_MessageName() string {
+	return "liveness.Liveness"
+}
+func init() {
+	proto.RegisterType((*Liveness)(nil), "liveness.Liveness")
+	golang_proto.RegisterType((*Liveness)(nil), "liveness.Liveness")
+}
+
+func init() { proto.RegisterFile("liveness.proto", fileDescriptor_3ace8490e4a3c672) }
+func init() { golang_proto.RegisterFile("liveness.proto", fileDescriptor_3ace8490e4a3c672) }
+
+var fileDescriptor_3ace8490e4a3c672 = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4a, 0xeb, 0x40,
+	0x14, 0xc7, 0x3b, 0xb7, 0xb9, 0x6d, 0x3a, 0x2d, 0x17, 0x6e, 0xb8, 0x5c, 0x86, 0x2e, 0xd2, 0xd0,
+	0x55, 0x16, 0xd2, 0x88, 0x1f, 0x1b, 0x77, 0xc6, 0x8d, 0xd4, 0x0f, 0x4a, 0x14, 0x04, 0x77, 0x4d,
+	0x72, 0x48, 0x07, 0xd3, 0x4c, 0x99, 0x99, 0x58, 0xfb, 0x20, 0x82, 0x4b, 0x1f, 0xc5, 0x65, 0x97,
+	0x2e, 0xc5, 0x45, 0x91, 0xf4, 0x45, 0x24, 0xc9, 0x54, 0xd3, 0x8d, 0xbb, 0xf9, 0xff, 0xce, 0x9c,
+	0xdf, 0x49, 0x38, 0x83, 0xff, 0xc4, 0xf4, 0x1e, 0x12, 0x10, 0x62, 0x30, 0xe3, 0x4c, 0x32, 0x43,
+	0xdf, 0xe4, 0xee, 0xbf, 0x88, 0x45, 0xac, 0x80, 0x4e, 0x7e, 0x2a, 0xeb, 0xdd, 0x4e, 0xc0, 0x17,
+	0x33, 0xa9, 0x52, 0xff, 0xb1, 0x8e, 0xf5, 0x73, 0xd5, 0x60, 0x5c, 0xe2, 0xe6, 0x71, 0x18, 0x72,
+	0x10, 0x82, 0x20, 0x0b, 0xd9, 0x1d, 0xf7, 0x60, 0xb9, 0xea, 0xd5, 0xde, 0x57, 0xbd, 0x9d, 0x88,
+	0xca, 0x49, 0xea, 0x0f, 0x02, 0x36, 0x75, 0x26, 0x8b, 0x19, 0xf0, 0x18, 0xc2, 0x08, 0xb8, 0xe3,
+	0xa7, 0x9c, 0xb3, 0xb9, 0xa3, 0x8c, 0xaa, 0xd7, 0xdb, 0x48, 0x8c, 0x43, 0xdc, 0x1a, 0xa5, 0x7e,
+	0x4c, 0x83, 0x33, 0x58, 0x90, 0x5f, 0x16, 0xb2, 0xdb, 0x7b, 0x7f, 0x07, 0xea, 0xf2, 0x57, 0xc1,
+	0xd5, 0xf2, 0x21, 0xde, 0xf7, 0x4d, 0xc3, 0xc2, 0xed, 0x2b, 0x39, 0xe6, 0xf2, 0x14, 0x68, 0x34,
+	0x91, 0xa4, 0x6e, 0x21, 0x5b, 0xf3, 0xaa, 0xc8, 0xf8, 0x8f, 0x1b, 0x37, 0x34, 0x09, 0xd9, 0x9c,
+	0x68, 0x45, 0x51, 0x25, 0xa3, 0x8f, 0x3b, 0x17, 0x54, 0x08, 0x08, 0xdd, 0x98, 0x05, 0x77, 0x82,
+	0xfc, 0xce, 0xff, 0xc2, 0xdb, 0x62, 0xb9, 0xbd, 0xcc, 0x27, 0x2c, 0x4d, 0x24, 0x69, 0x94, 0xf6,
+	0x0a, 0xca, 0xed, 0xc3, 0x31, 0x8d, 0x21, 0x24, 0x4d, 0x0b, 0xd9, 0xba, 0xa7, 0x52, 0x6e, 0x2f,
+	0x4f, 0xea, 0xc3, 0xf4, 0xa2, 0x75, 0x8b, 0xe5, 0xf6, 0x32, 0x8f, 0xd8, 0x1c, 0x38, 0x69, 0x95,
+	0xf6, 0x0a, 0x32, 0x4c, 0x8c, 0xaf, 0xd9, 0xd4, 0x17, 0x92, 0x25, 0x10, 0x12, 0x5c, 0x4c, 0xa8,
+	0x90, 0x23, 0xed, 0xe9, 0xb9, 0x57, 0x73, 0x87, 0xcb, 0xcc, 0x44, 0xaf, 0x99, 0x89, 0xde, 0x32,
+	0x13, 0x7d, 0x64, 0x26, 0x7a, 0x59, 0x9b, 0x68, 0xb9, 0x36, 0xd1, 0xed, 0xee, 0xcf, 0xbb, 0x80,
+	0x07, 0x08, 0x52, 0x49, 0x59, 0xe2, 0x6c, 0xde, 0x81, 0xdf, 0x28, 0x56, 0xbd, 0xff, 0x39, 0x00,
+	0xa3, 0x4e, 0xc2, 0x90, 0x2a, 0x02, 0x00, 0x00,
+}
+
+func (m *Liveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Liveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Liveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.JailedPower != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailedPower))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.JailedHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MissedBlocks) > 0 {
+		i -= len(m.MissedBlocks)
+		copy(dAtA[i:], m.MissedBlocks)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.MissedBlocks)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Window != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiveness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiveness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Liveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovLiveness(uint64(l))
+	l = m.PublicKey.Size()
+	n += 1 + l + sovLiveness(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.StartHeight))
+	}
+	if m.Window != 0 {
+		n += 1 + sovLiveness(uint64(m.Window))
+	}
+	l = len(m.MissedBlocks)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovLiveness(uint64(m.MissedCount))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.JailedHeight))
+	}
+	if m.JailedPower != 0 {
+		n += 1 + sovLiveness(uint64(m.JailedPower))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiveness(x uint64) (n int) {
+	return sovLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Liveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Liveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Liveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedBlocks == nil {
+				m.MissedBlocks = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedHeight", wireType)
+			}
+			m.JailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedPower", wireType)
+			}
+			m.JailedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiveness = fmt.Errorf("proto: unexpected end of group")
+)
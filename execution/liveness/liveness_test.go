@@ -0,0 +1,108 @@
+package liveness
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	l := New(val.GetPublicKey(), 1)
+	for height := uint64(1); height <= 10; height++ {
+		l.Record(height, 3, height%2 == 0)
+	}
+	// Of heights 8, 9, 10 we missed 8 and 10
+	assert.Equal(t, uint64(2), l.MissedCount)
+	l.Record(11, 3, false)
+	assert.Equal(t, uint64(1), l.MissedCount)
+
+	// Changing the window restarts the count
+	l.Record(12, 5, true)
+	assert.Equal(t, uint64(1), l.MissedCount)
+	assert.Equal(t, uint64(12), l.StartHeight)
+	assert.Len(t, l.MissedBlocks, 1)
+}
+
+func TestTracker(t *testing.T) {
+	vals := make([]*acm.PrivateAccount, 3)
+	for i := range vals {
+		vals[i] = acm.GeneratePrivateAccountFromSecret(string(rune('a' + i)))
+	}
+	newTracker := func(powers ...int64) *Tracker {
+		return &Tracker{
+			Params:     Params{Window: 10, MaxMissedBlocks: 2},
+			Liveness:   NewCache(memoryLiveness{}),
+			Validators: validator.NewBucket(validatorSet(vals, powers...)),
+			Logger:     logging.NewNoopLogger(),
+		}
+	}
+
+	t.Run("Downtime", func(t *testing.T) {
+		tracker := newTracker(5, 10, 10)
+		for height := uint64(1); height <= 3; height++ {
+			require.NoError(t, tracker.Signed(height, vals[0].GetPublicKey(), false))
+			require.NoError(t, tracker.Signed(height, vals[1].GetPublicKey(), true))
+		}
+		l, err := tracker.Liveness.GetLiveness(vals[0].GetAddress())
+		require.NoError(t, err)
+		assert.True(t, l.Jailed)
+		assert.Equal(t, uint64(3), l.JailedHeight)
+		assert.Equal(t, uint64(5), l.JailedPower)
+		assertPower(t, tracker, vals[0].GetAddress(), 0)
+		assertPower(t, tracker, vals[1].GetAddress(), 10)
+	})
+
+	t.Run("Tombstone", func(t *testing.T) {
+		// Too much power to remove in a single block
+		tracker := newTracker(20, 10, 10)
+		address := vals[0].GetAddress()
+		publicKey := vals[0].GetPublicKey()
+		require.NoError(t, tracker.Tombstone(1, address, &publicKey))
+		l, err := tracker.Liveness.GetLiveness(address)
+		require.NoError(t, err)
+		assert.True(t, l.Tombstoned)
+		assert.False(t, l.Jailed)
+		assertPower(t, tracker, address, 20)
+
+		// We keep trying to jail it
+		tracker.Validators = validator.NewBucket(validatorSet(vals, 20, 40, 40))
+		require.NoError(t, tracker.Signed(2, publicKey, true))
+		l, err = tracker.Liveness.GetLiveness(address)
+		require.NoError(t, err)
+		assert.True(t, l.Jailed)
+		assertPower(t, tracker, address, 0)
+
+		// A validator without power is tombstoned so it cannot bond again
+		require.NoError(t, tracker.Tombstone(2, vals[2].GetAddress(), nil))
+		l, err = tracker.Liveness.GetLiveness(vals[2].GetAddress())
+		require.NoError(t, err)
+		assert.True(t, l.Tombstoned)
+	})
+}
+
+func validatorSet(vals []*acm.PrivateAccount, powers ...int64) *validator.Set {
+	set := validator.NewSet()
+	for i, power := range powers {
+		set.ChangePower(vals[i].GetPublicKey(), big.NewInt(power))
+	}
+	return set
+}
+
+func assertPower(t *testing.T, tracker *Tracker, address crypto.Address, power int64) {
+	t.Helper()
+	bucket := tracker.Validators.(*validator.Bucket)
+	assert.Equal(t, big.NewInt(power), bucket.Next.GetPower(address))
+}
+
+type memoryLiveness map[crypto.Address]*Liveness
+
+func (ml memoryLiveness) GetLiveness(id crypto.Address) (*Liveness, error) {
+	return ml[id], nil
+}
@@ -0,0 +1,176 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestLiveness(t *testing.T) {
+	params := liveness.Params{
+		Window:          4,
+		MaxMissedBlocks: 1,
+		JailBlocks:      2,
+	}
+
+	t.Run("Downtime", func(t *testing.T) {
+		exe, validators, _ := makeLivenessExecutor(t, params)
+		offline := validators[3]
+
+		// Missing a single block is tolerated
+		require.NoError(t, exe.recordBlock(validators, offline))
+		require.NoError(t, exe.recordBlock(validators))
+		l := exe.getLiveness(t, offline)
+		assert.Equal(t, uint64(1), l.MissedCount)
+		assert.False(t, l.Jailed)
+		assert.Equal(t, uint64(9), exe.power(t, offline))
+
+		// But not a second within the window
+		require.NoError(t, exe.recordBlock(validators, offline))
+		l = exe.getLiveness(t, offline)
+		assert.Equal(t, uint64(2), l.MissedCount)
+		require.True(t, l.Jailed)
+		assert.Equal(t, uint64(9), l.JailedPower)
+		assert.Equal(t, uint64(0), exe.power(t, offline))
+
+		// Jailed validators cannot regain power by bonding
+		bondTx := payload.NewBondTx(offline.GetAddress(), 1)
+		bondTx.Input.Sequence = exe.getAccount(t, offline.GetAddress()).Sequence + 1
+		err := exe.signExecuteCommit(bondTx, offline)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "jailed")
+
+		// Nor unjail until they have served their time
+		err = exe.unjail(t, offline)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be unjailed until")
+
+		require.NoError(t, exe.recordBlock(validators, offline))
+		require.NoError(t, exe.unjail(t, offline))
+		l = exe.getLiveness(t, offline)
+		assert.False(t, l.Jailed)
+		assert.Equal(t, uint64(0), l.MissedCount)
+		assert.Equal(t, uint64(9), exe.power(t, offline))
+
+		err = exe.unjail(t, offline)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not jailed")
+	})
+
+	t.Run("DoubleSign", func(t *testing.T) {
+		exe, validators, root := makeLivenessExecutor(t, liveness.Params{})
+		byzantine := validators[1]
+		evidence := []abci.Evidence{{
+			Type:      abci.EvidenceType_DUPLICATE_VOTE,
+			Validator: abci.Validator{Address: byzantine.GetAddress().Bytes(), Power: 10},
+		}}
+
+		// Evidence is ignored until the upgrade so that existing chains replay to the same state
+		require.NoError(t, exe.RecordLiveness(abci.LastCommitInfo{}, evidence))
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+		assert.Nil(t, exe.maybeGetLiveness(t, byzantine))
+		assert.Equal(t, uint64(10), exe.power(t, byzantine))
+
+		exe.activateUpgrade(t, root, governance.JailingUpgrade)
+		require.NoError(t, exe.RecordLiveness(abci.LastCommitInfo{}, evidence))
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		l := exe.getLiveness(t, byzantine)
+		assert.True(t, l.Tombstoned)
+		assert.True(t, l.Jailed)
+		assert.Equal(t, uint64(0), exe.power(t, byzantine))
+
+		// No liveness is tracked with downtime jailing disabled
+		require.NoError(t, exe.recordBlock(validators, validators[2]))
+		assert.Nil(t, exe.maybeGetLiveness(t, validators[2]))
+
+		err = exe.unjail(t, byzantine)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "double signing")
+
+		// Nor can it bond its way back, since the upgrade refuses bonds to jailed validators
+		bondTx := payload.NewBondTx(byzantine.GetAddress(), 1)
+		bondTx.Input.Sequence = exe.getAccount(t, byzantine.GetAddress()).Sequence + 1
+		err = exe.signExecuteCommit(bondTx, byzantine)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "double signing")
+	})
+}
+
+// Returns the executor, its validators, and a root account
+func makeLivenessExecutor(t *testing.T, livenessParams liveness.Params) (*testExecutor, []acm.AddressableSigner,
+	acm.AddressableSigner) {
+	genDoc, privAccounts, privValidators := deterministicGenesis.GenesisDoc(1, 4)
+	validators := make([]acm.AddressableSigner, len(privValidators))
+	for i, val := range privValidators {
+		// Little enough power that any one of them can be jailed, and the last unjailed, within the permitted flow
+		genDoc.Validators[i].Amount = 10
+		if i == len(privValidators)-1 {
+			genDoc.Validators[i].Amount = 9
+		}
+		validators[i] = val
+	}
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), genDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	params := ParamsFromGenesis(testGenesisDoc)
+	params.Liveness = livenessParams
+	return makeExecutorWithParams(st, params), validators, privAccounts[0]
+}
+
+// Record and commit a block signed by all validators apart from those absent
+func (te *testExecutor) recordBlock(validators []acm.AddressableSigner, absent ...acm.AddressableSigner) error {
+	lastCommit := abci.LastCommitInfo{}
+	for _, val := range validators {
+		signed := true
+		for _, a := range absent {
+			if a.GetAddress() == val.GetAddress() {
+				signed = false
+			}
+		}
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: val.GetAddress().Bytes(), Power: 10},
+			SignedLastBlock: signed,
+		})
+	}
+	err := te.RecordLiveness(lastCommit, nil)
+	if err != nil {
+		return err
+	}
+	_, err = te.Commit(nil)
+	return err
+}
+
+func (te *testExecutor) unjail(t *testing.T, val acm.AddressableSigner) error {
+	tx := payload.NewUnjailTx(val.GetAddress())
+	tx.Input.Sequence = te.getAccount(t, val.GetAddress()).Sequence + 1
+	return te.signExecuteCommit(tx, val)
+}
+
+func (te *testExecutor) maybeGetLiveness(t *testing.T, val crypto.Addressable) *liveness.Liveness {
+	l, err := te.state.GetLiveness(val.GetAddress())
+	require.NoError(t, err)
+	return l
+}
+
+func (te *testExecutor) getLiveness(t *testing.T, val crypto.Addressable) *liveness.Liveness {
+	l := te.maybeGetLiveness(t, val)
+	require.NotNil(t, l)
+	return l
+}
+
+func (te *testExecutor) power(t *testing.T, val crypto.Addressable) uint64 {
+	power, err := te.state.(*state.State).Power(val.GetAddress())
+	require.NoError(t, err)
+	return power.Uint64()
+}
@@ -0,0 +1,101 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSig(t *testing.T) {
+	exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+	multiSig, err := crypto.NewMultiSig(2, validators[0].GetPublicKey(), validators[1].GetPublicKey(),
+		validators[2].GetPublicKey())
+	require.NoError(t, err)
+	address := multiSig.Address()
+
+	require.NoError(t, exe.govern(t, accounts[0], payload.UpdateAccountTx(accounts[0].GetAddress(),
+		&spec.TemplateAccount{
+			Address:     &address,
+			Amounts:     balance.New().Native(100),
+			Permissions: []string{permission.InputString, permission.SendString},
+		})))
+
+	// The members are recorded when the account is first spent from
+	require.NoError(t, exe.multiSigExecuteCommit(t, exe.sendFrom(t, address, accounts[1], 10), multiSig,
+		validators[0], validators[2]))
+	acc := exe.getAccount(t, address)
+	assert.True(t, multiSig.Equal(acc.MultiSig))
+	assert.Equal(t, uint64(90), acc.Balance)
+
+	err = exe.multiSigExecuteCommit(t, exe.sendFrom(t, address, accounts[1], 10), multiSig, validators[1])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires 2")
+
+	// Replace a member with the consent of the current quorum
+	newMultiSig, err := crypto.NewMultiSig(2, validators[0].GetPublicKey(), validators[1].GetPublicKey(),
+		accounts[1].GetPublicKey())
+	require.NoError(t, err)
+	msTx := payload.NewMultiSigTx(address, newMultiSig)
+	msTx.Input.Sequence = exe.getAccount(t, address).Sequence + 1
+	require.NoError(t, exe.multiSigExecuteCommit(t, msTx, multiSig, validators[1], validators[2]))
+	assert.True(t, newMultiSig.Equal(exe.getAccount(t, address).MultiSig))
+	// The address does not change with the membership
+	assert.NotEqual(t, address, newMultiSig.Address())
+
+	err = exe.multiSigExecuteCommit(t, exe.sendFrom(t, address, accounts[1], 10), multiSig, validators[0], validators[2])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "do not match the current members")
+
+	require.NoError(t, exe.multiSigExecuteCommit(t, exe.sendFrom(t, address, accounts[1], 10), newMultiSig,
+		validators[0], accounts[1]))
+	assert.Equal(t, uint64(80), exe.getAccount(t, address).Balance)
+
+	// Members cannot sign for a multi-signature account as a plain signatory
+	txEnv := txs.Enclose(testChainID, exe.sendFrom(t, address, accounts[1], 10))
+	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	require.NoError(t, err)
+	signature, err := validators[0].Sign(signBytes)
+	require.NoError(t, err)
+	publicKey := validators[0].GetPublicKey()
+	txEnv.Signatories = []txs.Signatory{{Address: &address, PublicKey: &publicKey, Signature: signature}}
+	_, err = exe.Execute(txEnv)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "address is not derived from public key")
+}
+
+// Sends amount from the account at address, whose inputs may not be signed by the key its address is derived from
+func (te *testExecutor) sendFrom(t *testing.T, address crypto.Address, to crypto.Addressable,
+	amount uint64) *payload.SendTx {
+	return &payload.SendTx{
+		Inputs: []*payload.TxInput{{
+			Address:  address,
+			Amount:   amount,
+			Sequence: te.getAccount(t, address).Sequence + 1,
+		}},
+		Outputs: []*payload.TxOutput{{Address: to.GetAddress(), Amount: amount}},
+	}
+}
+
+func (te *testExecutor) multiSigExecuteCommit(t *testing.T, tx payload.Payload, multiSig *crypto.MultiSig,
+	members ...acm.AddressableSigner) error {
+	txEnv := txs.Enclose(testChainID, tx)
+	require.NoError(t, txEnv.SignMultiSig(tx.GetInputs()[0].Address, multiSig, members...))
+	txe, err := te.Execute(txEnv)
+	if err != nil {
+		return err
+	}
+	if txe.Exception != nil {
+		return txe.Exception
+	}
+	_, err = te.Commit(nil)
+	return err
+}
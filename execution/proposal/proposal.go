@@ -24,6 +24,11 @@ type Iterable interface {
 	IterateProposals(consumer func(proposalHash []byte, proposal *payload.Ballot) error) (err error)
 }
 
+type ExpiryIterable interface {
+	// Iterates over the proposals still open at their expiry height
+	IterateExpiringProposals(height uint64, consumer func(proposalHash []byte) error) error
+}
+
 type IterableReader interface {
 	Iterable
 	Reader
@@ -0,0 +1,277 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposals(t *testing.T) {
+	t.Run("BeforeUpgrade", func(t *testing.T) {
+		exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+		proposal := exe.sendProposal(t, accounts[1], accounts[0], 5)
+		proposalHash := binary.HexBytes(contexts.HashProposal(proposal))
+
+		// New terms are refused so the proposal cannot be decided differently on replay
+		withQuorum := *proposal
+		withQuorum.Quorum = &payload.Fraction{Numerator: 1, Denominator: 1}
+		_, err := exe.propose(t, validators[0], &payload.ProposalTx{Proposal: &withQuorum, VotingWeight: 1})
+		require.Error(t, err)
+		assert.Equal(t, errors.Codes.InvalidProposal, errors.GetCode(err))
+
+		// Votes are counted against the ProposalThreshold without emitting ProposalEvents
+		txe, err := exe.propose(t, validators[0], &payload.ProposalTx{Proposal: proposal, VotingWeight: 1})
+		require.NoError(t, err)
+		for _, ev := range txe.Events {
+			assert.Nil(t, ev.Proposal)
+		}
+		_, err = exe.propose(t, validators[0], &payload.ProposalTx{ProposalHash: &proposalHash, Withdraw: true})
+		require.Error(t, err)
+		assert.Equal(t, errors.Codes.InvalidProposal, errors.GetCode(err))
+		for _, voter := range validators[1:] {
+			if exe.getBallot(t, proposalHash).ProposalState == payload.Ballot_EXECUTED {
+				break
+			}
+			_, err = exe.propose(t, voter, &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, payload.Ballot_EXECUTED, exe.getBallot(t, proposalHash).ProposalState)
+
+		// Votes on a decided ballot fail as they always have, on the sequence numbers of its transactions
+		_, err = exe.propose(t, accounts[0], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.Error(t, err)
+		assert.NotEqual(t, errors.Codes.ProposalExecuted, errors.GetCode(err))
+		assert.Contains(t, err.Error(), "sequence number")
+	})
+
+	t.Run("ValidatorPower", func(t *testing.T) {
+		exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+		exe.activateUpgrade(t, accounts[0], governance.ProposalsUpgrade)
+		proposal := exe.sendProposal(t, accounts[1], accounts[0], 5)
+		proposal.Quorum = &payload.Fraction{Numerator: 2, Denominator: 3}
+		proposal.Threshold = &payload.Fraction{Numerator: 2, Denominator: 3}
+		proposalHash := binary.HexBytes(contexts.HashProposal(proposal))
+		balance := exe.getAccount(t, accounts[0].GetAddress()).Balance
+
+		// A third of the power is short of quorum
+		txe, err := exe.propose(t, validators[0], &payload.ProposalTx{Proposal: proposal, VotingWeight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_PROPOSED, exe.getBallot(t, proposalHash).ProposalState)
+		ev := proposalEvent(t, txe)
+		assert.Equal(t, proposalHash, ev.ProposalHash)
+		assert.Equal(t, validators[0].GetAddress(), ev.Vote.Address)
+
+		// Accounts without power cannot vote
+		_, err = exe.propose(t, accounts[0], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no voting weight")
+
+		// Quorum reached but only half in favour
+		_, err = exe.propose(t, validators[1], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 0})
+		require.NoError(t, err)
+		assert.Len(t, exe.getBallot(t, proposalHash).Votes, 2)
+		assert.Equal(t, payload.Ballot_PROPOSED, exe.getBallot(t, proposalHash).ProposalState)
+
+		txe, err = exe.propose(t, validators[1], &payload.ProposalTx{ProposalHash: &proposalHash, Withdraw: true})
+		require.NoError(t, err)
+		assert.True(t, proposalEvent(t, txe).Withdrawn)
+		assert.Len(t, exe.getBallot(t, proposalHash).Votes, 1)
+		_, err = exe.propose(t, validators[1], &payload.ProposalTx{ProposalHash: &proposalHash, Withdraw: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has not voted")
+
+		txe, err = exe.propose(t, validators[1], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_EXECUTED, exe.getBallot(t, proposalHash).ProposalState)
+		assert.Equal(t, payload.Ballot_EXECUTED, proposalEvent(t, txe).ProposalState)
+		assert.Equal(t, balance+5, exe.getAccount(t, accounts[0].GetAddress()).Balance)
+
+		_, err = exe.propose(t, validators[2], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.Error(t, err)
+		assert.Equal(t, errors.Codes.ProposalExecuted, errors.GetCode(err))
+	})
+
+	t.Run("Role", func(t *testing.T) {
+		exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+		exe.activateUpgrade(t, accounts[0], governance.ProposalsUpgrade)
+		root := accounts[0]
+		proposal := exe.sendProposal(t, accounts[1], root, 5)
+		proposal.Role = "council"
+		proposalHash := binary.HexBytes(contexts.HashProposal(proposal))
+
+		_, err := exe.propose(t, validators[0], &payload.ProposalTx{Proposal: proposal, VotingWeight: 1})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no voting weight")
+
+		var council permission.AccountPermissions
+		council.AddRole(proposal.Role)
+		for _, member := range validators[:2] {
+			address := member.GetAddress()
+			require.NoError(t, exe.govern(t, root, payload.UpdateAccountTx(root.GetAddress(),
+				&spec.TemplateAccount{Address: &address, Roles: council.Roles})))
+		}
+
+		// The default threshold is half of the votes cast
+		_, err = exe.propose(t, validators[0], &payload.ProposalTx{Proposal: proposal, VotingWeight: 0})
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_PROPOSED, exe.getBallot(t, proposalHash).ProposalState)
+		_, err = exe.propose(t, validators[1], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_EXECUTED, exe.getBallot(t, proposalHash).ProposalState)
+	})
+
+	t.Run("RoleGrantedInBlock", func(t *testing.T) {
+		exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+		exe.activateUpgrade(t, accounts[0], governance.ProposalsUpgrade)
+		root := accounts[0]
+		proposal := exe.sendProposal(t, accounts[1], root, 5)
+		proposal.Role = "council"
+		proposal.Quorum = &payload.Fraction{Numerator: 1, Denominator: 1}
+		proposalHash := binary.HexBytes(contexts.HashProposal(proposal))
+
+		var council permission.AccountPermissions
+		council.AddRole(proposal.Role)
+		grant := func(member acm.AddressableSigner) *payload.GovTx {
+			address := member.GetAddress()
+			return payload.UpdateAccountTx(root.GetAddress(), &spec.TemplateAccount{Address: &address, Roles: council.Roles})
+		}
+		for _, member := range validators[:2] {
+			require.NoError(t, exe.govern(t, root, grant(member)))
+		}
+
+		// Grant the role to a third member in the same block as the others vote, so they fall short of quorum
+		execute := func(tx payload.Payload, signer acm.AddressableSigner) {
+			txEnv := txs.Enclose(testChainID, tx)
+			require.NoError(t, txEnv.Sign(signer))
+			txe, err := exe.Execute(txEnv)
+			require.NoError(t, err)
+			require.Nil(t, txe.Exception)
+		}
+		govTx := grant(validators[2])
+		govTx.Inputs[0].Sequence = exe.getAccount(t, root.GetAddress()).Sequence + 1
+		execute(govTx, root)
+		for i, tx := range []*payload.ProposalTx{
+			{Proposal: proposal, VotingWeight: 1},
+			{ProposalHash: &proposalHash, VotingWeight: 1},
+		} {
+			tx.Input = &payload.TxInput{
+				Address:  validators[i].GetAddress(),
+				Sequence: exe.getAccount(t, validators[i].GetAddress()).Sequence + 1,
+			}
+			execute(tx, validators[i])
+		}
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_PROPOSED, exe.getBallot(t, proposalHash).ProposalState)
+
+		_, err = exe.propose(t, validators[2], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_EXECUTED, exe.getBallot(t, proposalHash).ProposalState)
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+		exe.activateUpgrade(t, accounts[0], governance.ProposalsUpgrade)
+		proposal := exe.sendProposal(t, accounts[1], accounts[0], 5)
+		proposal.Quorum = &payload.Fraction{Numerator: 1, Denominator: 1}
+
+		// The proposal tx will be executed in the next block
+		proposal.ExpiryHeight = exe.LastBlockHeight() + 1
+		_, err := exe.propose(t, validators[0], &payload.ProposalTx{Proposal: proposal, VotingWeight: 1})
+		require.Error(t, err)
+		assert.Equal(t, errors.Codes.ExpiredProposal, errors.GetCode(err))
+
+		proposal.ExpiryHeight = exe.LastBlockHeight() + 2
+		proposalHash := binary.HexBytes(contexts.HashProposal(proposal))
+		_, err = exe.propose(t, validators[0], &payload.ProposalTx{Proposal: proposal, VotingWeight: 1})
+		require.NoError(t, err)
+		_, err = exe.propose(t, validators[1], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, payload.Ballot_EXPIRED, exe.getBallot(t, proposalHash).ProposalState)
+
+		// The block at the expiry height records the expiry
+		var events []*exec.Event
+		height := proposal.ExpiryHeight
+		require.NoError(t, exe.state.(*state.State).IterateStreamEvents(&height, &height, storage.AscendingSort,
+			func(ev *exec.StreamEvent) error {
+				if ev.Event != nil && ev.Event.Proposal != nil {
+					events = append(events, ev.Event)
+				}
+				return nil
+			}))
+		require.Len(t, events, 2)
+		assert.Equal(t, payload.Ballot_PROPOSED, events[0].Proposal.ProposalState)
+		assert.Equal(t, payload.Ballot_EXPIRED, events[1].Proposal.ProposalState)
+		assert.Equal(t, exec.TypeProposal, events[1].EventType())
+
+		_, err = exe.propose(t, validators[2], &payload.ProposalTx{ProposalHash: &proposalHash, VotingWeight: 1})
+		require.Error(t, err)
+		assert.Equal(t, errors.Codes.ExpiredProposal, errors.GetCode(err))
+	})
+}
+
+// Proposes a send from the proposer account, which must not otherwise transact while the proposal is open
+func (te *testExecutor) sendProposal(t *testing.T, from, to acm.AddressableSigner, amount uint64) *payload.Proposal {
+	sequence := te.getAccount(t, from.GetAddress()).Sequence + 1
+	tx := payload.NewSendTx()
+	require.NoError(t, tx.AddInputWithSequence(from.GetPublicKey(), amount, sequence))
+	tx.AddOutput(to.GetAddress(), amount)
+	return &payload.Proposal{
+		Name:        "send",
+		Description: "Send from the proposal account",
+		BatchTx: &payload.BatchTx{
+			Inputs: []*payload.TxInput{{Address: from.GetAddress(), Sequence: sequence}},
+			Txs:    []*payload.Any{tx.Any()},
+		},
+	}
+}
+
+func (te *testExecutor) propose(t *testing.T, signer acm.AddressableSigner,
+	tx *payload.ProposalTx) (*exec.TxExecution, error) {
+	tx.Input = &payload.TxInput{
+		Address:  signer.GetAddress(),
+		Sequence: te.getAccount(t, signer.GetAddress()).Sequence + 1,
+	}
+	txEnv := txs.Enclose(testChainID, tx)
+	require.NoError(t, txEnv.Sign(signer))
+	txe, err := te.Execute(txEnv)
+	if err == nil && txe.Exception != nil {
+		err = txe.Exception
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, err = te.Commit(nil)
+	return txe, err
+}
+
+func (te *testExecutor) getBallot(t *testing.T, proposalHash []byte) *payload.Ballot {
+	ballot, err := te.state.GetProposal(proposalHash)
+	require.NoError(t, err)
+	require.NotNil(t, ballot)
+	return ballot
+}
+
+func proposalEvent(t *testing.T, txe *exec.TxExecution) *exec.ProposalEvent {
+	for _, ev := range txe.Events {
+		if ev.Proposal != nil {
+			return ev.Proposal
+		}
+	}
+	require.Fail(t, "no ProposalEvent in TxExecution")
+	return nil
+}
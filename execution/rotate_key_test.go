@@ -0,0 +1,145 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/liveness"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+	address := accounts[0].GetAddress()
+	rotated := accountSigner{acm.GenerateEthereumAccountFromSecret("rotated"), address}
+
+	// Switch from ed25519 to secp256k1
+	tx := payload.NewRotateKeyTx(address, rotated.GetPublicKey())
+	tx.Input.Sequence = exe.getAccount(t, address).Sequence + 1
+	require.NoError(t, exe.signExecuteCommit(tx, accounts[0]))
+	acc := exe.getAccount(t, address)
+	assert.True(t, rotated.GetPublicKey().Equal(acc.PublicKey))
+	assert.Equal(t, crypto.CurveTypeSecp256k1, acc.PublicKey.CurveType)
+
+	err := exe.signExecuteCommit(exe.sendFrom(t, address, accounts[1], 1), accounts[0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not the current key of the account")
+	require.NoError(t, exe.signExecuteCommit(exe.sendFrom(t, address, accounts[1], 1), rotated))
+
+	tx = payload.NewRotateKeyTx(validators[0].GetAddress(), rotated.GetPublicKey())
+	tx.Input.Sequence = exe.getAccount(t, validators[0].GetAddress()).Sequence + 1
+	err = exe.signExecuteCommit(tx, validators[0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is a validator")
+
+	// Governance replaces the key of an account whose key is lost
+	recovery := accountSigner{acm.GeneratePrivateAccountFromSecret("recovery"), address}
+	recoveryKey := recovery.GetPublicKey()
+	require.NoError(t, exe.govern(t, accounts[1], payload.UpdateAccountTx(accounts[1].GetAddress(),
+		&spec.TemplateAccount{Address: &address, PublicKey: &recoveryKey})))
+	assert.True(t, recoveryKey.Equal(exe.getAccount(t, address).PublicKey))
+	require.Error(t, exe.signExecuteCommit(exe.sendFrom(t, address, accounts[1], 1), rotated))
+	require.NoError(t, exe.signExecuteCommit(exe.sendFrom(t, address, accounts[1], 1), recovery))
+
+	// Nor of a validator since the validator set would keep the old key
+	validatorAddress := validators[0].GetAddress()
+	err = exe.govern(t, accounts[1], payload.UpdateAccountTx(accounts[1].GetAddress(),
+		&spec.TemplateAccount{Address: &validatorAddress, PublicKey: &recoveryKey}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is a validator so its key cannot be replaced")
+	assert.True(t, validators[0].GetPublicKey().Equal(exe.getAccount(t, validatorAddress).PublicKey))
+
+	// But cannot assign a key to an address it was not derived from for an account that does not exist
+	unknown := crypto.Address{1, 2, 3}
+	err = exe.govern(t, accounts[1], payload.UpdateAccountTx(accounts[1].GetAddress(),
+		&spec.TemplateAccount{Address: &unknown, PublicKey: &recoveryKey}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can only replace the key of an existing account")
+}
+
+func TestRotateKeyBondedInBlock(t *testing.T) {
+	exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+	signer := accounts[1]
+	address := signer.GetAddress()
+	rotated := accountSigner{acm.GenerateEthereumAccountFromSecret("rotated"), address}
+	sequence := exe.getAccount(t, address).Sequence
+
+	// Becoming a validator only shows up in the current validator set once the block is committed
+	bondTx := payload.NewBondTx(address, 10)
+	bondTx.Input.Sequence = sequence + 1
+	bondEnv := txs.Enclose(testChainID, bondTx)
+	require.NoError(t, bondEnv.Sign(signer))
+	txe, err := exe.Execute(bondEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	tx := payload.NewRotateKeyTx(address, rotated.GetPublicKey())
+	tx.Input.Sequence = sequence + 2
+	txEnv := txs.Enclose(testChainID, tx)
+	require.NoError(t, txEnv.Sign(signer))
+	txe, err = exe.Execute(txEnv)
+	if err == nil {
+		err = txe.Exception.AsError()
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is a validator")
+
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.True(t, signer.GetPublicKey().Equal(exe.getAccount(t, address).PublicKey))
+}
+
+func TestRotateKeyJailedOrBonded(t *testing.T) {
+	t.Run("Jailed", func(t *testing.T) {
+		exe, validators, _ := makeLivenessExecutor(t, liveness.Params{Window: 4, JailBlocks: 1})
+		offline := validators[3]
+		require.NoError(t, exe.recordBlock(validators, offline))
+		require.True(t, exe.getLiveness(t, offline).Jailed)
+		assert.Equal(t, uint64(0), exe.power(t, offline))
+
+		// Otherwise unjailing would restore its power to the key it had been using
+		rotated := acm.GeneratePrivateAccountFromSecret("rotated")
+		tx := payload.NewRotateKeyTx(offline.GetAddress(), rotated.GetPublicKey())
+		tx.Input.Sequence = exe.getAccount(t, offline.GetAddress()).Sequence + 1
+		err := exe.signExecuteCommit(tx, offline)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is a jailed validator")
+	})
+
+	t.Run("Bonded", func(t *testing.T) {
+		exe, _, accounts := makeStakingExecutor(t, staking.Params{})
+		signer := accounts[1]
+		address := signer.GetAddress()
+		require.NoError(t, exe.bond(t, signer, 10, nil))
+
+		// Governance may take away its power but its bond is still held against its key
+		publicKey := signer.GetPublicKey()
+		require.NoError(t, exe.govern(t, accounts[0], payload.UpdateAccountTx(accounts[0].GetAddress(),
+			&spec.TemplateAccount{PublicKey: &publicKey, Amounts: balance.New().Power(0)})))
+		assert.Equal(t, uint64(0), exe.power(t, signer))
+
+		rotated := acm.GeneratePrivateAccountFromSecret("rotated")
+		tx := payload.NewRotateKeyTx(address, rotated.GetPublicKey())
+		tx.Input.Sequence = exe.getAccount(t, address).Sequence + 1
+		err := exe.signExecuteCommit(tx, signer)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bonds held against its key")
+	})
+}
+
+// Signs for an account with a key other than the one from which its address was derived
+type accountSigner struct {
+	acm.AddressableSigner
+	address crypto.Address
+}
+
+func (as accountSigner) GetAddress() crypto.Address {
+	return as.address
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/fees"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -25,6 +26,7 @@ func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress,
 		State:         cache,
 		MetadataState: acmstate.NewMemoryState(),
 		Blockchain:    blockchain,
+		Fees:          new(fees.Pool),
 		Logger:        logger,
 	}
 
@@ -0,0 +1,241 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package staking
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Cache buffers updates to bonds and unbondings for the duration of a block
+type Cache struct {
+	sync.RWMutex
+	backend    Reader
+	bonds      map[bondKey]*bondInfo
+	unbondings map[unbondingKey]*unbondingInfo
+}
+
+type bondKey struct {
+	validator crypto.Address
+	delegator crypto.Address
+}
+
+type unbondingKey struct {
+	releaseHeight uint64
+	bondKey
+}
+
+type bondInfo struct {
+	bond    *Bond
+	updated bool
+}
+
+type unbondingInfo struct {
+	unbonding *Unbonding
+	updated   bool
+}
+
+var _ ReaderWriter = &Cache{}
+
+// NewCache returns a Cache which can write to an output Writer via Sync.
+func NewCache(backend Reader) *Cache {
+	return &Cache{
+		backend:    backend,
+		bonds:      make(map[bondKey]*bondInfo),
+		unbondings: make(map[unbondingKey]*unbondingInfo),
+	}
+}
+
+func (cache *Cache) GetBond(validator, delegator crypto.Address) (*Bond, error) {
+	info, err := cache.getBond(bondKey{validator, delegator})
+	if err != nil {
+		return nil, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if info.bond == nil {
+		return nil, nil
+	}
+	bond := *info.bond
+	return &bond, nil
+}
+
+func (cache *Cache) UpdateBond(bond *Bond) error {
+	info, err := cache.getBond(bondKey{bond.Validator, bond.Delegator})
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	info.bond = bond
+	info.updated = true
+	return nil
+}
+
+func (cache *Cache) IterateValidatorBonds(validator crypto.Address, consumer func(*Bond) error) error {
+	// Bonds in the cache take precedence over those in the backend
+	seen := make(map[crypto.Address]bool)
+	err := cache.backend.IterateValidatorBonds(validator, func(bond *Bond) error {
+		seen[bond.Delegator] = true
+		cached, err := cache.GetBond(validator, bond.Delegator)
+		if err != nil || cached == nil {
+			return err
+		}
+		return consumer(cached)
+	})
+	if err != nil {
+		return err
+	}
+	cache.RLock()
+	var added []*Bond
+	for key, info := range cache.bonds {
+		if key.validator == validator && !seen[key.delegator] && info.bond != nil {
+			bond := *info.bond
+			added = append(added, &bond)
+		}
+	}
+	cache.RUnlock()
+	sort.Slice(added, func(i, j int) bool {
+		return bytes.Compare(added[i].Delegator.Bytes(), added[j].Delegator.Bytes()) < 0
+	})
+	for _, bond := range added {
+		err = consumer(bond)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cache *Cache) GetUnbonding(releaseHeight uint64, validator, delegator crypto.Address) (*Unbonding, error) {
+	info, err := cache.getUnbonding(unbondingKey{releaseHeight, bondKey{validator, delegator}})
+	if err != nil {
+		return nil, err
+	}
+	cache.RLock()
+	defer cache.RUnlock()
+	if info.unbonding == nil {
+		return nil, nil
+	}
+	unbonding := *info.unbonding
+	return &unbonding, nil
+}
+
+func (cache *Cache) UpdateUnbonding(unbonding *Unbonding) error {
+	info, err := cache.getUnbonding(unbondingKey{unbonding.ReleaseHeight,
+		bondKey{unbonding.Validator, unbonding.Delegator}})
+	if err != nil {
+		return err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	info.unbonding = unbonding
+	info.updated = true
+	return nil
+}
+
+// Sync writes whatever is in the cache to the output state in key order. Does not flush the cache, to do that call
+// Reset() after Sync
+func (cache *Cache) Sync(state Writer) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	bondKeys := make([]bondKey, 0, len(cache.bonds))
+	for key, info := range cache.bonds {
+		if info.updated {
+			bondKeys = append(bondKeys, key)
+		}
+	}
+	sort.Slice(bondKeys, func(i, j int) bool {
+		return bytes.Compare(bondKeys[i].bytes(), bondKeys[j].bytes()) < 0
+	})
+	for _, key := range bondKeys {
+		err := state.UpdateBond(cache.bonds[key].bond)
+		if err != nil {
+			return err
+		}
+	}
+	unbondingKeys := make([]unbondingKey, 0, len(cache.unbondings))
+	for key, info := range cache.unbondings {
+		if info.updated {
+			unbondingKeys = append(unbondingKeys, key)
+		}
+	}
+	sort.Slice(unbondingKeys, func(i, j int) bool {
+		return bytes.Compare(unbondingKeys[i].bytes(), unbondingKeys[j].bytes()) < 0
+	})
+	for _, key := range unbondingKeys {
+		err := state.UpdateUnbonding(cache.unbondings[key].unbonding)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reset the cache to empty
+func (cache *Cache) Reset(backend Reader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.bonds = make(map[bondKey]*bondInfo)
+	cache.unbondings = make(map[unbondingKey]*unbondingInfo)
+}
+
+func (cache *Cache) getBond(key bondKey) (*bondInfo, error) {
+	cache.RLock()
+	info := cache.bonds[key]
+	cache.RUnlock()
+	if info == nil {
+		cache.Lock()
+		defer cache.Unlock()
+		info = cache.bonds[key]
+		if info == nil {
+			bond, err := cache.backend.GetBond(key.validator, key.delegator)
+			if err != nil {
+				return nil, err
+			}
+			info = &bondInfo{
+				bond: bond,
+			}
+			cache.bonds[key] = info
+		}
+	}
+	return info, nil
+}
+
+func (cache *Cache) getUnbonding(key unbondingKey) (*unbondingInfo, error) {
+	cache.RLock()
+	info := cache.unbondings[key]
+	cache.RUnlock()
+	if info == nil {
+		cache.Lock()
+		defer cache.Unlock()
+		info = cache.unbondings[key]
+		if info == nil {
+			unbonding, err := cache.backend.GetUnbonding(key.releaseHeight, key.validator, key.delegator)
+			if err != nil {
+				return nil, err
+			}
+			info = &unbondingInfo{
+				unbonding: unbonding,
+			}
+			cache.unbondings[key] = info
+		}
+	}
+	return info, nil
+}
+
+func (key bondKey) bytes() []byte {
+	return append(key.validator.Bytes(), key.delegator.Bytes()...)
+}
+
+func (key unbondingKey) bytes() []byte {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, key.releaseHeight)
+	return append(bs, key.bondKey.bytes()...)
+}
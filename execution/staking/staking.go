@@ -0,0 +1,136 @@
+// Copyright Monax Industries Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package staking
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Params governing how bonded balances are released
+type Params struct {
+	// The number of blocks after unbonding before the balance is returned, zero returns it immediately
+	UnbondingBlocks uint64
+}
+
+type Reader interface {
+	// Returns nil if the delegator has no bond with the validator
+	GetBond(validator, delegator crypto.Address) (*Bond, error)
+	// Iterates over the bonds with the validator, including its bond with itself
+	IterateValidatorBonds(validator crypto.Address, consumer func(*Bond) error) error
+	// Returns nil if there is no unbonding from the validator to the delegator released at releaseHeight
+	GetUnbonding(releaseHeight uint64, validator, delegator crypto.Address) (*Unbonding, error)
+}
+
+type Writer interface {
+	// Updates the bond, removing it if its amount is zero
+	UpdateBond(bond *Bond) error
+	// Updates the unbonding, removing it if its amount is zero
+	UpdateUnbonding(unbonding *Unbonding) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	IterateBonds(consumer func(*Bond) error) error
+	// Iterates over pending unbondings in order of release height
+	IterateUnbondings(consumer func(*Unbonding) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
+
+func (b *Bond) IsDelegation() bool {
+	return b.Validator != b.Delegator
+}
+
+func (b *Bond) String() string {
+	return fmt.Sprintf("Bond{%v -> %v: %d}", b.Delegator, b.Validator, b.Amount)
+}
+
+func (u *Unbonding) String() string {
+	return fmt.Sprintf("Unbonding{%v <- %v: %d at %d}", u.Delegator, u.Validator, u.Amount, u.ReleaseHeight)
+}
+
+// AddBond locks amount of the delegator's balance against the validator with publicKey
+func AddBond(rw ReaderWriter, publicKey crypto.PublicKey, delegator crypto.Address, amount uint64) error {
+	validator := publicKey.GetAddress()
+	bond, err := rw.GetBond(validator, delegator)
+	if err != nil {
+		return err
+	}
+	if bond == nil {
+		bond = &Bond{
+			Validator: validator,
+			Delegator: delegator,
+			PublicKey: publicKey,
+		}
+	}
+	bond.Amount += amount
+	return rw.UpdateBond(bond)
+}
+
+// SubtractBond releases amount of the delegator's bond with the validator, failing if it has less than amount bonded,
+// and returns what remains of the bond
+func SubtractBond(rw ReaderWriter, validator, delegator crypto.Address, amount uint64) (*Bond, error) {
+	bond, err := rw.GetBond(validator, delegator)
+	if err != nil {
+		return nil, err
+	}
+	if bond == nil || bond.Amount < amount {
+		var bonded uint64
+		if bond != nil {
+			bonded = bond.Amount
+		}
+		return nil, fmt.Errorf("%v has only %d bonded to validator %v so cannot unbond %d", delegator, bonded,
+			validator, amount)
+	}
+	bond.Amount -= amount
+	return bond, rw.UpdateBond(bond)
+}
+
+// Validators given power at genesis or by governance have no bonds for it so any power a validator has beyond that of
+// its bonds is an implicit self-bond. RecordSelfBond records the implicit self-bond of a validator with power and
+// returns its bond with itself, which is nil if it has none.
+func RecordSelfBond(rw ReaderWriter, publicKey crypto.PublicKey, power uint64) (*Bond, error) {
+	validator := publicKey.GetAddress()
+	var bonded uint64
+	err := rw.IterateValidatorBonds(validator, func(bond *Bond) error {
+		bonded += bond.Amount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if power > bonded {
+		err = AddBond(rw, publicKey, validator, power-bonded)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rw.GetBond(validator, validator)
+}
+
+// AddUnbonding queues amount to be returned to the delegator at releaseHeight
+func AddUnbonding(rw ReaderWriter, releaseHeight uint64, validator, delegator crypto.Address, amount uint64) error {
+	unbonding, err := rw.GetUnbonding(releaseHeight, validator, delegator)
+	if err != nil {
+		return err
+	}
+	if unbonding == nil {
+		unbonding = &Unbonding{
+			Validator:     validator,
+			Delegator:     delegator,
+			ReleaseHeight: releaseHeight,
+		}
+	}
+	unbonding.Amount += amount
+	return rw.UpdateUnbonding(unbonding)
+}
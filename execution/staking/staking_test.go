@@ -0,0 +1,73 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBonds(t *testing.T) {
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	delegator := acm.GeneratePrivateAccountFromSecret("delegator")
+	cache := NewCache(new(memoryStaking))
+
+	require.NoError(t, AddBond(cache, val.GetPublicKey(), delegator.GetAddress(), 10))
+	require.NoError(t, AddBond(cache, val.GetPublicKey(), delegator.GetAddress(), 5))
+	bond, err := cache.GetBond(val.GetAddress(), delegator.GetAddress())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), bond.Amount)
+	assert.Equal(t, val.GetPublicKey(), bond.PublicKey)
+	assert.True(t, bond.IsDelegation())
+
+	_, err = SubtractBond(cache, val.GetAddress(), delegator.GetAddress(), 16)
+	require.Error(t, err)
+	bond, err = SubtractBond(cache, val.GetAddress(), delegator.GetAddress(), 15)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), bond.Amount)
+
+	require.NoError(t, AddUnbonding(cache, 4, val.GetAddress(), delegator.GetAddress(), 10))
+	require.NoError(t, AddUnbonding(cache, 4, val.GetAddress(), delegator.GetAddress(), 5))
+	require.NoError(t, AddUnbonding(cache, 2, val.GetAddress(), delegator.GetAddress(), 1))
+
+	output := new(memoryStaking)
+	require.NoError(t, cache.Sync(output))
+	assert.Empty(t, output.bonds)
+	require.Len(t, output.unbondings, 2)
+	assert.Equal(t, uint64(2), output.unbondings[0].ReleaseHeight)
+	assert.Equal(t, uint64(15), output.unbondings[1].Amount)
+}
+
+type memoryStaking struct {
+	bonds      []*Bond
+	unbondings []*Unbonding
+}
+
+func (ms *memoryStaking) GetBond(validator, delegator crypto.Address) (*Bond, error) {
+	return nil, nil
+}
+
+func (ms *memoryStaking) IterateValidatorBonds(validator crypto.Address, consumer func(*Bond) error) error {
+	return nil
+}
+
+func (ms *memoryStaking) GetUnbonding(releaseHeight uint64, validator, delegator crypto.Address) (*Unbonding, error) {
+	return nil, nil
+}
+
+// Keeps only what has not been removed, in the order written
+func (ms *memoryStaking) UpdateBond(bond *Bond) error {
+	if bond.Amount > 0 {
+		ms.bonds = append(ms.bonds, bond)
+	}
+	return nil
+}
+
+func (ms *memoryStaking) UpdateUnbonding(unbonding *Unbonding) error {
+	if unbonding.Amount > 0 {
+		ms.unbondings = append(ms.unbondings, unbonding)
+	}
+	return nil
+}
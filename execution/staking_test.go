@@ -0,0 +1,253 @@
+package execution
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestStaking(t *testing.T) {
+	t.Run("UnbondingPeriod", func(t *testing.T) {
+		exe, validators, _ := makeStakingExecutor(t, staking.Params{UnbondingBlocks: 2})
+		val := validators[0]
+		balance := exe.getAccount(t, val.GetAddress()).Balance
+
+		// Bonding locks the balance
+		require.NoError(t, exe.bond(t, val, 10, nil))
+		assert.Equal(t, balance-10, exe.getAccount(t, val.GetAddress()).Balance)
+		assert.Equal(t, uint64(110), exe.power(t, val))
+		assert.Equal(t, uint64(110), exe.getBond(t, val, val).Amount)
+
+		// Unbonding removes power at once but holds on to the balance
+		require.NoError(t, exe.unbond(t, val, 30, nil))
+		assert.Equal(t, uint64(80), exe.power(t, val))
+		assert.Equal(t, uint64(80), exe.getBond(t, val, val).Amount)
+		assert.Equal(t, balance-10, exe.getAccount(t, val.GetAddress()).Balance)
+		unbondings := exe.unbondings(t)
+		require.Len(t, unbondings, 1)
+		assert.Equal(t, uint64(30), unbondings[0].Amount)
+		assert.Equal(t, exe.LastBlockHeight()+2, unbondings[0].ReleaseHeight)
+
+		// Cannot unbond what is not bonded
+		err := exe.unbond(t, val, 81, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only 80 bonded")
+
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		assert.Equal(t, balance-10, exe.getAccount(t, val.GetAddress()).Balance)
+
+		// Until the unbonding period has passed
+		_, err = exe.Commit(nil)
+		require.NoError(t, err)
+		assert.Equal(t, balance+20, exe.getAccount(t, val.GetAddress()).Balance)
+		assert.Empty(t, exe.unbondings(t))
+	})
+
+	t.Run("ImplicitSelfBond", func(t *testing.T) {
+		exe, validators, _ := makeStakingExecutor(t, staking.Params{})
+		val := validators[2]
+		balance := exe.getAccount(t, val.GetAddress()).Balance
+
+		// Genesis validators have no bonds but their power is their own stake
+		assert.Nil(t, exe.maybeGetBond(t, val, val))
+		require.NoError(t, exe.unbond(t, val, 30, nil))
+		assert.Equal(t, uint64(70), exe.power(t, val))
+		assert.Equal(t, uint64(70), exe.getBond(t, val, val).Amount)
+		assert.Equal(t, balance+30, exe.getAccount(t, val.GetAddress()).Balance)
+
+		err := exe.unbond(t, val, 71, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only 70 bonded")
+	})
+
+	t.Run("Delegation", func(t *testing.T) {
+		exe, validators, accounts := makeStakingExecutor(t, staking.Params{})
+		val := validators[1]
+		valAddress := val.GetAddress()
+		delegator := accounts[0]
+		balance := exe.getAccount(t, delegator.GetAddress()).Balance
+
+		require.NoError(t, exe.bond(t, delegator, 20, &valAddress))
+		assert.Equal(t, uint64(120), exe.power(t, val))
+		assert.Equal(t, uint64(0), exe.power(t, delegator))
+		bond := exe.getBond(t, val, delegator)
+		assert.True(t, bond.IsDelegation())
+		assert.Equal(t, uint64(20), bond.Amount)
+		assert.Equal(t, balance-20, exe.getAccount(t, delegator.GetAddress()).Balance)
+
+		// Only bonded validators can be delegated to
+		otherAddress := accounts[1].GetAddress()
+		err := exe.bond(t, delegator, 20, &otherAddress)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a bonded validator")
+
+		// Delegated power is not the delegator's own
+		err = exe.unbond(t, delegator, 20, nil)
+		require.Error(t, err)
+
+		// With no unbonding period the balance is returned at once
+		require.NoError(t, exe.unbond(t, delegator, 20, &valAddress))
+		assert.Equal(t, uint64(100), exe.power(t, val))
+		assert.Nil(t, exe.maybeGetBond(t, val, delegator))
+		assert.Equal(t, balance, exe.getAccount(t, delegator.GetAddress()).Balance)
+		assert.Empty(t, exe.unbondings(t))
+	})
+}
+
+func TestStakingBeforeUpgrade(t *testing.T) {
+	// The same transactions executed as they were before bonds were recorded must leave the same state
+	executors, validators, accounts := makeStakingExecutors(t, staking.Params{UnbondingBlocks: 2}, 2)
+	exe, legacy := executors[0], executors[1]
+	legacy.addLegacyStakingContexts()
+	val := validators[0]
+	balance := exe.getAccount(t, val.GetAddress()).Balance
+	valAddress := val.GetAddress()
+
+	replay := func(tx payload.Payload, signer acm.AddressableSigner) error {
+		txEnv := txs.Enclose(testChainID, tx)
+		require.NoError(t, txEnv.Sign(signer))
+		err := exe.executeCommit(txEnv)
+		require.Equal(t, err, legacy.executeCommit(txEnv))
+		assert.Equal(t, legacy.state.(*state.State).Hash(), exe.state.(*state.State).Hash())
+		return err
+	}
+
+	bondTx := payload.NewBondTx(valAddress, 10)
+	bondTx.Input.Sequence = exe.getAccount(t, valAddress).Sequence + 1
+	require.NoError(t, replay(bondTx, val))
+	assert.Equal(t, uint64(110), exe.power(t, val))
+	assert.Nil(t, exe.maybeGetBond(t, val, val))
+
+	// The balance is returned at once regardless of the unbonding period
+	unbondTx := payload.NewUnbondTx(valAddress, 30)
+	unbondTx.Input.Sequence = exe.getAccount(t, valAddress).Sequence + 1
+	require.NoError(t, replay(unbondTx, val))
+	assert.Equal(t, uint64(80), exe.power(t, val))
+	assert.Equal(t, balance+20, exe.getAccount(t, valAddress).Balance)
+	assert.Empty(t, exe.unbondings(t))
+
+	// Delegation is new in the upgrade
+	err := exe.bond(t, accounts[0], 20, &valAddress)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "until the staking upgrade")
+}
+
+func makeStakingExecutor(t *testing.T, stakingParams staking.Params) (*testExecutor, []acm.AddressableSigner,
+	[]acm.AddressableSigner) {
+	executors, validators, accounts := makeStakingExecutors(t, stakingParams, 1)
+	executors[0].activateUpgrade(t, accounts[0], governance.StakingUpgrade)
+	return executors[0], validators, accounts
+}
+
+// Makes n executors from the same genesis, before the staking upgrade
+func makeStakingExecutors(t *testing.T, stakingParams staking.Params, n int) ([]*testExecutor,
+	[]acm.AddressableSigner, []acm.AddressableSigner) {
+	genDoc, privAccounts, privValidators := deterministicGenesis.GenesisDoc(2, 3)
+	validators := make([]acm.AddressableSigner, len(privValidators))
+	for i, val := range privValidators {
+		genDoc.Validators[i].Amount = 100
+		validators[i] = val
+	}
+	accounts := make([]acm.AddressableSigner, len(privAccounts))
+	for i, acc := range privAccounts {
+		accounts[i] = acc
+	}
+	params := ParamsFromGenesis(testGenesisDoc)
+	params.Staking = stakingParams
+	executors := make([]*testExecutor, n)
+	for i := range executors {
+		st, err := state.MakeGenesisState(dbm.NewMemDB(), genDoc)
+		require.NoError(t, err)
+		require.NoError(t, st.InitialCommit())
+		executors[i] = makeExecutorWithParams(st, params)
+	}
+	return executors, validators, accounts
+}
+
+func (te *testExecutor) bond(t *testing.T, signer acm.AddressableSigner, amount uint64,
+	validator *crypto.Address) error {
+	tx := payload.NewBondTx(signer.GetAddress(), amount)
+	tx.Input.Sequence = te.getAccount(t, signer.GetAddress()).Sequence + 1
+	tx.Validator = validator
+	return te.signExecuteCommit(tx, signer)
+}
+
+func (te *testExecutor) unbond(t *testing.T, signer acm.AddressableSigner, amount uint64,
+	validator *crypto.Address) error {
+	tx := payload.NewUnbondTx(signer.GetAddress(), amount)
+	tx.Input.Sequence = te.getAccount(t, signer.GetAddress()).Sequence + 1
+	tx.Validator = validator
+	return te.signExecuteCommit(tx, signer)
+}
+
+func (te *testExecutor) maybeGetBond(t *testing.T, val, delegator crypto.Addressable) *staking.Bond {
+	bond, err := te.state.GetBond(val.GetAddress(), delegator.GetAddress())
+	require.NoError(t, err)
+	return bond
+}
+
+func (te *testExecutor) getBond(t *testing.T, val, delegator crypto.Addressable) *staking.Bond {
+	bond := te.maybeGetBond(t, val, delegator)
+	require.NotNil(t, bond)
+	return bond
+}
+
+func (te *testExecutor) unbondings(t *testing.T) []*staking.Unbonding {
+	var unbondings []*staking.Unbonding
+	err := te.state.IterateUnbondings(func(unbonding *staking.Unbonding) error {
+		unbondings = append(unbondings, unbonding)
+		return nil
+	})
+	require.NoError(t, err)
+	return unbondings
+}
+
+// Replaces the BondTx and UnbondTx contexts with those of Burrow before bonds were recorded, which moved an account's
+// own balance to and from its power
+func (te *testExecutor) addLegacyStakingContexts() {
+	te.AddContext(payload.TypeBond, contextFunc(func(txe *exec.TxExecution, p payload.Payload) error {
+		tx := p.(*payload.BondTx)
+		account, err := te.stateCache.GetAccount(tx.Input.Address)
+		if err != nil {
+			return err
+		}
+		err = account.SubtractFromBalance(tx.Input.Amount)
+		if err != nil {
+			return err
+		}
+		err = validator.AddPower(te.validatorCache, account.PublicKey, new(big.Int).SetUint64(tx.Input.Amount))
+		if err != nil {
+			return err
+		}
+		return te.stateCache.UpdateAccount(account)
+	}))
+	te.AddContext(payload.TypeUnbond, contextFunc(func(txe *exec.TxExecution, p payload.Payload) error {
+		tx := p.(*payload.UnbondTx)
+		account, err := te.stateCache.GetAccount(tx.Input.Address)
+		if err != nil {
+			return err
+		}
+		err = account.AddToBalance(tx.Output.Amount)
+		if err != nil {
+			return err
+		}
+		err = validator.SubtractPower(te.validatorCache, account.PublicKey, new(big.Int).SetUint64(tx.Output.Amount))
+		if err != nil {
+			return err
+		}
+		return te.stateCache.UpdateAccount(account)
+	}))
+}
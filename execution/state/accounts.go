@@ -50,6 +50,40 @@ func (ws *writeState) statsRemoveAccount(acc *acm.Account) {
 	}
 }
 
+func (ws *writeState) statsAddRoles(acc *acm.Account) {
+	ws.roleLock.Lock()
+	defer ws.roleLock.Unlock()
+	for _, role := range uniqueRoles(acc) {
+		ws.roleCounts[role]++
+	}
+}
+
+func (ws *writeState) statsRemoveRoles(acc *acm.Account) {
+	ws.roleLock.Lock()
+	defer ws.roleLock.Unlock()
+	for _, role := range uniqueRoles(acc) {
+		ws.roleCounts[role]--
+		if ws.roleCounts[role] == 0 {
+			delete(ws.roleCounts, role)
+		}
+	}
+}
+
+func uniqueRoles(acc *acm.Account) []string {
+	if acc == nil {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(acc.Permissions.Roles))
+	roles := make([]string, 0, len(acc.Permissions.Roles))
+	for _, role := range acc.Permissions.Roles {
+		if _, ok := seen[role]; !ok {
+			seen[role] = struct{}{}
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
 func (ws *writeState) UpdateAccount(account *acm.Account) error {
 	if account == nil {
 		return fmt.Errorf("UpdateAccount passed nil account in State")
@@ -62,6 +96,19 @@ func (ws *writeState) UpdateAccount(account *acm.Account) error {
 	if err != nil {
 		return err
 	}
+	prevBytes, err := tree.GetWriteTree(keys.Account.KeyNoPrefix(account.Address))
+	if err != nil {
+		return err
+	}
+	if prevBytes != nil {
+		prev := new(acm.Account)
+		err = encoding.Decode(prevBytes, prev)
+		if err != nil {
+			return err
+		}
+		ws.statsRemoveRoles(prev)
+	}
+	ws.statsAddRoles(account)
 	updated := tree.Set(keys.Account.KeyNoPrefix(account.Address), bs)
 	if updated {
 		ws.statsAddAccount(account)
@@ -82,6 +129,7 @@ func (ws *writeState) RemoveAccount(address crypto.Address) error {
 			return err
 		}
 		ws.statsRemoveAccount(account)
+		ws.statsRemoveRoles(account)
 		// Delete storage associated with account too
 		_, err = ws.forest.Delete(keys.Storage.Key(address))
 		if err != nil {
@@ -110,6 +158,12 @@ func (s *State) GetAccountStats() acmstate.AccountStats {
 	return s.writeState.accountStats
 }
 
+func (s *State) CountRole(role string) (uint64, error) {
+	s.writeState.roleLock.RLock()
+	defer s.writeState.roleLock.RUnlock()
+	return s.writeState.roleCounts[string(binary.RightPadBytes([]byte(role), 32))], nil
+}
+
 // Storage
 
 func (s *ReadState) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
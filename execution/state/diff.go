@@ -0,0 +1,104 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// StateDiff returns the accounts, storage slots, names, and validators changed by the block at height along with their
+// values before and after the block by comparing the forest committed at height with that at the previous height
+func (s *State) StateDiff(height uint64) (*exec.StateDiff, error) {
+	if height == 0 {
+		return nil, fmt.Errorf("no state diff for genesis state at height 0")
+	}
+	before, err := s.ForestAtHeight(height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("could not load state at height %d: %w", height-1, err)
+	}
+	after, err := s.ForestAtHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not load state at height %d: %w", height, err)
+	}
+	diff := &exec.StateDiff{
+		Height: height,
+	}
+	err = storage.DiffForests(before, after, func(prefix, key, beforeValue, afterValue []byte) error {
+		switch {
+		case bytes.Equal(prefix, keys.Account.Prefix()):
+			accDiff := &exec.AccountDiff{
+				Address: crypto.MustAddressFromBytes(key),
+			}
+			err := decodeChange(beforeValue, afterValue, &accDiff.Before, &accDiff.After)
+			if err != nil {
+				return fmt.Errorf("could not decode account %v: %w", accDiff.Address, err)
+			}
+			diff.Accounts = append(diff.Accounts, accDiff)
+		case bytes.HasPrefix(prefix, keys.Storage.Prefix()):
+			diff.Storage = append(diff.Storage, &exec.StorageDiff{
+				Address: crypto.MustAddressFromBytes(prefix[keys.Storage.Prefix().Length():]),
+				Key:     binary.LeftPadWord256(key),
+				Before:  beforeValue,
+				After:   afterValue,
+			})
+		case bytes.Equal(prefix, keys.Name.Prefix()):
+			nameDiff := &exec.NameDiff{
+				Name: string(key),
+			}
+			err := decodeChange(beforeValue, afterValue, &nameDiff.Before, &nameDiff.After)
+			if err != nil {
+				return fmt.Errorf("could not decode name %s: %w", nameDiff.Name, err)
+			}
+			diff.Names = append(diff.Names, nameDiff)
+		case bytes.Equal(prefix, keys.Validator.Prefix()):
+			valDiff := &exec.ValidatorDiff{
+				Address: crypto.MustAddressFromBytes(key),
+			}
+			err := decodeChange(beforeValue, afterValue, &valDiff.Before, &valDiff.After)
+			if err != nil {
+				return fmt.Errorf("could not decode validator %v: %w", valDiff.Address, err)
+			}
+			diff.Validators = append(diff.Validators, valDiff)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// Decodes the values of a changed key into before and after, which are left nil where the key is absent
+func decodeChange(beforeValue, afterValue []byte, before, after interface{}) error {
+	err := decodeValue(beforeValue, before)
+	if err != nil {
+		return err
+	}
+	return decodeValue(afterValue, after)
+}
+
+func decodeValue(value []byte, ptr interface{}) error {
+	if value == nil {
+		return nil
+	}
+	switch p := ptr.(type) {
+	case **acm.Account:
+		*p = new(acm.Account)
+		return encoding.Decode(value, *p)
+	case **names.Entry:
+		*p = new(names.Entry)
+		return encoding.Decode(value, *p)
+	case **validator.Validator:
+		*p = new(validator.Validator)
+		return encoding.Decode(value, *p)
+	}
+	return fmt.Errorf("cannot decode value into %T", ptr)
+}
@@ -0,0 +1,88 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestState_StateDiff(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	acc := acm.NewAccountFromSecret("Foo")
+	acc.Balance = 10
+	removed := acm.NewAccountFromSecret("Bar")
+	val := validator.FromAccount(acm.NewAccountFromSecret("Baz"), 100)
+	key := binary.Int64ToWord256(1)
+
+	// Genesis
+	_, _, err := s.Update(func(up Updatable) error {
+		err := up.UpdateAccount(acc)
+		require.NoError(t, err)
+		err = up.UpdateAccount(removed)
+		require.NoError(t, err)
+		_, err = up.SetPower(val.GetPublicKey(), val.BigPower())
+		return err
+	})
+	require.NoError(t, err)
+
+	// Block 1
+	_, _, err = s.Update(func(up Updatable) error {
+		updated := acc.Copy()
+		updated.Balance = 20
+		err := up.UpdateAccount(updated)
+		require.NoError(t, err)
+		err = up.RemoveAccount(removed.Address)
+		require.NoError(t, err)
+		err = up.SetStorage(acc.Address, key, binary.Int64ToWord256(42).Bytes())
+		require.NoError(t, err)
+		err = up.UpdateName(&names.Entry{Name: "foo", Owner: acc.Address, Data: "bar", Expires: 100})
+		require.NoError(t, err)
+		_, err = up.SetPower(val.GetPublicKey(), big.NewInt(90))
+		return err
+	})
+	require.NoError(t, err)
+
+	diff, err := s.StateDiff(1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), diff.Height)
+
+	require.Len(t, diff.Accounts, 2)
+	for _, accDiff := range diff.Accounts {
+		switch accDiff.Address {
+		case acc.Address:
+			assert.Equal(t, uint64(10), accDiff.Before.Balance)
+			assert.Equal(t, uint64(20), accDiff.After.Balance)
+		case removed.Address:
+			assert.NotNil(t, accDiff.Before)
+			assert.Nil(t, accDiff.After)
+		default:
+			t.Errorf("unexpected account %v in diff", accDiff.Address)
+		}
+	}
+
+	require.Len(t, diff.Storage, 1)
+	assert.Equal(t, acc.Address, diff.Storage[0].Address)
+	assert.Equal(t, key, diff.Storage[0].Key)
+	assert.Empty(t, diff.Storage[0].Before)
+	assert.Equal(t, binary.Int64ToWord256(42).Bytes(), diff.Storage[0].After.Bytes())
+
+	require.Len(t, diff.Names, 1)
+	assert.Equal(t, "foo", diff.Names[0].Name)
+	assert.Nil(t, diff.Names[0].Before)
+	assert.Equal(t, "bar", diff.Names[0].After.Data)
+
+	require.Len(t, diff.Validators, 1)
+	assert.Equal(t, val.GetAddress(), diff.Validators[0].Address)
+	assert.Equal(t, uint64(100), diff.Validators[0].Before.Power)
+	assert.Equal(t, uint64(90), diff.Validators[0].After.Power)
+
+	_, err = s.StateDiff(0)
+	assert.Error(t, err)
+}
@@ -11,14 +11,14 @@ import (
 )
 
 func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
-	// If there are no transactions, do not store anything. This reduces the amount of data we store and
+	// If there are no transactions or events, do not store anything. This reduces the amount of data we store and
 	// prevents the iavl tree from changing, which means the AppHash does not change. If the AppHash changes then
 	// Tendermint will always produce another block. If we change the AppHash on empty blocks then we will continue
 	// creating empty blocks even if we have been configure to not do so.
 	// TODO: we would prefer not to do this and instead store sequential monotonic blocks, once this:
 	// https://github.com/tendermint/tendermint/issues/1909 is resolved we should be able to suppress empty blocks
 	// even when the AppHash changes
-	if len(be.TxExecutions) == 0 {
+	if be.IsEmpty() {
 		return nil
 	}
 	buf := new(bytes.Buffer)
@@ -52,12 +52,20 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 	key := keys.Event.KeyNoPrefix(be.Height)
 	tree.Set(key, buf.Bytes())
 
-	return nil
+	// Stored on the plain so it does not affect the AppHash, which allows it to be added to existing chains
+	return ws.plain.Set(keys.Bloom.Key(be.Height), be.Bloom().Bytes())
 }
 
 // Iterate SteamEvents over the closed interval [startHeight, endHeight] - i.e. startHeight and endHeight inclusive
 func (s *ReadState) IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
 	consumer func(*exec.StreamEvent) error) error {
+	return s.IterateStreamEventsMatching(startHeight, endHeight, sortOrder, nil, consumer)
+}
+
+// Like IterateStreamEvents but skips, without decoding, any block with a logs bloom for which mayMatch returns false.
+// Blocks without a logs bloom are always iterated. A nil mayMatch matches every block.
+func (s *ReadState) IterateStreamEventsMatching(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
+	mayMatch func(height uint64, bloom *exec.Bloom) bool, consumer func(*exec.StreamEvent) error) error {
 	tree, err := s.Forest.Reader(keys.Event.Prefix())
 	if err != nil {
 		return err
@@ -70,7 +78,21 @@ func (s *ReadState) IterateStreamEvents(startHeight, endHeight *uint64, sortOrde
 		// Convert to inclusive end bounds since this generally makes more sense for block height
 		endKey = keys.Event.KeyNoPrefix(*endHeight + 1)
 	}
-	return tree.Iterate(startKey, endKey, sortOrder == storage.AscendingSort, func(_, value []byte) error {
+	return tree.Iterate(startKey, endKey, sortOrder == storage.AscendingSort, func(key, value []byte) error {
+		if mayMatch != nil {
+			var height uint64
+			err := keys.Event.ScanNoPrefix(key, &height)
+			if err != nil {
+				return err
+			}
+			bloom, err := s.LogsBloom(height)
+			if err != nil {
+				return err
+			}
+			if bloom != nil && !mayMatch(height, bloom) {
+				return nil
+			}
+		}
 		buf := bytes.NewBuffer(value)
 
 		for {
@@ -141,6 +163,11 @@ func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
 			errHeader, txHash)
 	}
 
+	if key.Offset >= uint64(len(bs)) {
+		return nil, fmt.Errorf("%s reference to transaction with TxHash %X has offset %d beyond end of block",
+			errHeader, txHash, key.Offset)
+	}
+
 	buf := bytes.NewBuffer(bs[key.Offset:])
 	var stack exec.TxStack
 
@@ -161,6 +188,60 @@ func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
 	}
 }
 
+// Checks that every TxHash index entry refers to a transaction with that hash in the event tree. fn is called with each
+// dangling entry and the reason it is dangling. If repair is set dangling entries are deleted. Returns the number of
+// entries checked.
+func (s *ReadState) CheckTxHashIndex(repair bool, fn func(txHash []byte, err error) error) (int, error) {
+	it, err := keys.TxHash.Iterator(s.Plain, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	var dangling [][]byte
+	for ; it.Valid(); it.Next() {
+		txHash := append([]byte(nil), it.Key()...)
+		count++
+		txe, err := s.TxByHash(txHash)
+		if err == nil && !bytes.Equal(txe.TxHash, txHash) {
+			err = fmt.Errorf("index refers to transaction with TxHash %v", txe.TxHash)
+		}
+		if err != nil {
+			dangling = append(dangling, txHash)
+			err = fn(txHash, err)
+			if err != nil {
+				it.Close()
+				return count, err
+			}
+		}
+	}
+	err = it.Close()
+	if err != nil {
+		return count, err
+	}
+	if repair {
+		for _, txHash := range dangling {
+			err = s.Plain.Delete(keys.TxHash.Key(txHash))
+			if err != nil {
+				return count, err
+			}
+		}
+	}
+	return count, nil
+}
+
+// Returns the logs bloom of the block at height, or nil if we have no bloom for that block because it was stored before
+// we recorded blooms - in which case callers must assume that it may match
+func (s *ReadState) LogsBloom(height uint64) (*exec.Bloom, error) {
+	bs, err := s.Plain.Get(keys.Bloom.Key(height))
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	return exec.DecodeBloom(bs)
+}
+
 // Get the last block height we stored in state
 func (s *ReadState) LastStoredHeight() (uint64, error) {
 	var height uint64
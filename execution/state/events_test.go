@@ -8,6 +8,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
@@ -137,6 +138,75 @@ func TestLastBlockStored(t *testing.T) {
 	require.Equal(t, lastStoredHeight, uint64(3))
 }
 
+func TestReadState_LogsBloom(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	addBlock(t, s, uint64(1), 2, 3)
+	addBlock(t, s, uint64(2), 0, 0)
+
+	bloom, err := s.LogsBloom(1)
+	require.NoError(t, err)
+	require.NotNil(t, bloom)
+	require.Equal(t, mkBlock(1, 2, 3).Bloom(), bloom)
+	require.True(t, bloom.TestAddress(crypto.Address{1, 2}))
+	require.False(t, bloom.TestAddress(crypto.Address{1, 3}))
+
+	// Empty blocks are not stored
+	bloom, err = s.LogsBloom(2)
+	require.NoError(t, err)
+	require.Nil(t, bloom)
+
+	addBlock(t, s, uint64(3), 1, 1)
+	var heights []uint64
+	err = s.IterateStreamEventsMatching(nil, nil, storage.AscendingSort,
+		func(height uint64, bloom *exec.Bloom) bool {
+			return bloom.TestAddress(crypto.Address{3, 0})
+		},
+		func(ev *exec.StreamEvent) error {
+			if ev.BeginBlock != nil {
+				heights = append(heights, ev.BeginBlock.Height)
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, heights)
+}
+
+func TestReadState_CheckTxHashIndex(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	addBlock(t, s, uint64(1), 2, 3)
+	addBlock(t, s, uint64(2), 1, 2)
+
+	var dangling [][]byte
+	check := func(repair bool) int {
+		dangling = nil
+		count, err := s.CheckTxHashIndex(repair, func(txHash []byte, err error) error {
+			dangling = append(dangling, txHash)
+			return nil
+		})
+		require.NoError(t, err)
+		return count
+	}
+	require.Equal(t, 3, check(false))
+	require.Empty(t, dangling)
+
+	setIndex := func(txHash []byte, height, offset uint64) {
+		bs, err := encoding.Encode(&exec.TxExecutionKey{Height: height, Offset: offset})
+		require.NoError(t, err)
+		require.NoError(t, s.Plain.Set(keys.TxHash.Key(txHash), bs))
+	}
+	// Block never committed
+	setIndex(mkTxExecution(3, 0, 1).TxHash, 3, 0)
+	// Offset of some other transaction
+	setIndex(make([]byte, txs.HashLength), 1, 0)
+	// Offset past the end of the block
+	setIndex(mkTxExecution(2, 1, 1).TxHash, 2, 1<<20)
+
+	require.Equal(t, 6, check(true))
+	require.Len(t, dangling, 3)
+	require.Equal(t, 3, check(false))
+	require.Empty(t, dangling)
+}
+
 func BenchmarkAddBlockAndIterator(b *testing.B) {
 	s := NewState(dbm.NewMemDB())
 	numTxs := uint64(5)
@@ -0,0 +1,128 @@
+package state
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/storage"
+)
+
+var _ governance.Reader = &State{}
+var _ governance.UpgradeIterable = &State{}
+
+func (s *ReadState) GetExecutionParams() (*governance.ExecutionParams, error) {
+	params := new(governance.ExecutionParams)
+	found, err := s.latest(keys.ExecutionParams, params)
+	if err != nil || !found {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (s *ReadState) GetConsensusParams() (*governance.ConsensusParams, error) {
+	params := new(governance.ConsensusParams)
+	found, err := s.latest(keys.ConsensusParams, params)
+	if err != nil || !found {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (ws *writeState) UpdateExecutionParams(height uint64, params *governance.ExecutionParams) error {
+	if params == nil {
+		return fmt.Errorf("UpdateExecutionParams passed nil params in State")
+	}
+	return ws.setAtHeight(keys.ExecutionParams, height, params)
+}
+
+func (ws *writeState) UpdateConsensusParams(height uint64, params *governance.ConsensusParams) error {
+	if params == nil {
+		return fmt.Errorf("UpdateConsensusParams passed nil params in State")
+	}
+	return ws.setAtHeight(keys.ConsensusParams, height, params)
+}
+
+func (s *ReadState) GetUpgrade(name string) (*governance.Upgrade, error) {
+	tree, err := s.Forest.Reader(keys.Upgrade.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Upgrade.KeyNoPrefix(name))
+	if err != nil || bs == nil {
+		return nil, err
+	}
+	upgrade := new(governance.Upgrade)
+	return upgrade, encoding.Decode(bs, upgrade)
+}
+
+func (ws *writeState) UpdateUpgrade(upgrade *governance.Upgrade) error {
+	if upgrade == nil {
+		return fmt.Errorf("UpdateUpgrade passed nil upgrade in State")
+	}
+	tree, err := ws.forest.Writer(keys.Upgrade.Prefix())
+	if err != nil {
+		return err
+	}
+	if upgrade.Height == 0 {
+		tree.Delete(keys.Upgrade.KeyNoPrefix(upgrade.Name))
+		return nil
+	}
+	bs, err := encoding.Encode(upgrade)
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Upgrade.KeyNoPrefix(upgrade.Name), bs)
+	return nil
+}
+
+func (s *ReadState) IterateUpgrades(consumer func(*governance.Upgrade) error) error {
+	tree, err := s.Forest.Reader(keys.Upgrade.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+		upgrade := new(governance.Upgrade)
+		err := encoding.Decode(value, upgrade)
+		if err != nil {
+			return fmt.Errorf("State.IterateUpgrades() could not iterate over upgrades: %v", err)
+		}
+		return consumer(upgrade)
+	})
+}
+
+// Decodes the value stored at the greatest height under the key format into msg, returning false if there is none
+func (s *ReadState) latest(keyFormat *storage.MustKeyFormat, msg proto.Message) (bool, error) {
+	tree, err := s.Forest.Reader(keyFormat.Prefix())
+	if err != nil {
+		return false, err
+	}
+	found := false
+	err = tree.Iterate(nil, nil, false, func(_, value []byte) error {
+		found = true
+		err := encoding.Decode(value, msg)
+		if err != nil {
+			return err
+		}
+		return io.EOF
+	})
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	return found, nil
+}
+
+func (ws *writeState) setAtHeight(keyFormat *storage.MustKeyFormat, height uint64, msg proto.Message) error {
+	bs, err := encoding.Encode(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode %v: %v", msg, err)
+	}
+	tree, err := ws.forest.Writer(keyFormat.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keyFormat.KeyNoPrefix(height), bs)
+	return nil
+}
@@ -0,0 +1,57 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/liveness"
+)
+
+var _ liveness.IterableReader = &State{}
+
+func (s *ReadState) GetLiveness(id crypto.Address) (*liveness.Liveness, error) {
+	tree, err := s.Forest.Reader(keys.Liveness.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Liveness.KeyNoPrefix(id))
+	if err != nil {
+		return nil, err
+	} else if len(bs) == 0 {
+		return nil, nil
+	}
+	l := new(liveness.Liveness)
+	return l, encoding.Decode(bs, l)
+}
+
+func (ws *writeState) UpdateLiveness(id crypto.Address, l *liveness.Liveness) error {
+	if l == nil {
+		return fmt.Errorf("UpdateLiveness passed nil liveness in State")
+	}
+	bs, err := encoding.Encode(l)
+	if err != nil {
+		return fmt.Errorf("UpdateLiveness could not encode liveness: %v", err)
+	}
+	tree, err := ws.forest.Writer(keys.Liveness.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Liveness.KeyNoPrefix(id), bs)
+	return nil
+}
+
+func (s *ReadState) IterateLiveness(consumer func(*liveness.Liveness) error) error {
+	tree, err := s.Forest.Reader(keys.Liveness.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(_, value []byte) error {
+		l := new(liveness.Liveness)
+		err := encoding.Decode(value, l)
+		if err != nil {
+			return fmt.Errorf("State.IterateLiveness() could not iterate over liveness: %v", err)
+		}
+		return consumer(l)
+	})
+}
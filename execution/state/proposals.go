@@ -7,6 +7,8 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
+var noValue = []byte{}
+
 var _ proposal.IterableReader = &State{}
 
 func (s *ReadState) GetProposal(proposalHash []byte) (*payload.Ballot, error) {
@@ -40,7 +42,7 @@ func (ws *writeState) UpdateProposal(proposalHash []byte, p *payload.Ballot) err
 	}
 
 	tree.Set(keys.Proposal.KeyNoPrefix(proposalHash), bs)
-	return nil
+	return ws.indexProposalExpiry(proposalHash, p, p.ProposalState == payload.Ballot_PROPOSED)
 }
 
 func (ws *writeState) RemoveProposal(proposalHash []byte) error {
@@ -48,7 +50,33 @@ func (ws *writeState) RemoveProposal(proposalHash []byte) error {
 	if err != nil {
 		return err
 	}
-	tree.Delete(keys.Proposal.KeyNoPrefix(proposalHash))
+	bs, deleted := tree.Delete(keys.Proposal.KeyNoPrefix(proposalHash))
+	if !deleted {
+		return nil
+	}
+	ballot := new(payload.Ballot)
+	err = encoding.Decode(bs, ballot)
+	if err != nil {
+		return err
+	}
+	return ws.indexProposalExpiry(proposalHash, ballot, false)
+}
+
+// Keeps the proposal in the expiry index while it is open so it can be expired without scanning every proposal
+func (ws *writeState) indexProposalExpiry(proposalHash []byte, ballot *payload.Ballot, open bool) error {
+	if ballot.Proposal == nil || ballot.Proposal.ExpiryHeight == 0 {
+		return nil
+	}
+	tree, err := ws.forest.Writer(keys.ProposalExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	key := keys.ProposalExpiry.KeyNoPrefix(ballot.Proposal.ExpiryHeight, proposalHash)
+	if open {
+		tree.Set(key, noValue)
+	} else {
+		tree.Delete(key)
+	}
 	return nil
 }
 
@@ -66,3 +94,19 @@ func (s *ReadState) IterateProposals(consumer func(proposalHash []byte, proposal
 		return consumer(key, ballot)
 	})
 }
+
+func (s *ReadState) IterateExpiringProposals(height uint64, consumer func(proposalHash []byte) error) error {
+	tree, err := s.Forest.Reader(keys.ProposalExpiry.Prefix())
+	if err != nil {
+		return err
+	}
+	prefix := keys.ProposalExpiry.KeyNoPrefix(height)
+	return tree.Iterate(prefix, prefix.Above(), true, func(key []byte, _ []byte) error {
+		var proposalHash []byte
+		err := keys.ProposalExpiry.ScanNoPrefix(key, nil, &proposalHash)
+		if err != nil {
+			return err
+		}
+		return consumer(proposalHash)
+	})
+}
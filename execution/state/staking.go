@@ -0,0 +1,130 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/staking"
+	"github.com/hyperledger/burrow/storage"
+)
+
+var _ staking.IterableReader = &State{}
+
+func (s *ReadState) GetBond(validator, delegator crypto.Address) (*staking.Bond, error) {
+	tree, err := s.Forest.Reader(keys.Bond.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Bond.KeyNoPrefix(validator, delegator))
+	if err != nil {
+		return nil, err
+	} else if len(bs) == 0 {
+		return nil, nil
+	}
+	bond := new(staking.Bond)
+	return bond, encoding.Decode(bs, bond)
+}
+
+func (ws *writeState) UpdateBond(bond *staking.Bond) error {
+	if bond == nil {
+		return fmt.Errorf("UpdateBond passed nil bond in State")
+	}
+	tree, err := ws.forest.Writer(keys.Bond.Prefix())
+	if err != nil {
+		return err
+	}
+	key := keys.Bond.KeyNoPrefix(bond.Validator, bond.Delegator)
+	if bond.Amount == 0 {
+		tree.Delete(key)
+		return nil
+	}
+	bs, err := encoding.Encode(bond)
+	if err != nil {
+		return fmt.Errorf("UpdateBond could not encode bond: %v", err)
+	}
+	tree.Set(key, bs)
+	return nil
+}
+
+func (s *ReadState) IterateBonds(consumer func(*staking.Bond) error) error {
+	tree, err := s.Forest.Reader(keys.Bond.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(_, value []byte) error {
+		bond := new(staking.Bond)
+		err := encoding.Decode(value, bond)
+		if err != nil {
+			return fmt.Errorf("State.IterateBonds() could not iterate over bonds: %v", err)
+		}
+		return consumer(bond)
+	})
+}
+
+func (s *ReadState) IterateValidatorBonds(validator crypto.Address, consumer func(*staking.Bond) error) error {
+	tree, err := s.Forest.Reader(keys.Bond.Prefix())
+	if err != nil {
+		return err
+	}
+	prefix := storage.Prefix(validator.Bytes())
+	return tree.Iterate(prefix, prefix.Above(), true, func(_, value []byte) error {
+		bond := new(staking.Bond)
+		err := encoding.Decode(value, bond)
+		if err != nil {
+			return fmt.Errorf("State.IterateValidatorBonds() could not iterate over bonds: %v", err)
+		}
+		return consumer(bond)
+	})
+}
+
+func (s *ReadState) GetUnbonding(releaseHeight uint64, validator, delegator crypto.Address) (*staking.Unbonding, error) {
+	tree, err := s.Forest.Reader(keys.Unbonding.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := tree.Get(keys.Unbonding.KeyNoPrefix(releaseHeight, validator, delegator))
+	if err != nil {
+		return nil, err
+	} else if len(bs) == 0 {
+		return nil, nil
+	}
+	unbonding := new(staking.Unbonding)
+	return unbonding, encoding.Decode(bs, unbonding)
+}
+
+func (ws *writeState) UpdateUnbonding(unbonding *staking.Unbonding) error {
+	if unbonding == nil {
+		return fmt.Errorf("UpdateUnbonding passed nil unbonding in State")
+	}
+	tree, err := ws.forest.Writer(keys.Unbonding.Prefix())
+	if err != nil {
+		return err
+	}
+	key := keys.Unbonding.KeyNoPrefix(unbonding.ReleaseHeight, unbonding.Validator, unbonding.Delegator)
+	if unbonding.Amount == 0 {
+		tree.Delete(key)
+		return nil
+	}
+	bs, err := encoding.Encode(unbonding)
+	if err != nil {
+		return fmt.Errorf("UpdateUnbonding could not encode unbonding: %v", err)
+	}
+	tree.Set(key, bs)
+	return nil
+}
+
+func (s *ReadState) IterateUnbondings(consumer func(*staking.Unbonding) error) error {
+	tree, err := s.Forest.Reader(keys.Unbonding.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(_, value []byte) error {
+		unbonding := new(staking.Unbonding)
+		err := encoding.Decode(value, unbonding)
+		if err != nil {
+			return fmt.Errorf("State.IterateUnbondings() could not iterate over unbondings: %v", err)
+		}
+		return consumer(unbonding)
+	})
+}
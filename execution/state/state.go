@@ -4,6 +4,7 @@
 package state
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"sync"
@@ -16,8 +17,11 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
+	"github.com/hyperledger/burrow/execution/liveness"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/execution/staking"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/storage"
@@ -49,8 +53,18 @@ type KeyFormatStore struct {
 	Validator *storage.MustKeyFormat
 	Event     *storage.MustKeyFormat
 	Registry  *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
-	Abi       *storage.MustKeyFormat
+	Liveness  *storage.MustKeyFormat
+	Bond      *storage.MustKeyFormat
+	Unbonding *storage.MustKeyFormat
+	// Index of open proposals by expiry height
+	ProposalExpiry *storage.MustKeyFormat
+	// Governed parameters
+	ExecutionParams *storage.MustKeyFormat
+	ConsensusParams *storage.MustKeyFormat
+	Upgrade         *storage.MustKeyFormat
+	TxHash          *storage.MustKeyFormat
+	Abi             *storage.MustKeyFormat
+	Bloom           *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -63,18 +77,34 @@ var keys = KeyFormatStore{
 	Name: storage.NewMustKeyFormat("n", storage.VariadicSegmentLength),
 	// ProposalHash -> Proposal
 	Proposal: storage.NewMustKeyFormat("p", sha256.Size),
+	// ExpiryHeight, ProposalHash -> nothing
+	ProposalExpiry: storage.NewMustKeyFormat("q", uint64Length, sha256.Size),
 	// ValidatorAddress -> Power
 	Validator: storage.NewMustKeyFormat("v", crypto.AddressLength),
 	// Height -> StreamEvent
 	Event: storage.NewMustKeyFormat("e", uint64Length),
 	// Validator -> NodeIdentity
 	Registry: storage.NewMustKeyFormat("r", crypto.AddressLength),
+	// ValidatorAddress -> Liveness
+	Liveness: storage.NewMustKeyFormat("j", crypto.AddressLength),
+	// ValidatorAddress, DelegatorAddress -> Bond
+	Bond: storage.NewMustKeyFormat("b", crypto.AddressLength, crypto.AddressLength),
+	// ReleaseHeight, ValidatorAddress, DelegatorAddress -> Unbonding
+	Unbonding: storage.NewMustKeyFormat("u", uint64Length, crypto.AddressLength, crypto.AddressLength),
+	// Height -> ExecutionParams set by the block at that height
+	ExecutionParams: storage.NewMustKeyFormat("x", uint64Length),
+	// Height -> ConsensusParams set by the block at that height
+	ConsensusParams: storage.NewMustKeyFormat("c", uint64Length),
+	// Name -> Upgrade
+	Upgrade: storage.NewMustKeyFormat("g", storage.VariadicSegmentLength),
 
 	// Stored on the plain
 	// TxHash -> TxHeight, TxIndex
 	TxHash: storage.NewMustKeyFormat("th", txs.HashLength),
 	// CodeHash -> Abi
 	Abi: storage.NewMustKeyFormat("abi", sha256.Size),
+	// Height -> Logs bloom
+	Bloom: storage.NewMustKeyFormat("lb", uint64Length),
 }
 
 var Prefixes [][]byte
@@ -87,11 +117,79 @@ func init() {
 	}
 }
 
+// Describes a key in the tree at prefix of the state forest in terms of what it stores, for example the account
+// address and storage key of a storage slot
+func DescribeKey(prefix, key []byte) string {
+	describeAddress := func(kind string, bs []byte) string {
+		address, err := crypto.AddressFromBytes(bs)
+		if err != nil {
+			return fmt.Sprintf("%s %X", kind, bs)
+		}
+		return fmt.Sprintf("%s %v", kind, address)
+	}
+	switch {
+	case bytes.Equal(prefix, keys.Account.Prefix()):
+		return describeAddress("account", key)
+	case bytes.HasPrefix(prefix, keys.Storage.Prefix()):
+		return fmt.Sprintf("%s key %X", describeAddress("storage of", prefix[keys.Storage.Prefix().Length():]), key)
+	case bytes.Equal(prefix, keys.Name.Prefix()):
+		return fmt.Sprintf("name %s", key)
+	case bytes.Equal(prefix, keys.Proposal.Prefix()):
+		return fmt.Sprintf("proposal %X", key)
+	case bytes.Equal(prefix, keys.ProposalExpiry.Prefix()):
+		var height uint64
+		var proposalHash []byte
+		if keys.ProposalExpiry.ScanNoPrefix(key, &height, &proposalHash) == nil {
+			return fmt.Sprintf("proposal %X expiring at height %d", proposalHash, height)
+		}
+	case bytes.Equal(prefix, keys.Validator.Prefix()):
+		return describeAddress("validator", key)
+	case bytes.Equal(prefix, keys.Registry.Prefix()):
+		return describeAddress("node of validator", key)
+	case bytes.Equal(prefix, keys.Liveness.Prefix()):
+		return describeAddress("liveness of validator", key)
+	case bytes.Equal(prefix, keys.Bond.Prefix()):
+		var validator, delegator []byte
+		if keys.Bond.ScanNoPrefix(key, &validator, &delegator) == nil {
+			return fmt.Sprintf("%s %s", describeAddress("bond to validator", validator),
+				describeAddress("from", delegator))
+		}
+	case bytes.Equal(prefix, keys.Unbonding.Prefix()):
+		var height uint64
+		var validator, delegator []byte
+		if keys.Unbonding.ScanNoPrefix(key, &height, &validator, &delegator) == nil {
+			return fmt.Sprintf("%s %s released at height %d", describeAddress("unbonding from validator", validator),
+				describeAddress("to", delegator), height)
+		}
+	case bytes.Equal(prefix, keys.Event.Prefix()):
+		var height uint64
+		if keys.Event.ScanNoPrefix(key, &height) == nil {
+			return fmt.Sprintf("events at height %d", height)
+		}
+	case bytes.Equal(prefix, keys.ExecutionParams.Prefix()):
+		var height uint64
+		if keys.ExecutionParams.ScanNoPrefix(key, &height) == nil {
+			return fmt.Sprintf("execution parameters set at height %d", height)
+		}
+	case bytes.Equal(prefix, keys.ConsensusParams.Prefix()):
+		var height uint64
+		if keys.ConsensusParams.ScanNoPrefix(key, &height) == nil {
+			return fmt.Sprintf("consensus parameters set at height %d", height)
+		}
+	case bytes.Equal(prefix, keys.Upgrade.Prefix()):
+		return fmt.Sprintf("upgrade %s", key)
+	}
+	return fmt.Sprintf("%q key %X", prefix, key)
+}
+
 type Updatable interface {
 	acmstate.Writer
 	names.Writer
 	proposal.Writer
 	registry.Writer
+	liveness.Writer
+	staking.Writer
+	governance.Writer
 	validator.Writer
 	acmstate.MetadataWriter
 	AddBlock(blockExecution *exec.BlockExecution) error
@@ -104,6 +202,9 @@ type writeState struct {
 	ring         *validator.Ring
 	accountStats acmstate.AccountStats
 	nodeStats    registry.NodeStats
+	// Number of accounts holding each role, guarded by roleLock
+	roleLock   sync.RWMutex
+	roleCounts map[string]uint64
 }
 
 type ReadState struct {
@@ -138,10 +239,11 @@ func NewState(db dbm.DB) *State {
 			History: ring,
 		},
 		writeState: writeState{
-			forest:    forest,
-			plain:     plain,
-			ring:      ring,
-			nodeStats: registry.NewNodeStats(),
+			forest:     forest,
+			plain:      plain,
+			ring:       ring,
+			nodeStats:  registry.NewNodeStats(),
+			roleCounts: make(map[string]uint64),
 		},
 		logger: logging.NewNoopLogger(),
 	}
@@ -193,8 +295,24 @@ func (s *State) InitialCommit() error {
 
 // Tries to load the execution state from DB, returns nil with no error if no state found
 func LoadState(db dbm.DB, version int64) (*State, error) {
+	return loadState(db, version, (*storage.MutableForest).Load)
+}
+
+// Loads the execution state from DB for inspection, leaving any later versions in DB that LoadState would delete. The
+// returned State must not be updated.
+func LoadStateReadOnly(db dbm.DB, version int64) (*State, error) {
+	return loadState(db, version, (*storage.MutableForest).LoadReadOnly)
+}
+
+// Loads the execution state from DB at a version earlier than the last one saved so that the blocks after it may be
+// executed again, discarding the state they saved (see storage.MutableForest.LoadForReplay)
+func LoadStateForReplay(db dbm.DB, version int64) (*State, error) {
+	return loadState(db, version, (*storage.MutableForest).LoadForReplay)
+}
+
+func loadState(db dbm.DB, version int64, load func(*storage.MutableForest, int64) error) (*State, error) {
 	s := NewState(db)
-	err := s.writeState.forest.Load(version)
+	err := load(s.writeState.forest, version)
 	if err != nil {
 		return nil, fmt.Errorf("could not load MutableForest at version %d: %v", version, err)
 	}
@@ -228,6 +346,7 @@ func (s *State) loadAccountStats() error {
 		} else {
 			s.writeState.accountStats.AccountsWithoutCode++
 		}
+		s.writeState.statsAddRoles(acc)
 		return nil
 	})
 }
@@ -247,6 +366,21 @@ func (s *State) Hash() []byte {
 	return s.writeState.forest.Hash()
 }
 
+// Returns the state hash committed at height, which is the AppHash of the following block
+func (s *State) HashAtHeight(height uint64) ([]byte, error) {
+	return s.writeState.forest.HashAtVersion(VersionAtHeight(height))
+}
+
+// Checks each tree in the forest against the CommitID recorded for it at the loaded version, see ImmutableForest.Verify
+func (s *State) VerifyForest(fn func(prefix []byte, commitID *storage.CommitID, err error) error) error {
+	return s.writeState.forest.Verify(fn)
+}
+
+// Returns the forest of state trees as committed at height
+func (s *State) ForestAtHeight(height uint64) (*storage.ImmutableForest, error) {
+	return s.writeState.forest.GetImmutable(VersionAtHeight(height))
+}
+
 func (s *State) LoadHeight(height uint64) (*ReadState, error) {
 	version := VersionAtHeight(height)
 	forest, err := s.writeState.forest.GetImmutable(version)
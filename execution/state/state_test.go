@@ -15,14 +15,18 @@
 package state
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
+	hex "github.com/tmthrgd/go-hex"
 )
 
 func TestState_UpdateAccount(t *testing.T) {
@@ -40,3 +44,47 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, source.JSONString(account), source.JSONString(accountOut))
 }
+
+func TestMakeGenesisState_AppHash(t *testing.T) {
+	// Nodes joining an existing chain must arrive at the same genesis state
+	genDoc, _, _ := genesis.NewDeterministicGenesis(3450976).GenesisDoc(3, 2)
+	st, err := MakeGenesisState(dbm.NewMemDB(), genDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	assert.Equal(t, "1FF99C97DDFDE3E9D3A7C871C4127A9F0126C56CDD17CB723332E6EEF7D4F6F4",
+		hex.EncodeUpperToString(st.Hash()))
+}
+
+func TestState_IterateExpiringProposals(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	proposalHash := make([]byte, sha256.Size)
+	proposalHash[0] = 1
+	ballot := &payload.Ballot{
+		Proposal:      &payload.Proposal{Name: "expiring", ExpiryHeight: 7},
+		ProposalState: payload.Ballot_PROPOSED,
+	}
+	expiring := func(height uint64) [][]byte {
+		var hashes [][]byte
+		require.NoError(t, s.IterateExpiringProposals(height, func(proposalHash []byte) error {
+			hashes = append(hashes, proposalHash)
+			return nil
+		}))
+		return hashes
+	}
+	update := func() {
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.UpdateProposal(proposalHash, ballot)
+		})
+		require.NoError(t, err)
+	}
+
+	update()
+	assert.Equal(t, [][]byte{proposalHash}, expiring(7))
+	assert.Empty(t, expiring(6))
+	assert.Empty(t, expiring(8))
+
+	// Closed proposals leave the index
+	ballot.ProposalState = payload.Ballot_EXECUTED
+	update()
+	assert.Empty(t, expiring(7))
+}
@@ -0,0 +1,161 @@
+package forensics
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+)
+
+// Divergence describes the first block after which the state of two sources differs
+type Divergence struct {
+	Height uint64
+	// Replays of the block at Height by each source on top of its own state at the previous height
+	Expected *ReplayCapture
+	Actual   *ReplayCapture
+	// Transactions of the block whose execution differs between the replays
+	Txs []*TxDiff
+	// Keys whose values differ in the state stored by each source after Height
+	Keys []*KeyDiff
+}
+
+func (div *Divergence) String() string {
+	sb := new(strings.Builder)
+	fmt.Fprintf(sb, "State first diverges after block %d\n", div.Height)
+	fmt.Fprintf(sb, "Expected: %v\nActual:   %v\n", div.Expected, div.Actual)
+	writeDiffs(sb, div.Txs, div.Keys)
+	return sb.String()
+}
+
+// StateHash returns the hash of the state src has stored after the block at height
+func (src *Source) StateHash(height uint64) ([]byte, error) {
+	st, err := src.stateAt(height)
+	if err != nil {
+		return nil, err
+	}
+	return st.Hash(), nil
+}
+
+// StateDiff returns the changes made to the state src has stored by the block at height, see state.State.StateDiff
+func (src *Source) StateDiff(height uint64) (*exec.StateDiff, error) {
+	st, err := src.stateAt(height)
+	if err != nil {
+		return nil, err
+	}
+	return st.StateDiff(height)
+}
+
+// ReplayFromGenesis replays the blocks of src up to end into a new Source made from genesisDoc that shares src's
+// block store. If a block fails to replay the new Source is returned along with the last height successfully replayed
+// and the error.
+func ReplayFromGenesis(src *Source, genesisDoc *genesis.GenesisDoc, end uint64) (*Source, uint64, error) {
+	dst := NewSourceFromGenesis(genesisDoc)
+	dst.Explorer = src.Explorer
+	re := NewReplay(src, dst)
+	for height := uint64(1); height <= end; height++ {
+		_, err := re.Commit(height)
+		if err != nil {
+			return dst, height - 1, fmt.Errorf("could not replay block %d: %w", height, err)
+		}
+	}
+	return dst, end, nil
+}
+
+// Bisect binary searches heights up to end for the first block after which the state stored by exp and act differs,
+// assuming that once state has diverged it stays diverged. Returns nil if the states agree at end. Each source then
+// replays the divergent block on top of its state at the previous height so that its transactions can be compared.
+func Bisect(exp, act *Source, end uint64) (*Divergence, error) {
+	same, err := sameStateHash(exp, act, 0)
+	if err != nil {
+		return nil, err
+	} else if !same {
+		return nil, fmt.Errorf("genesis states differ so sources are not from the same chain")
+	}
+	same, err = sameStateHash(exp, act, end)
+	if err != nil || same {
+		return nil, err
+	}
+	// Invariant: states agree at low and differ at high
+	low, high := uint64(0), end
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		same, err = sameStateHash(exp, act, mid)
+		if err != nil {
+			return nil, err
+		}
+		if same {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return CompareBlock(exp, act, high)
+}
+
+// CompareBlock describes how the state of exp and act differs after the block at height by comparing their stored
+// state and by replaying the block on top of each of their states at the previous height
+func CompareBlock(exp, act *Source, height uint64) (*Divergence, error) {
+	div := &Divergence{
+		Height: height,
+	}
+	// Read stored state before replaying since loading state for replay discards later versions from the cache
+	keys, err := diffStateAt(exp, act, height)
+	if err != nil {
+		return nil, err
+	}
+	div.Keys = keys
+	div.Expected, err = NewReplay(exp, exp).Block(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not replay block %d with expected source: %w", height, err)
+	}
+	div.Actual, err = NewReplay(act, act).Block(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not replay block %d with actual source: %w", height, err)
+	}
+	div.Txs = diffTxs(div.Expected.TxExecutions, div.Actual.TxExecutions)
+	return div, nil
+}
+
+func (src *Source) stateAt(height uint64) (*state.State, error) {
+	st, err := state.LoadStateReadOnly(src.cacheDB, execution.VersionAtHeight(height))
+	if err != nil {
+		return nil, fmt.Errorf("could not load state at height %d: %w", height, err)
+	}
+	return st, nil
+}
+
+func sameStateHash(exp, act *Source, height uint64) (bool, error) {
+	expHash, err := exp.StateHash(height)
+	if err != nil {
+		return false, err
+	}
+	actHash, err := act.StateHash(height)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(expHash, actHash), nil
+}
+
+func diffStateAt(exp, act *Source, height uint64) ([]*KeyDiff, error) {
+	expState, err := exp.stateAt(height)
+	if err != nil {
+		return nil, err
+	}
+	actState, err := act.stateAt(height)
+	if err != nil {
+		return nil, err
+	}
+	expForest, err := expState.ForestAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	actForest, err := actState.ForestAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return diffKeys(expForest, actForest)
+}
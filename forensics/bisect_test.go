@@ -0,0 +1,49 @@
+package forensics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestBisect(t *testing.T) {
+	var height uint64 = 8
+	genesisDoc, tmDB, burrowDB := makeChain(t, height+1)
+	exp := NewSource(burrowDB, tmDB, genesisDoc)
+
+	act, replayed, err := ReplayFromGenesis(exp, genesisDoc, height)
+	require.NoError(t, err)
+	require.Equal(t, height, replayed)
+	div, err := Bisect(exp, act, height)
+	require.NoError(t, err)
+	require.Nil(t, div)
+
+	// Commit a block without executing its transaction
+	var divergent uint64 = 5
+	act, _, err = ReplayFromGenesis(exp, genesisDoc, divergent-1)
+	require.NoError(t, err)
+	block, err := exp.Explorer.Block(int64(divergent))
+	require.NoError(t, err)
+	header := types.TM2PB.Header(&block.Header)
+	_, err = act.committer.Commit(&header)
+	require.NoError(t, err)
+
+	div, err = Bisect(exp, act, divergent)
+	require.NoError(t, err)
+	require.NotNil(t, div)
+	assert.Equal(t, divergent, div.Height)
+	assert.Equal(t, divergent, div.Expected.Height)
+	// Replaying the block executes the transaction in both
+	assert.Len(t, div.Actual.TxExecutions, 1)
+	assert.Empty(t, div.Txs)
+	var accounts int
+	for _, kd := range div.Keys {
+		if kd.Prefix.String() == "61" {
+			accounts++
+		}
+	}
+	// Validator input and the output of the transaction
+	assert.Equal(t, 2, accounts, "%v", div)
+}
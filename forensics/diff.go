@@ -0,0 +1,211 @@
+package forensics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// BlockDiff describes how the replay of a block differs from its recorded execution
+type BlockDiff struct {
+	Height uint64
+	// Transactions whose replay differs from the recorded TxExecution
+	Txs []*TxDiff
+	// Keys whose values differ between the recorded state after the block and the replayed state. Since state is only
+	// committed once per block these cannot be attributed to individual transactions.
+	Keys []*KeyDiff
+}
+
+type TxDiff struct {
+	// Index of the transaction in its block
+	Index    int
+	Expected *exec.TxExecution
+	Actual   *exec.TxExecution
+	// The aspects of execution that differ, for example "gas used" or "events"
+	Differences []string
+}
+
+type KeyDiff struct {
+	// A description of the key in terms of what it stores, see state.DescribeKey
+	Description string
+	Prefix      binary.HexBytes
+	Key         binary.HexBytes
+	// Values are empty if the key is absent
+	Expected binary.HexBytes
+	Actual   binary.HexBytes
+}
+
+func (bd *BlockDiff) String() string {
+	sb := new(strings.Builder)
+	fmt.Fprintf(sb, "Replay of block %d differs from recorded execution\n", bd.Height)
+	writeDiffs(sb, bd.Txs, bd.Keys)
+	return sb.String()
+}
+
+func (txd *TxDiff) String() string {
+	var txHash binary.HexBytes
+	if txd.Expected != nil {
+		txHash = txd.Expected.TxHash
+	} else if txd.Actual != nil {
+		txHash = txd.Actual.TxHash
+	}
+	return fmt.Sprintf("Transaction %d (%v) differs in %s:\n- %s\n+ %s", txd.Index, txHash,
+		strings.Join(txd.Differences, ", "), txJSON(txd.Expected), txJSON(txd.Actual))
+}
+
+func (kd *KeyDiff) String() string {
+	return fmt.Sprintf("%s: %v -> %v", kd.Description, kd.Expected, kd.Actual)
+}
+
+// DiffBlocks replays each block from startHeight to endHeight inclusive on top of the state recorded at the previous
+// height and compares the replay with the recorded TxExecutions and state, returning a BlockDiff for each block whose
+// replay differs. Src and Dst must be the same Source, for example one given execution options that are to be tested
+// against history with WithExecutionOptions.
+func (re *Replay) DiffBlocks(startHeight, endHeight uint64) ([]*BlockDiff, error) {
+	if re.Src != re.Dst {
+		return nil, fmt.Errorf("DiffBlocks() must replay blocks with their source")
+	}
+	if startHeight < 1 || endHeight < startHeight {
+		return nil, fmt.Errorf("invalid block range %d to %d", startHeight, endHeight)
+	}
+	// Read recorded execution from the underlying database since loading state for replay discards later versions
+	// from the cache
+	recorded, err := state.LoadStateReadOnly(re.Src.db, execution.VersionAtHeight(endHeight))
+	if err != nil {
+		return nil, fmt.Errorf("could not load recorded state: %w", err)
+	}
+	var diffs []*BlockDiff
+	for height := startHeight; height <= endHeight; height++ {
+		recordedTxs, err := recorded.TxsAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		recordedForest, err := recorded.ForestAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		// Replay each block on the recorded state rather than state left by the replay of the previous block
+		re.Src.resetCache()
+		recap, err := re.Block(height)
+		if err != nil {
+			return nil, fmt.Errorf("could not replay block %d: %w", height, err)
+		}
+		replayedForest, err := re.Dst.State.ForestAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := diffKeys(recordedForest, replayedForest)
+		if err != nil {
+			return nil, err
+		}
+		txs := diffTxs(recordedTxs, recap.TxExecutions)
+		if len(txs) > 0 || len(keys) > 0 {
+			diffs = append(diffs, &BlockDiff{
+				Height: height,
+				Txs:    txs,
+				Keys:   keys,
+			})
+		}
+	}
+	return diffs, nil
+}
+
+func diffKeys(exp, act *storage.ImmutableForest) ([]*KeyDiff, error) {
+	var diffs []*KeyDiff
+	err := storage.DiffForests(exp, act, func(prefix, key, expValue, actValue []byte) error {
+		diffs = append(diffs, &KeyDiff{
+			Description: state.DescribeKey(prefix, key),
+			Prefix:      prefix,
+			Key:         key,
+			Expected:    expValue,
+			Actual:      actValue,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return diffs, nil
+}
+
+func diffTxs(exp, act []*exec.TxExecution) []*TxDiff {
+	n := len(exp)
+	if len(act) > n {
+		n = len(act)
+	}
+	var diffs []*TxDiff
+	for i := 0; i < n; i++ {
+		txd := &TxDiff{Index: i}
+		if i < len(exp) {
+			txd.Expected = exp[i]
+		}
+		if i < len(act) {
+			txd.Actual = act[i]
+		}
+		txd.Differences = txDifferences(txd.Expected, txd.Actual)
+		if len(txd.Differences) > 0 {
+			diffs = append(diffs, txd)
+		}
+	}
+	return diffs
+}
+
+func txDifferences(exp, act *exec.TxExecution) []string {
+	if exp == nil || act == nil {
+		return []string{"presence"}
+	}
+	var diffs []string
+	if !bytes.Equal(exp.TxHash, act.TxHash) {
+		diffs = append(diffs, "transaction")
+	}
+	if jsonString(exp.Exception) != jsonString(act.Exception) {
+		diffs = append(diffs, "exception")
+	}
+	if !bytes.Equal(exp.Result.GetReturn(), act.Result.GetReturn()) ||
+		jsonString(exp.Result.GetNameEntry()) != jsonString(act.Result.GetNameEntry()) ||
+		jsonString(exp.Result.GetPermArgs()) != jsonString(act.Result.GetPermArgs()) {
+		diffs = append(diffs, "result")
+	}
+	if exp.Result.GetGasUsed() != act.Result.GetGasUsed() {
+		diffs = append(diffs, "gas used")
+	}
+	if jsonString(exp.Events) != jsonString(act.Events) {
+		diffs = append(diffs, "events")
+	}
+	if jsonString(exp.TxExecutions) != jsonString(act.TxExecutions) {
+		diffs = append(diffs, "nested transactions")
+	}
+	return diffs
+}
+
+func writeDiffs(sb *strings.Builder, txs []*TxDiff, keys []*KeyDiff) {
+	for _, txd := range txs {
+		fmt.Fprintf(sb, "%v\n", txd)
+	}
+	fmt.Fprintf(sb, "%d key(s) differ:\n", len(keys))
+	for _, kd := range keys {
+		fmt.Fprintf(sb, "  %v\n", kd)
+	}
+}
+
+func txJSON(txe *exec.TxExecution) string {
+	if txe == nil {
+		return "<none>"
+	}
+	return jsonString(txe)
+}
+
+func jsonString(v interface{}) string {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("<could not serialise: %v>", err)
+	}
+	return string(bs)
+}
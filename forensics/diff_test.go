@@ -0,0 +1,54 @@
+package forensics
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestReplay_DiffBlocks(t *testing.T) {
+	var height uint64 = 4
+	genesisDoc, tmDB, burrowDB := makeChainOf(t, height+1, makeCreateTx)
+
+	src := NewSource(burrowDB, tmDB, genesisDoc)
+	diffs, err := NewReplay(src, src).DiffBlocks(1, height)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	// The contract's init code needs two stack slots
+	src = NewSource(burrowDB, tmDB, genesisDoc)
+	re := NewReplay(src, src, WithExecutionOptions(execution.VMOptions(evm.Options{DataStackMaxDepth: 1})))
+	diffs, err = re.DiffBlocks(2, height)
+	require.NoError(t, err)
+	require.Len(t, diffs, int(height-1))
+	for i, diff := range diffs {
+		assert.Equal(t, uint64(i+2), diff.Height)
+		require.Len(t, diff.Txs, 1)
+		assert.Contains(t, diff.Txs[0].Differences, "exception")
+		assert.Nil(t, diff.Txs[0].Expected.Exception)
+		assert.NotNil(t, diff.Txs[0].Actual.Exception)
+		assert.NotEmpty(t, diff.Keys)
+	}
+
+	_, err = NewReplay(src, NewSourceFromGenesis(genesisDoc)).DiffBlocks(1, height)
+	require.Error(t, err)
+}
+
+func makeCreateTx(t *testing.T, chainID string, height int64, val *acm.PrivateAccount) types.Tx {
+	code := bc.MustSplice(asm.PUSH1, 1, asm.PUSH1, 2, asm.ADD, asm.POP, asm.STOP)
+	callTx := payload.NewCallTxWithSequence(val.GetPublicKey(), nil, code, 1, 100000, 0, uint64(height))
+	txEnv := txs.Enclose(chainID, callTx)
+	require.NoError(t, txEnv.Sign(val))
+	data, err := txs.NewProtobufCodec().EncodeTx(txEnv)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,120 @@
+package forensics
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/storage"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// FsckReport describes the checks made by Fsck and any inconsistencies found
+type FsckReport struct {
+	// The last height committed to burrow's blockchain
+	Height uint64
+	// The hash of the state loaded at Height
+	AppHash binary.HexBytes
+	// The number of trees in the state forest that were verified
+	Trees int
+	// The number of TxHash index entries checked
+	TxHashEntries int
+	// The number of dangling TxHash index entries deleted
+	Repaired int
+	Problems []string
+}
+
+func (report *FsckReport) OK() bool {
+	return len(report.Problems) == 0
+}
+
+func (report *FsckReport) problemf(format string, args ...interface{}) {
+	report.Problems = append(report.Problems, fmt.Sprintf(format, args...))
+}
+
+// Fsck checks the consistency of burrow's state in burrowDB with itself and with Tendermint's blockStore, as may be
+// needed after a node has crashed mid-commit. It checks that every tree in the state forest has the hash recorded for
+// it in the forest's commits tree, that the state hash at the last height matches the AppHash recorded by burrow's
+// blockchain and by Tendermint, and that every TxHash index entry refers to a stored transaction with that hash.
+// If repair is set dangling TxHash index entries are deleted from burrowDB, no other changes are made. Returns an error
+// only if the checks cannot be made, inconsistencies are collected in the report.
+func Fsck(burrowDB dbm.DB, blockStore *bcm.BlockStore, genesisDoc *genesis.GenesisDoc, repair bool) (*FsckReport,
+	error) {
+	blockchain, exists, err := bcm.LoadOrNewBlockchain(burrowDB, genesisDoc, logging.NewNoopLogger())
+	if err != nil {
+		return nil, fmt.Errorf("could not load blockchain: %w", err)
+	} else if !exists {
+		return nil, fmt.Errorf("no blockchain found in burrow state")
+	}
+	report := &FsckReport{
+		Height: blockchain.LastBlockHeight(),
+	}
+	st, err := state.LoadStateReadOnly(burrowDB, execution.VersionAtHeight(report.Height))
+	if err != nil {
+		report.problemf("could not load state at height %d: %v", report.Height, err)
+		return report, nil
+	}
+	report.AppHash = st.Hash()
+
+	err = st.VerifyForest(func(prefix []byte, commitID *storage.CommitID, err error) error {
+		report.Trees++
+		if err != nil {
+			report.problemf("tree %q: %v", prefix, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate state forest: %w", err)
+	}
+
+	if !bytes.Equal(report.AppHash, blockchain.AppHashAfterLastBlock()) {
+		report.problemf("state has hash %v but blockchain records AppHash %X at height %d",
+			report.AppHash, blockchain.AppHashAfterLastBlock(), report.Height)
+	}
+	err = checkBlockStore(report, blockStore)
+	if err != nil {
+		return nil, err
+	}
+
+	report.TxHashEntries, err = st.CheckTxHashIndex(repair, func(txHash []byte, err error) error {
+		report.problemf("dangling TxHash index entry %X: %v", txHash, err)
+		if repair {
+			report.Repaired++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not check TxHash index: %w", err)
+	}
+	return report, nil
+}
+
+func checkBlockStore(report *FsckReport, blockStore *bcm.BlockStore) error {
+	height := int64(report.Height)
+	tmHeight := blockStore.Height()
+	if tmHeight < height {
+		report.problemf("Tendermint block store is at height %d but burrow state is at height %d", tmHeight, height)
+		return nil
+	}
+	// Tendermint may not have stored the next block if we stopped between commits, otherwise its header records the
+	// AppHash we returned from the last block we committed
+	if tmHeight == height {
+		return nil
+	}
+	meta, err := blockStore.BlockMeta(height + 1)
+	if err != nil {
+		return err
+	}
+	if meta == nil {
+		report.problemf("Tendermint block store has no block at height %d", height+1)
+	} else if !bytes.Equal(meta.Header.AppHash, report.AppHash) {
+		report.problemf("Tendermint block header at height %d has AppHash %v but state has hash %v",
+			height+1, meta.Header.AppHash, report.AppHash)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package forensics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestFsck(t *testing.T) {
+	genesisDoc, tmDB, burrowDB := makeChain(t, 6)
+	commitBlockchain(t, genesisDoc, tmDB, burrowDB, 5)
+
+	report, err := Fsck(burrowDB, bcm.NewBlockStore(store.NewBlockStore(tmDB)), genesisDoc, false)
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "unexpected problems: %v", report.Problems)
+	assert.Equal(t, uint64(5), report.Height)
+	assert.Equal(t, 5, report.TxHashEntries)
+
+	// Blockchain recording a different AppHash to state
+	chain, _, err := bcm.LoadOrNewBlockchain(burrowDB, genesisDoc, logging.NewNoopLogger())
+	require.NoError(t, err)
+	require.NoError(t, chain.CommitWithAppHash([]byte("not the hash")))
+	report, err = Fsck(burrowDB, bcm.NewBlockStore(store.NewBlockStore(tmDB)), genesisDoc, false)
+	require.NoError(t, err)
+	assert.Len(t, report.Problems, 1)
+}
+
+func TestFsck_Repair(t *testing.T) {
+	genesisDoc, tmDB, burrowDB := makeChain(t, 6)
+	// Stopped after committing state for the last block but before checkpointing it in the blockchain
+	commitBlockchain(t, genesisDoc, tmDB, burrowDB, 4)
+
+	report, err := Fsck(burrowDB, bcm.NewBlockStore(store.NewBlockStore(tmDB)), genesisDoc, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), report.Height)
+	// The last block's transaction is indexed but not found in the state we resume from
+	assert.Len(t, report.Problems, 1)
+	assert.Equal(t, 0, report.Repaired)
+
+	report, err = Fsck(burrowDB, bcm.NewBlockStore(store.NewBlockStore(tmDB)), genesisDoc, true)
+	require.NoError(t, err)
+	assert.Len(t, report.Problems, 1)
+	assert.Equal(t, 1, report.Repaired)
+
+	report, err = Fsck(burrowDB, bcm.NewBlockStore(store.NewBlockStore(tmDB)), genesisDoc, false)
+	require.NoError(t, err)
+	assert.True(t, report.OK(), "unexpected problems: %v", report.Problems)
+	assert.Equal(t, 4, report.TxHashEntries)
+}
+
+// Checkpoints the blockchain in burrowDB at height as burrow would have done when committing the blocks in tmDB
+func commitBlockchain(t *testing.T, genesisDoc *genesis.GenesisDoc, tmDB, burrowDB dbm.DB, height uint64) {
+	chain := bcm.NewBlockchain(burrowDB, genesisDoc)
+	blockStore := bcm.NewBlockStore(store.NewBlockStore(tmDB))
+	var appHash []byte
+	for h := uint64(1); h <= height; h++ {
+		block, err := blockStore.Block(int64(h))
+		require.NoError(t, err)
+		st, err := state.LoadStateReadOnly(burrowDB, execution.VersionAtHeight(h))
+		require.NoError(t, err)
+		appHash = st.Hash()
+		// Blocks made by makeChain have no time
+		blockTime := genesisDoc.GenesisTime.Add(time.Duration(h) * time.Second)
+		require.NoError(t, chain.CommitBlockAtHeight(blockTime, block.Hash(), appHash, h))
+	}
+	require.NoError(t, chain.CommitWithAppHash(appHash))
+}
@@ -40,28 +40,35 @@ type Source struct {
 	blockchain *bcm.Blockchain
 	genesisDoc *genesis.GenesisDoc
 	committer  execution.BatchCommitter
-	logger     *logging.Logger
+	// Execution options for the committer built when loading state
+	options []execution.Option
+	logger  *logging.Logger
 }
 
 func NewSource(burrowDB, tmDB dbm.DB, genesisDoc *genesis.GenesisDoc) *Source {
-	// Avoid writing through to underlying DB
-	cacheDB := storage.NewCacheDB(burrowDB)
-	return &Source{
+	src := &Source{
 		Explorer:   bcm.NewBlockStore(store.NewBlockStore(tmDB)),
 		db:         burrowDB,
-		cacheDB:    cacheDB,
-		blockchain: bcm.NewBlockchain(cacheDB, genesisDoc),
 		genesisDoc: genesisDoc,
 		logger:     logging.NewNoopLogger(),
 	}
+	src.resetCache()
+	return src
+}
+
+// Discard any state written since the source was created (or last reset)
+func (src *Source) resetCache() {
+	// Avoid writing through to underlying DB
+	src.cacheDB = storage.NewCacheDB(src.db)
+	src.blockchain = bcm.NewBlockchain(src.cacheDB, src.genesisDoc)
 }
 
-func NewSourceFromDir(genesisDoc *genesis.GenesisDoc, dbDir string) *Source {
-	burrowDB, err := dbm.NewDB(core.BurrowDBName, dbm.GoLevelDBBackend, dbDir)
+func NewSourceFromDir(genesisDoc *genesis.GenesisDoc, dbDir string, backend dbm.BackendType) *Source {
+	burrowDB, err := dbm.NewDB(core.BurrowDBName, backend, dbDir)
 	if err != nil {
 		panic(fmt.Errorf("could not create core DB for replay source: %w", err))
 	}
-	tmDB, err := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, path.Join(dbDir, "data"))
+	tmDB, err := dbm.NewDB("blockstore", backend, path.Join(dbDir, "data"))
 	if err != nil {
 		panic(fmt.Errorf("could not create blockstore DB for replay source: %w", err))
 	}
@@ -125,14 +132,14 @@ func (src *Source) LoadAt(height uint64) (err error) {
 			return err
 		}
 	}
-	src.State, err = state.LoadState(src.cacheDB, execution.VersionAtHeight(height))
+	src.State, err = state.LoadStateForReplay(src.cacheDB, execution.VersionAtHeight(height))
 	if err != nil {
 		return err
 	}
 
 	// Get our commit machinery
 	src.committer, err = execution.NewBatchCommitter(src.State, execution.ParamsFromGenesis(src.genesisDoc), src.blockchain,
-		event.NewEmitter(), src.logger)
+		event.NewEmitter(), src.logger, src.options...)
 	return err
 }
 
@@ -159,8 +166,22 @@ type Replay struct {
 	Dst *Source
 }
 
-func NewReplay(src, dst *Source) *Replay {
-	return &Replay{src, dst}
+type ReplayOption func(*Replay)
+
+// Execute blocks with options (such as those given by an alternative execution.ExecutionConfig) in place of the
+// defaults. Options take effect the next time the destination state is loaded.
+func WithExecutionOptions(options ...execution.Option) ReplayOption {
+	return func(re *Replay) {
+		re.Dst.options = options
+	}
+}
+
+func NewReplay(src, dst *Source, options ...ReplayOption) *Replay {
+	re := &Replay{src, dst}
+	for _, option := range options {
+		option(re)
+	}
+	return re
 }
 
 // Block loads and commits a block
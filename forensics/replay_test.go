@@ -68,6 +68,11 @@ func TestReplay(t *testing.T) {
 }
 
 func makeChain(t *testing.T, max uint64) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
+	return makeChainOf(t, max, makeTx)
+}
+
+// Makes a chain with a single transaction made by mkTx in each block
+func makeChainOf(t *testing.T, max uint64, mkTx txMaker) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
 	genesisDoc, _, validators := genesis.NewDeterministicGenesis(0).GenesisDoc(0, 1)
 
 	tmDB := dbm.NewMemDB()
@@ -89,7 +94,7 @@ func makeChain(t *testing.T, max uint64) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
 
 	var stateHash []byte
 	for i := uint64(1); i < max; i++ {
-		makeBlock(t, st, bs, func(block *types.Block) {
+		makeBlock(t, st, bs, mkTx, func(block *types.Block) {
 
 			decoder := txs.NewProtobufCodec()
 			err = bcm.NewBlock(decoder, block).Transactions(func(txEnv *txs.Envelope) error {
@@ -110,9 +115,12 @@ func makeChain(t *testing.T, max uint64) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
 	return genesisDoc, tmDB, burrowDB
 }
 
-func makeBlock(t *testing.T, st sm.State, bs *store.BlockStore, commit func(*types.Block), val *acm.PrivateAccount) {
+type txMaker func(t *testing.T, chainID string, height int64, val *acm.PrivateAccount) types.Tx
+
+func makeBlock(t *testing.T, st sm.State, bs *store.BlockStore, mkTx txMaker, commit func(*types.Block),
+	val *acm.PrivateAccount) {
 	height := bs.Height() + 1
-	tx := makeTx(t, st.ChainID, height, val)
+	tx := mkTx(t, st.ChainID, height, val)
 	block, _ := st.MakeBlock(height, []types.Tx{tx}, new(types.Commit), nil,
 		st.Validators.GetProposer().Address)
 
@@ -46,7 +46,7 @@ func (cdb *CacheDB) Iterator(low, high []byte) (storage.KVIterator, error) {
 		return nil, err
 	}
 
-	return Uniq(NewMultiIterator(false, cdb.cache.Iterator(low, high), iterator)), nil
+	return skipDeleted(Uniq(NewMultiIterator(false, cdb.cache.Iterator(low, high), iterator))), nil
 }
 
 func (cdb *CacheDB) ReverseIterator(low, high []byte) (storage.KVIterator, error) {
@@ -55,7 +55,7 @@ func (cdb *CacheDB) ReverseIterator(low, high []byte) (storage.KVIterator, error
 		return nil, err
 	}
 
-	return Uniq(NewMultiIterator(true, cdb.cache.ReverseIterator(low, high), iterator)), nil
+	return skipDeleted(Uniq(NewMultiIterator(true, cdb.cache.ReverseIterator(low, high), iterator))), nil
 }
 
 func (cdb *CacheDB) Set(key, value []byte) error {
@@ -128,3 +128,25 @@ func (cdb *CacheDB) Print() error {
 func (cdb *CacheDB) Stats() map[string]string {
 	return map[string]string{}
 }
+
+// Keys deleted in the cache are iterated with a nil value (which cannot be stored) ahead of any value in the backend
+type deletedSkippingIterator struct {
+	storage.KVIterator
+}
+
+func skipDeleted(source storage.KVIterator) *deletedSkippingIterator {
+	it := &deletedSkippingIterator{source}
+	it.skip()
+	return it
+}
+
+func (it *deletedSkippingIterator) Next() {
+	it.KVIterator.Next()
+	it.skip()
+}
+
+func (it *deletedSkippingIterator) skip() {
+	for it.KVIterator.Valid() && it.KVIterator.Value() == nil {
+		it.KVIterator.Next()
+	}
+}
@@ -80,3 +80,20 @@ func TestCacheDB_Iterator(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, kvp, collectIterator(it))
 }
+
+func TestCacheDB_IteratorDeleted(t *testing.T) {
+	db := dbm.NewMemDB()
+	cdb := NewCacheDB(db)
+	db.Set([]byte("a"), []byte("1"))
+	db.Set([]byte("b"), []byte("2"))
+	db.Set([]byte("c"), []byte("3"))
+	cdb.Delete([]byte("a"))
+	cdb.Delete([]byte("c"))
+
+	it, err := cdb.Iterator(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, kvPairs("b", "2"), collectIterator(it))
+	it, err = cdb.ReverseIterator(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, kvPairs("b", "2"), collectIterator(it))
+}
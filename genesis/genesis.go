@@ -51,6 +51,19 @@ var DefaultPermissionsAccount = PermissionsAccount(permission.DefaultAccountPerm
 
 type params struct {
 	ProposalThreshold uint64
+	// The number of most recent blocks over which each validator's missed blocks are counted (zero disables jailing
+	// validators for downtime)
+	LivenessWindow uint64 `json:",omitempty" toml:",omitempty"`
+	// A validator is jailed once it has missed more than this many of the last LivenessWindow blocks
+	MaxMissedBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// The number of blocks a jailed validator must wait before it may be unjailed
+	JailBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// The number of blocks after unbonding before the bonded balance is returned (zero returns it immediately)
+	UnbondingBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// The minimum fee a CallTx or NameTx must offer
+	MinimumFee uint64 `json:",omitempty" toml:",omitempty"`
+	// The minimum price per unit of gas a CallTx must offer, where its price is its fee divided by its gas limit
+	MinimumGasPrice uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
@@ -34,6 +34,12 @@ type GenesisSpec struct {
 
 type params struct {
 	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	LivenessWindow    uint64 `json:",omitempty" toml:",omitempty"`
+	MaxMissedBlocks   uint64 `json:",omitempty" toml:",omitempty"`
+	JailBlocks        uint64 `json:",omitempty" toml:",omitempty"`
+	UnbondingBlocks   uint64 `json:",omitempty" toml:",omitempty"`
+	MinimumFee        uint64 `json:",omitempty" toml:",omitempty"`
+	MinimumGasPrice   uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 // Produce a fully realised GenesisDoc from a template GenesisDoc that may omit values
@@ -54,6 +60,12 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, curve crypto.CurveTy
 	if gs.Params.ProposalThreshold != 0 {
 		genesisDoc.Params.ProposalThreshold = genesis.DefaultProposalThreshold
 	}
+	genesisDoc.Params.LivenessWindow = gs.Params.LivenessWindow
+	genesisDoc.Params.MaxMissedBlocks = gs.Params.MaxMissedBlocks
+	genesisDoc.Params.JailBlocks = gs.Params.JailBlocks
+	genesisDoc.Params.UnbondingBlocks = gs.Params.UnbondingBlocks
+	genesisDoc.Params.MinimumFee = gs.Params.MinimumFee
+	genesisDoc.Params.MinimumGasPrice = gs.Params.MinimumGasPrice
 
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
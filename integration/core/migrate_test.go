@@ -0,0 +1,50 @@
+//go:build integration && boltdb
+// +build integration,boltdb
+
+package core
+
+import (
+	"path/filepath"
+	"testing"
+
+	bcore "github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMigrateStorage(t *testing.T) {
+	genesisDoc, privateAccounts, privateValidators := genesis.NewDeterministicGenesis(123).GenesisDoc(1, 1)
+	conf, cleanup := integration.NewTestConfig(genesisDoc)
+	defer cleanup()
+
+	var height uint64
+	err := bootWaitBlocksShutdown(t, privateValidators[0], privateAccounts, conf, func(block *exec.BlockExecution) bool {
+		height = block.Height
+		return height < 3
+	})
+	require.NoError(t, err)
+
+	err = bcore.MigrateStorage(conf, dbm.BoltDBBackend, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.True(t, storage.DBExists(bcore.BurrowDBName, dbm.BoltDBBackend, conf.BurrowDir))
+	assert.True(t, storage.DBExists(bcore.BurrowDBName, dbm.GoLevelDBBackend,
+		filepath.Join(conf.BurrowDir, "backup-goleveldb")))
+
+	// Already migrated
+	conf.Storage.Backend = string(dbm.BoltDBBackend)
+	err = bcore.MigrateStorage(conf, dbm.BoltDBBackend, logging.NewNoopLogger())
+	require.Error(t, err)
+
+	// Resumes from the migrated state
+	err = bootWaitBlocksShutdown(t, privateValidators[0], privateAccounts, conf, func(block *exec.BlockExecution) bool {
+		require.Greater(t, block.Height, height)
+		return false
+	})
+	require.NoError(t, err)
+}
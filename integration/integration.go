@@ -96,6 +96,8 @@ func NewTestConfig(genesisDoc *genesis.GenesisDoc,
 	conf.RPC.Info.ListenPort = freeport
 	conf.RPC.Web3.ListenHost = rpc.LocalHost
 	conf.RPC.Web3.ListenPort = freeport
+	conf.RPC.Gateway.ListenHost = rpc.LocalHost
+	conf.RPC.Gateway.ListenPort = freeport
 	conf.Execution.TimeoutFactor = 0.5
 	conf.Execution.VMOptions = []execution.VMOption{}
 	for _, opt := range options {
@@ -112,7 +114,7 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 
 	fmt.Println("Creating integration test Kernel...")
 
-	kern, err := core.NewKernel(testConfig.BurrowDir)
+	kern, err := core.NewKernel(testConfig.BurrowDir, testConfig.Storage)
 	if err != nil {
 		return nil, err
 	}
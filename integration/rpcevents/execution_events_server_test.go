@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
@@ -128,6 +129,45 @@ func TestExecutionEventsTest(t *testing.T) {
 			require.NoError(t, stream.CloseSend())
 		})
 
+		t.Run("StreamResume", func(t *testing.T) {
+			blockRange := doSends(t, 8, tcli, kern, inputAddress1, 2004)
+			recvAll := func(request *rpcevents.BlocksRequest) []*exec.StreamEvent {
+				stream, err := ecli.Stream(context.Background(), request)
+				require.NoError(t, err)
+				var evs []*exec.StreamEvent
+				for ev, err := stream.Recv(); err != io.EOF; ev, err = stream.Recv() {
+					require.NoError(t, err)
+					evs = append(evs, ev)
+				}
+				return evs
+			}
+			all := recvAll(&rpcevents.BlocksRequest{BlockRange: blockRange})
+			require.True(t, len(all) > 4)
+			// Resume from the middle of a block
+			for _, i := range []int{2, len(all) / 2, len(all) - 2} {
+				resumed := recvAll(&rpcevents.BlocksRequest{BlockRange: blockRange, After: *all[i].Cursor})
+				assert.Equal(t, all[i+1:], resumed)
+			}
+
+			// Block consumers resume from the end of a block
+			var cursor []byte
+			var firstHeight uint64
+			stream, err := ecli.Stream(context.Background(), &rpcevents.BlocksRequest{BlockRange: blockRange})
+			require.NoError(t, err)
+			err = rpcevents.ConsumeBlockExecutionsWithCursor(stream, func(be *exec.BlockExecution, c binary.HexBytes) error {
+				firstHeight, cursor = be.Height, c
+				return io.EOF
+			})
+			require.Equal(t, io.EOF, err)
+			stream, err = ecli.Stream(context.Background(), &rpcevents.BlocksRequest{BlockRange: blockRange, After: cursor})
+			require.NoError(t, err)
+			err = rpcevents.ConsumeBlockExecutions(stream, func(be *exec.BlockExecution) error {
+				assert.True(t, be.Height > firstHeight)
+				return nil
+			})
+			require.Equal(t, io.EOF, err)
+		})
+
 		t.Run("GetEventsSend", func(t *testing.T) {
 			numSends := 1100
 			request := &rpcevents.BlocksRequest{BlockRange: doSends(t, numSends, tcli, kern, inputAddress0, 2004)}
@@ -122,6 +122,30 @@ func TestQueryServer(t *testing.T) {
 		assert.Equal(t, int64(height), header.Height)
 		assert.Len(t, header.AppHash, tmhash.Size)
 	})
+
+	t.Run("GetStateDiff", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		name, data := "StateDiff", "WHAT CHANGED"
+		txe, err := rpctest.UpdateName(tcli, rpctest.PrivateAccounts[0].GetAddress(), name, data, 200)
+		require.NoError(t, err)
+		diff, err := qcli.GetStateDiff(context.Background(), &rpcquery.GetStateDiffParam{Height: txe.Height})
+		require.NoError(t, err)
+		assert.Equal(t, txe.Height, diff.Height)
+		require.Len(t, diff.Names, 1)
+		assert.Equal(t, name, diff.Names[0].Name)
+		assert.Nil(t, diff.Names[0].Before)
+		assert.Equal(t, data, diff.Names[0].After.Data)
+		// The sender's sequence and balance changed
+		var senders int
+		for _, accDiff := range diff.Accounts {
+			if accDiff.Address == rpctest.PrivateAccounts[0].GetAddress() {
+				senders++
+				assert.Equal(t, accDiff.Before.Sequence+1, accDiff.After.Sequence)
+			}
+		}
+		assert.Equal(t, 1, senders)
+	})
 }
 
 func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*names.Entry {
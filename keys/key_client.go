@@ -180,9 +180,10 @@ func NewLocalKeyClient(ks KeyStore, logger *logging.Logger) KeyClient {
 }
 
 type Signer struct {
-	keyClient KeyClient
-	address   crypto.Address
-	publicKey crypto.PublicKey
+	keyClient  KeyClient
+	address    crypto.Address
+	keyAddress crypto.Address
+	publicKey  crypto.PublicKey
 }
 
 // AddressableSigner creates a signer that assumes the address holds an Ed25519 key
@@ -193,12 +194,24 @@ func AddressableSigner(keyClient KeyClient, address crypto.Address) (*Signer, er
 	}
 	// TODO: we can do better than this and return a typed signature when we reform the keys service
 	return &Signer{
-		keyClient: keyClient,
-		address:   address,
-		publicKey: publicKey,
+		keyClient:  keyClient,
+		address:    address,
+		keyAddress: address,
+		publicKey:  publicKey,
 	}, nil
 }
 
+// AccountSigner creates a signer for the account at address using publicKey, which need not be the key the address
+// is derived from if the account's key has been rotated
+func AccountSigner(keyClient KeyClient, address crypto.Address, publicKey crypto.PublicKey) *Signer {
+	return &Signer{
+		keyClient:  keyClient,
+		address:    address,
+		keyAddress: publicKey.GetAddress(),
+		publicKey:  publicKey,
+	}
+}
+
 func (ms *Signer) GetAddress() crypto.Address {
 	return ms.address
 }
@@ -208,5 +221,5 @@ func (ms *Signer) GetPublicKey() crypto.PublicKey {
 }
 
 func (ms *Signer) Sign(message []byte) (*crypto.Signature, error) {
-	return ms.keyClient.Sign(ms.address, message)
+	return ms.keyClient.Sign(ms.keyAddress, message)
 }
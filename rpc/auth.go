@@ -0,0 +1,316 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Method name prefixes for the HTTP servers, GRPC methods are named by their full method name without the leading
+	// slash, e.g. rpcquery.Query/GetAccount
+	InfoMethodPrefix = "info/"
+	Web3MethodPrefix = "web3/"
+
+	AuthorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+	AnonymousClient     = "anonymous"
+)
+
+// AuthConfig restricts which RPC methods clients may call. Clients are identified by a bearer token passed in the
+// 'authorization' header (or GRPC metadata) or by the common name of their TLS client certificate.
+//
+// Methods are matched against patterns with path.Match, except that '*' on its own matches every method. For example:
+// 'rpcquery.Query/*', 'rpctransact.Transact/SignTx', 'info/status', or 'web3/eth_get*'.
+type AuthConfig struct {
+	// Methods that clients presenting no credentials may call
+	AnonymousAllow []string `json:",omitempty" toml:",omitempty"`
+	Clients        []*ClientConfig
+}
+
+type ClientConfig struct {
+	Name string
+	// Bearer token this client authenticates with
+	Token string `json:",omitempty" toml:",omitempty"`
+	// Common name of the client's TLS certificate (requires a server with a TLS ClientCAFile)
+	CertificateCommonName string `json:",omitempty" toml:",omitempty"`
+	// Methods this client may call
+	Allow []string
+}
+
+type Authorizer struct {
+	anonymous *ClientConfig
+	clients   []*ClientConfig
+}
+
+// Returns an Authorizer that permits everything when conf is nil
+func NewAuthorizer(conf *AuthConfig) (*Authorizer, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	auth := &Authorizer{
+		anonymous: &ClientConfig{Name: AnonymousClient, Allow: conf.AnonymousAllow},
+		clients:   conf.Clients,
+	}
+	err := validatePatterns(auth.anonymous)
+	if err != nil {
+		return nil, err
+	}
+	for _, client := range auth.clients {
+		if client.Name == "" {
+			return nil, fmt.Errorf("AuthConfig: clients must have a Name")
+		}
+		if client.Token == "" && client.CertificateCommonName == "" {
+			return nil, fmt.Errorf("AuthConfig: client %s must have a Token or CertificateCommonName", client.Name)
+		}
+		err = validatePatterns(client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return auth, nil
+}
+
+func validatePatterns(client *ClientConfig) error {
+	for _, pattern := range client.Allow {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("AuthConfig: client %s has invalid method pattern '%s': %v", client.Name, pattern, err)
+		}
+	}
+	return nil
+}
+
+// Identify the client from its credentials. If a token is presented it must be known. Returns the anonymous client
+// if no credentials are presented.
+func (auth *Authorizer) Authenticate(token string, state *tls.ConnectionState) (*ClientConfig, error) {
+	if token != "" {
+		for _, client := range auth.clients {
+			if client.Token != "" && subtle.ConstantTimeCompare([]byte(client.Token), []byte(token)) == 1 {
+				return client, nil
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "unrecognised bearer token")
+	}
+	if state != nil && len(state.VerifiedChains) > 0 {
+		cn := state.VerifiedChains[0][0].Subject.CommonName
+		for _, client := range auth.clients {
+			if client.CertificateCommonName != "" && client.CertificateCommonName == cn {
+				return client, nil
+			}
+		}
+	}
+	return auth.anonymous, nil
+}
+
+// Returns a GRPC status error if client may not call method
+func (auth *Authorizer) Authorize(client *ClientConfig, method string) error {
+	for _, pattern := range client.Allow {
+		if pattern == "*" {
+			return nil
+		}
+		if ok, _ := path.Match(pattern, method); ok {
+			return nil
+		}
+	}
+	if client == auth.anonymous {
+		return status.Errorf(codes.Unauthenticated, "credentials required to call %s", method)
+	}
+	return status.Errorf(codes.PermissionDenied, "client %s may not call %s", client.Name, method)
+}
+
+// Authenticate and authorize a GRPC call from its context
+func (auth *Authorizer) AuthorizeContext(ctx context.Context, fullMethod string) (*ClientConfig, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(AuthorizationHeader) {
+			token = bearerToken(value)
+		}
+	}
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &tlsInfo.State
+		}
+	}
+	client, err := auth.Authenticate(token, state)
+	if err != nil {
+		return nil, err
+	}
+	return client, auth.Authorize(client, strings.TrimPrefix(fullMethod, "/"))
+}
+
+// Authenticate and authorize an HTTP request, methods extracts the names of the methods the request would call
+func (auth *Authorizer) AuthorizeRequest(r *http.Request, methods []string) (*ClientConfig, error) {
+	client, err := auth.Authenticate(bearerToken(r.Header.Get(AuthorizationHeader)), r.TLS)
+	if err != nil {
+		return nil, err
+	}
+	for _, method := range methods {
+		err = auth.Authorize(client, method)
+		if err != nil {
+			return client, err
+		}
+	}
+	return client, nil
+}
+
+// Authorizes calls and makes the client available to handlers via ClientFromContext
+func (auth *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		client, err := auth.AuthorizeContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ContextWithClient(ctx, client), req)
+	}
+}
+
+// Authorizes calls and makes the client available to handlers via ClientFromContext
+func (auth *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		client, err := auth.AuthorizeContext(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStreamWithContext{
+			ServerStream: ss,
+			ctx:          ContextWithClient(ss.Context(), client),
+		})
+	}
+}
+
+type clientContextKey struct{}
+
+// Returns the client authenticated by an Authorizer or nil if there is none
+func ClientFromContext(ctx context.Context) *ClientConfig {
+	client, _ := ctx.Value(clientContextKey{}).(*ClientConfig)
+	return client
+}
+
+// Attaches client to ctx as an Authorizer does for the handlers it authorizes
+func ContextWithClient(ctx context.Context, client *ClientConfig) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, client)
+}
+
+type serverStreamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStreamWithContext) Context() context.Context {
+	return ss.ctx
+}
+
+func bearerToken(value string) string {
+	if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(value[len(bearerPrefix):])
+	}
+	return ""
+}
+
+// Extracts the names of the methods an HTTP request would call, implementations must restore r.Body if they read it
+type HTTPMethods func(r *http.Request) ([]string, error)
+
+// Wraps an HTTP handler so that a request is only served if its client may call every method it contains
+func (auth *Authorizer) HTTPHandler(handler http.Handler, methods HTTPMethods) http.Handler {
+	if auth == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names, err := methods(r)
+		if err != nil {
+			writeHTTPError(w, status.Errorf(codes.InvalidArgument, "could not read request: %v", err))
+			return
+		}
+		client, err := auth.AuthorizeRequest(r, names)
+		if err != nil {
+			writeHTTPError(w, err)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(ContextWithClient(r.Context(), client)))
+	})
+}
+
+// Written as a JSON-RPC 2.0 error since that is what our HTTP servers speak
+func writeHTTPError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	code := http.StatusForbidden
+	switch st.Code() {
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.ResourceExhausted:
+		code = http.StatusTooManyRequests
+	}
+	bs, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    -32000,
+			"message": st.Message(),
+		},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(bs) // nolint: errcheck
+}
+
+// Extracts JSON-RPC method names (of a single or batch request) from the request body. If pathRoutes is set then
+// requests to any path other than the root are treated as calls to the method named by the path.
+func JSONRPCMethods(prefix string, pathRoutes bool) HTTPMethods {
+	return func(r *http.Request) ([]string, error) {
+		if pathRoutes && r.URL.Path != "/" && r.URL.Path != "" {
+			return []string{prefix + strings.TrimPrefix(r.URL.Path, "/")}, nil
+		}
+		if r.Body == nil {
+			return nil, nil
+		}
+		bs, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(bs))
+		bs = bytes.TrimSpace(bs)
+		if len(bs) == 0 {
+			return nil, nil
+		}
+		type request struct {
+			Method string `json:"method"`
+		}
+		var requests []request
+		if bs[0] == '[' {
+			err = json.Unmarshal(bs, &requests)
+		} else {
+			requests = make([]request, 1)
+			err = json.Unmarshal(bs, &requests[0])
+		}
+		if err != nil {
+			return nil, err
+		}
+		methods := make([]string, len(requests))
+		for i, req := range requests {
+			methods[i] = prefix + req.Method
+		}
+		return methods, nil
+	}
+}
@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func testAuthorizer(t *testing.T) *Authorizer {
+	auth, err := NewAuthorizer(&AuthConfig{
+		AnonymousAllow: []string{"rpcquery.Query/Status", InfoMethodPrefix + "status"},
+		Clients: []*ClientConfig{
+			{
+				Name:  "reader",
+				Token: "read-token",
+				Allow: []string{"rpcquery.Query/*", "rpcevents.ExecutionEvents/*", Web3MethodPrefix + "eth_get*"},
+			},
+			{
+				Name:                  "signer",
+				CertificateCommonName: "signer.example.com",
+				Allow:                 []string{"*"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return auth
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	auth, err := NewAuthorizer(nil)
+	require.NoError(t, err)
+	assert.Nil(t, auth)
+
+	_, err = NewAuthorizer(&AuthConfig{Clients: []*ClientConfig{{Name: "nobody", Allow: []string{"*"}}}})
+	require.Error(t, err)
+
+	_, err = NewAuthorizer(&AuthConfig{AnonymousAllow: []string{"rpcquery.Query/["}})
+	require.Error(t, err)
+}
+
+func TestAuthorizer_AuthorizeContext(t *testing.T) {
+	auth := testAuthorizer(t)
+	ctx := context.Background()
+
+	_, err := auth.AuthorizeContext(ctx, "/rpcquery.Query/Status")
+	require.NoError(t, err)
+
+	_, err = auth.AuthorizeContext(ctx, "/rpcquery.Query/GetAccount")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	readerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(AuthorizationHeader, "Bearer read-token"))
+	client, err := auth.AuthorizeContext(readerCtx, "/rpcquery.Query/GetAccount")
+	require.NoError(t, err)
+	assert.Equal(t, "reader", client.Name)
+
+	_, err = auth.AuthorizeContext(readerCtx, "/rpctransact.Transact/SignTx")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	badCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(AuthorizationHeader, "Bearer nope"))
+	_, err = auth.AuthorizeContext(badCtx, "/rpcquery.Query/Status")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	signerCtx := peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: tlsState("signer.example.com")}})
+	client, err = auth.AuthorizeContext(signerCtx, "/rpctransact.Transact/SignTx")
+	require.NoError(t, err)
+	assert.Equal(t, "signer", client.Name)
+}
+
+func TestAuthorizer_UnaryInterceptor(t *testing.T) {
+	auth := testAuthorizer(t)
+	unary, _ := Interceptors(logging.NewNoopLogger(), auth, nil)
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcdump.Dump/GetDump"}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+
+	_, err = unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcquery.Query/Status"}, handler)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestAuthorizer_HTTPHandler(t *testing.T) {
+	auth := testAuthorizer(t)
+	var body string
+	handler := auth.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(bs)
+	}), JSONRPCMethods(Web3MethodPrefix, false))
+
+	request := func(token, payload string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	single := `{"jsonrpc": "2.0", "id": 1, "method": "eth_getBalance"}`
+	assert.Equal(t, http.StatusUnauthorized, request("", single))
+	assert.Equal(t, http.StatusOK, request("read-token", single))
+	// Body should be restored for the wrapped handler
+	assert.Equal(t, single, body)
+
+	batch := `[{"method": "eth_getBalance"}, {"method": "eth_sendTransaction"}]`
+	assert.Equal(t, http.StatusForbidden, request("read-token", batch))
+
+	// Info server routes methods by path too
+	info := auth.HTTPHandler(http.NotFoundHandler(), JSONRPCMethods(InfoMethodPrefix, true))
+	w := httptest.NewRecorder()
+	info.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/status", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = httptest.NewRecorder()
+	info.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/accounts", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func tlsState(commonName string) tls.ConnectionState {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+}
@@ -15,12 +15,18 @@ type RPCConfig struct {
 	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
 	Web3     *ServerConfig  `json:",omitempty" toml:",omitempty"`
+	Gateway  *ServerConfig  `json:",omitempty" toml:",omitempty"`
+	// When set, restricts the methods clients may call on the Info, Web3, GRPC, and Gateway servers
+	Auth *AuthConfig `json:",omitempty" toml:",omitempty"`
+	// When set, limits the rate at which each client may call the Info, Web3, GRPC, and Gateway servers
+	RateLimit *RateLimitConfig `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
 	Enabled    bool
 	ListenHost string
 	ListenPort string
+	TLS        *TLSConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func (sc *ServerConfig) ListenAddress() string {
@@ -40,6 +46,7 @@ func DefaultRPCConfig() *RPCConfig {
 		GRPC:     DefaultGRPCConfig(),
 		Metrics:  DefaultMetricsConfig(),
 		Web3:     DefaultWeb3Config(),
+		Gateway:  DefaultGatewayConfig(),
 	}
 }
 
@@ -86,3 +93,12 @@ func DefaultWeb3Config() *ServerConfig {
 		ListenPort: "26660",
 	}
 }
+
+// The HTTP/JSON gateway to the GRPC services
+func DefaultGatewayConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled:    false,
+		ListenHost: AnyLocal,
+		ListenPort: "26661",
+	}
+}
@@ -5,18 +5,21 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/acm/validator"
 	bcm "github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
 	x "github.com/hyperledger/burrow/encoding/hex"
 	"github.com/hyperledger/burrow/encoding/rlp"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/governance"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
@@ -29,11 +32,13 @@ import (
 )
 
 const (
-	chainID      = 1
-	maxGasLimit  = 2<<52 - 1
-	hexZero      = "0x0"
-	hexZeroNonce = "0x0000000000000000"
-	pending      = "null"
+	chainID     = 1
+	maxGasLimit = 2<<52 - 1
+	// The most blocks eth_feeHistory will return, as geth does
+	maxFeeHistoryBlocks = 1024
+	hexZero             = "0x0"
+	hexZeroNonce        = "0x0000000000000000"
+	pending             = "null"
 )
 
 // EthService is a web3 provider
@@ -42,6 +47,7 @@ type EthService struct {
 	events     EventsReader
 	blockchain bcm.BlockchainInfo
 	validators validator.History
+	params     governance.Reader
 	nodeView   *tendermint.NodeView
 	trans      *execution.Transactor
 	keyClient  keys.KeyClient
@@ -53,7 +59,7 @@ type EthService struct {
 // NewEthService returns our web3 provider
 func NewEthService(accounts acmstate.IterableStatsReader,
 	events EventsReader, blockchain bcm.BlockchainInfo,
-	validators validator.History, nodeView *tendermint.NodeView,
+	validators validator.History, params governance.Reader, nodeView *tendermint.NodeView,
 	trans *execution.Transactor, keyStore *keys.FilesystemKeyStore,
 	logger *logging.Logger) *EthService {
 
@@ -64,6 +70,7 @@ func NewEthService(accounts acmstate.IterableStatsReader,
 		events,
 		blockchain,
 		validators,
+		params,
 		nodeView,
 		trans,
 		keyClient,
@@ -78,6 +85,7 @@ var _ web3.Service = &EthService{}
 type EventsReader interface {
 	TxsAtHeight(height uint64) ([]*exec.TxExecution, error)
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	LogsBloom(height uint64) (*exec.Bloom, error)
 }
 
 var _ EventsReader = &state.State{}
@@ -470,7 +478,7 @@ func (srv *EthService) EthGetTransactionReceipt(req *web3.EthGetTransactionRecei
 			GasUsed:           x.EncodeNumber(txe.Result.GetGasUsed()),
 			TransactionHash:   x.EncodeBytes(hash),
 			CumulativeGasUsed: hexZero,
-			LogsBloom:         hexZero,
+			LogsBloom:         x.EncodeBytes(txe.Bloom().Bytes()),
 			Logs:              []web3.Logs{},
 		},
 	}
@@ -541,13 +549,144 @@ func (srv *EthService) EthEstimateGas(req *web3.EthEstimateGasParams) (*web3.Eth
 	}, nil
 }
 
+// EthGasPrice returns the minimum gas price a transaction must offer in wei
 func (srv *EthService) EthGasPrice() (*web3.EthGasPriceResult, error) {
-	// TODO
+	gasPrice, err := srv.minimumGasPrice()
+	if err != nil {
+		return nil, err
+	}
 	return &web3.EthGasPriceResult{
-		GasPrice: hexZero,
+		GasPrice: hexWei(gasPrice),
+	}, nil
+}
+
+// EthFeeHistory returns the fees paid in the requested range of blocks. The base fee is the minimum gas price
+// and the reward is whatever a transaction paid for its gas above that.
+func (srv *EthService) EthFeeHistory(req *web3.EthFeeHistoryParams) (*web3.EthFeeHistoryResult, error) {
+	blockCount, err := x.DecodeToNumber(req.BlockCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse blockCount: %v", err)
+	}
+	newest, err := srv.getHeightByWordOrNumber(req.NewestBlock)
+	if err != nil {
+		return nil, err
+	}
+	if last := srv.blockchain.LastBlockHeight(); newest > last {
+		newest = last
+	}
+	if blockCount > maxFeeHistoryBlocks {
+		blockCount = maxFeeHistoryBlocks
+	}
+	if blockCount > newest+1 {
+		blockCount = newest + 1
+	}
+	for i, percentile := range req.RewardPercentiles {
+		if percentile < 0 || percentile > 100 || (i > 0 && percentile < req.RewardPercentiles[i-1]) {
+			return nil, fmt.Errorf("reward percentiles must be ascending values between 0 and 100")
+		}
+	}
+
+	gasPrice, err := srv.minimumGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	baseFee := balance.NativeToWei(gasPrice)
+	history := web3.FeeHistory{
+		OldestBlock:   x.EncodeNumber(newest + 1 - blockCount),
+		BaseFeePerGas: make([]string, 0, blockCount+1),
+		GasUsedRatio:  make([]float64, 0, blockCount),
+	}
+	if len(req.RewardPercentiles) > 0 {
+		history.Reward = make([][]string, 0, blockCount)
+	}
+	for height := newest + 1 - blockCount; height <= newest; height++ {
+		txes, err := srv.events.TxsAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		var gasUsed uint64
+		var rewards []gasReward
+		for _, txe := range txes {
+			_, tx, err := getHashAndCallTxFromExecution(txe)
+			if err != nil || tx.GasLimit == 0 {
+				continue
+			}
+			used := txe.Result.GetGasUsed()
+			gasUsed += used
+			price := balance.NativeToWei(tx.Fee)
+			price.Div(price, new(big.Int).SetUint64(tx.GasLimit))
+			reward := price.Sub(price, baseFee)
+			if reward.Sign() < 0 {
+				reward.SetInt64(0)
+			}
+			rewards = append(rewards, gasReward{reward: reward, gasUsed: used})
+		}
+		history.BaseFeePerGas = append(history.BaseFeePerGas, hexBigInt(baseFee))
+		history.GasUsedRatio = append(history.GasUsedRatio, float64(gasUsed)/float64(maxGasLimit))
+		if history.Reward != nil {
+			history.Reward = append(history.Reward, rewardPercentiles(rewards, gasUsed, req.RewardPercentiles))
+		}
+	}
+	// The base fee of the next block
+	history.BaseFeePerGas = append(history.BaseFeePerGas, hexBigInt(baseFee))
+
+	return &web3.EthFeeHistoryResult{
+		FeeHistory: history,
 	}, nil
 }
 
+type gasReward struct {
+	reward  *big.Int
+	gasUsed uint64
+}
+
+// Picks the reward of the transaction at which each percentile of the block's gas has been used, as geth does
+func rewardPercentiles(rewards []gasReward, gasUsed uint64, percentiles []float64) []string {
+	result := make([]string, len(percentiles))
+	if len(rewards) == 0 {
+		for i := range result {
+			result[i] = hexZero
+		}
+		return result
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].reward.Cmp(rewards[j].reward) < 0
+	})
+	index := 0
+	sumGasUsed := rewards[0].gasUsed
+	for i, percentile := range percentiles {
+		threshold := uint64(float64(gasUsed) * percentile / 100)
+		for sumGasUsed < threshold && index < len(rewards)-1 {
+			index++
+			sumGasUsed += rewards[index].gasUsed
+		}
+		result[i] = hexBigInt(rewards[index].reward)
+	}
+	return result
+}
+
+// Returns the minimum gas price currently in force, which is the genesis value unless changed by governance
+// Returns the fee implied by the gas price of a transaction, which is only paid once governance.FeesUpgrade has
+// activated
+func (srv *EthService) ethFee(gasPrice, gasLimit uint64) (uint64, error) {
+	payingFees, err := governance.Activated(srv.params, governance.FeesUpgrade, srv.blockchain.LastBlockHeight()+1)
+	if err != nil || !payingFees {
+		return 0, err
+	}
+	return txs.EthFee(gasPrice, gasLimit), nil
+}
+
+func (srv *EthService) minimumGasPrice() (uint64, error) {
+	params, err := srv.params.GetExecutionParams()
+	if err != nil {
+		return 0, err
+	}
+	if params == nil {
+		return srv.blockchain.GenesisDoc().Params.MinimumGasPrice, nil
+	}
+	return params.MinimumGasPrice, nil
+}
+
 type RawTx struct {
 	Nonce    uint64 `json:"nonce"`
 	GasPrice uint64 `json:"gasPrice"`
@@ -611,7 +750,12 @@ func (srv *EthService) EthSendRawTransaction(req *web3.EthSendRawTransactionPara
 		return nil, err
 	}
 
-	amount := balance.WeiToNative(rawTx.Value).Uint64()
+	// The input amount covers the value transferred and the fee paid for gas
+	fee, err := srv.ethFee(rawTx.GasPrice, rawTx.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+	amount := balance.WeiToNative(rawTx.Value).Uint64() + fee
 
 	txEnv := &txs.Envelope{
 		Signatories: []txs.Signatory{
@@ -635,6 +779,7 @@ func (srv *EthService) EthSendRawTransaction(req *web3.EthSendRawTransactionPara
 				Address:  &to,
 				GasLimit: rawTx.GasLimit,
 				GasPrice: rawTx.GasPrice,
+				Fee:      fee,
 				Data:     rawTx.Data,
 			},
 		},
@@ -683,6 +828,15 @@ func hexKeccak(data []byte) string {
 	return x.EncodeBytes(crypto.Keccak256(data))
 }
 
+// Encodes the native amount in wei as a hex quantity
+func hexWei(amount uint64) string {
+	return hexBigInt(balance.NativeToWei(amount))
+}
+
+func hexBigInt(i *big.Int) string {
+	return x.AddPrefix(i.Text(16))
+}
+
 func hexKeccakAddress(data []byte) string {
 	addr := crypto.Keccak256(data)
 	return x.EncodeBytes(addr[len(addr)-20:])
@@ -704,6 +858,7 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (web
 			Timestamp:       x.EncodeNumber(uint64(doc.GenesisTime.Unix())),
 			Number:          hexZero,
 			Size:            hexZero,
+			LogsBloom:       x.EncodeBytes(new(exec.Bloom).Bytes()),
 			ExtraData:       hexZero,
 			Difficulty:      hexZero,
 			TotalDifficulty: hexZero,
@@ -723,6 +878,11 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (web
 		return web3.Block{}, err
 	}
 
+	bloom, err := srv.getLogsBloomAtHeight(height)
+	if err != nil {
+		return web3.Block{}, err
+	}
+
 	transactions := make([]web3.Transactions, 0)
 	if includeTxs {
 		txes, err := srv.events.TxsAtHeight(height)
@@ -751,7 +911,7 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (web
 		Number:           x.EncodeNumber(uint64(block.Height)),
 		Miner:            x.EncodeBytes(block.ProposerAddress.Bytes()),
 		Sha3Uncles:       hexZero,
-		LogsBloom:        hexZero,
+		LogsBloom:        x.EncodeBytes(bloom.Bytes()),
 		ExtraData:        hexZero,
 		Difficulty:       hexZero,
 		TotalDifficulty:  hexZero,
@@ -763,6 +923,23 @@ func (srv *EthService) getBlockInfoAtHeight(height uint64, includeTxs bool) (web
 	}, nil
 }
 
+// Blocks stored before we recorded logs blooms have their bloom computed from their transactions
+func (srv *EthService) getLogsBloomAtHeight(height uint64) (*exec.Bloom, error) {
+	bloom, err := srv.events.LogsBloom(height)
+	if err != nil || bloom != nil {
+		return bloom, err
+	}
+	txes, err := srv.events.TxsAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	bloom = new(exec.Bloom)
+	for _, txe := range txes {
+		bloom.Or(txe.Bloom())
+	}
+	return bloom, nil
+}
+
 func getTransaction(block *types.Header, hash []byte, tx *payload.CallTx) web3.Transaction {
 	// TODO: sensible defaults for non-call
 	transaction := web3.Transaction{
@@ -770,7 +947,7 @@ func getTransaction(block *types.Header, hash []byte, tx *payload.CallTx) web3.T
 		R:        hexZero,
 		S:        hexZero,
 		From:     x.EncodeBytes(tx.Input.Address.Bytes()),
-		Value:    x.EncodeNumber(tx.Input.Amount),
+		Value:    x.EncodeNumber(tx.Input.Amount - tx.Fee),
 		Nonce:    x.EncodeNumber(tx.Input.Sequence),
 		Gas:      x.EncodeNumber(tx.GasLimit),
 		GasPrice: x.EncodeNumber(tx.GasPrice),
@@ -877,6 +1054,12 @@ func (srv *EthService) EthSendTransaction(req *web3.EthSendTransactionParams) (*
 		tx.Data = bs
 	}
 
+	tx.Fee, err = srv.ethFee(tx.GasPrice, tx.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+	tx.Input.Amount += tx.Fee
+
 	txEnv := txs.Enclose(srv.blockchain.ChainID(), tx)
 
 	ctx := context.Background()
@@ -1017,6 +1200,145 @@ func (srv *EthService) EthCoinbase() (*web3.EthCoinbaseResult, error) {
 	return nil, web3.ErrNotFound
 }
 
+// EthGetLogs returns the logs of successful transactions between FromBlock and ToBlock (inclusive) that match the
+// filter's address and topics, blocks whose logs bloom shows they cannot match are skipped without being read
 func (srv *EthService) EthGetLogs(req *web3.EthGetLogsParams) (*web3.EthGetLogsResult, error) {
-	return nil, web3.ErrNotFound
+	filter, err := newLogFilter(req.Address, req.Topics)
+	if err != nil {
+		return nil, err
+	}
+	from, to := srv.blockchain.LastBlockHeight(), srv.blockchain.LastBlockHeight()
+	if req.FromBlock != "" {
+		from, err = srv.getHeightByWordOrNumber(req.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.ToBlock != "" {
+		to, err = srv.getHeightByWordOrNumber(req.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if from == 0 {
+		// genesis has no logs
+		from = 1
+	}
+	logs := make([]web3.Logs, 0)
+	for height := from; height <= to; height++ {
+		bloom, err := srv.events.LogsBloom(height)
+		if err != nil {
+			return nil, err
+		} else if bloom != nil && !filter.mayMatch(bloom) {
+			continue
+		}
+		txes, err := srv.events.TxsAtHeight(height)
+		if err != nil {
+			return nil, err
+		} else if len(txes) == 0 {
+			continue
+		}
+		block, err := srv.blockchain.GetBlockHeader(height)
+		if err != nil {
+			return nil, err
+		}
+		var logIndex uint64
+		for _, txe := range txes {
+			if txe.Exception != nil {
+				continue
+			}
+			for _, ev := range txe.Events {
+				if ev.Log == nil {
+					continue
+				}
+				if filter.matches(ev.Log) {
+					logs = append(logs, getLog(block, txe, ev.Log, logIndex))
+				}
+				logIndex++
+			}
+		}
+	}
+	return &web3.EthGetLogsResult{Logs: logs}, nil
+}
+
+func getLog(block *types.Header, txe *exec.TxExecution, log *exec.LogEvent, logIndex uint64) web3.Logs {
+	topics := make([]web3.Topics, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = web3.Topics{DataWord: x.EncodeBytes(topic.Bytes())}
+	}
+	return web3.Logs{
+		LogIndex:         x.EncodeNumber(logIndex),
+		TransactionIndex: x.EncodeNumber(txe.GetIndex()),
+		TransactionHash:  x.EncodeBytes(txe.TxHash),
+		Address:          x.EncodeBytes(log.Address.Bytes()),
+		BlockHash:        hexKeccak(block.Hash().Bytes()),
+		BlockNumber:      x.EncodeNumber(uint64(block.Height)),
+		Data:             x.EncodeBytes(log.Data),
+		Topics:           topics,
+	}
+}
+
+// Matches logs by emitting address and by position of topics, a nil address or topic matches anything
+type logFilter struct {
+	address *crypto.Address
+	topics  []*binary.Word256
+}
+
+func newLogFilter(address string, topics []string) (*logFilter, error) {
+	filter := &logFilter{
+		topics: make([]*binary.Word256, len(topics)),
+	}
+	if address != "" {
+		bs, err := x.DecodeToBytes(address)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := crypto.AddressFromBytes(bs)
+		if err != nil {
+			return nil, err
+		}
+		filter.address = &addr
+	}
+	for i, topic := range topics {
+		if topic == "" || topic == pending {
+			continue
+		}
+		bs, err := x.DecodeToBytes(topic)
+		if err != nil {
+			return nil, err
+		} else if len(bs) != binary.Word256Bytes {
+			return nil, fmt.Errorf("topic %s is not %d bytes", topic, binary.Word256Bytes)
+		}
+		word := binary.LeftPadWord256(bs)
+		filter.topics[i] = &word
+	}
+	return filter, nil
+}
+
+func (filter *logFilter) mayMatch(bloom *exec.Bloom) bool {
+	if filter.address != nil && !bloom.TestAddress(*filter.address) {
+		return false
+	}
+	for _, topic := range filter.topics {
+		if topic != nil && !bloom.TestTopic(*topic) {
+			return false
+		}
+	}
+	return true
+}
+
+func (filter *logFilter) matches(log *exec.LogEvent) bool {
+	if filter.address != nil && *filter.address != log.Address {
+		return false
+	}
+	for i, topic := range filter.topics {
+		if topic == nil {
+			continue
+		}
+		if i >= len(log.Topics) || *topic != log.Topics[i] {
+			return false
+		}
+	}
+	return true
 }
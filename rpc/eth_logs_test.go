@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	x "github.com/hyperledger/burrow/encoding/hex"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogFilter(t *testing.T) {
+	contract := crypto.Address{1, 2, 3}
+	transfer := binary.LeftPadWord256([]byte("Transfer"))
+	from := binary.LeftPadWord256([]byte("from"))
+	log := &exec.LogEvent{Address: contract, Topics: []binary.Word256{transfer, from}}
+	bloom := new(exec.Bloom)
+	bloom.AddLog(log)
+
+	filter, err := newLogFilter(x.EncodeBytes(contract.Bytes()), []string{x.EncodeBytes(transfer.Bytes())})
+	require.NoError(t, err)
+	assert.True(t, filter.mayMatch(bloom))
+	assert.True(t, filter.matches(log))
+	assert.False(t, filter.mayMatch(new(exec.Bloom)))
+
+	// Wildcard first topic
+	filter, err = newLogFilter("", []string{"", x.EncodeBytes(from.Bytes())})
+	require.NoError(t, err)
+	assert.True(t, filter.matches(log))
+
+	// Topic in the wrong position
+	filter, err = newLogFilter("", []string{x.EncodeBytes(from.Bytes())})
+	require.NoError(t, err)
+	assert.True(t, filter.mayMatch(bloom))
+	assert.False(t, filter.matches(log))
+
+	filter, err = newLogFilter(x.EncodeBytes(crypto.Address{4}.Bytes()), nil)
+	require.NoError(t, err)
+	assert.False(t, filter.mayMatch(bloom))
+	assert.False(t, filter.matches(log))
+
+	_, err = newLogFilter("", []string{"0x1234"})
+	require.Error(t, err)
+}
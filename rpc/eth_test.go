@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	x "github.com/hyperledger/burrow/encoding/hex"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging"
@@ -55,7 +56,8 @@ func TestWeb3Service(t *testing.T) {
 	accountState := kern.State
 	eventsState := kern.State
 	validatorState := kern.State
-	eth := rpc.NewEthService(accountState, eventsState, kern.Blockchain, validatorState,
+	paramsState := kern.State
+	eth := rpc.NewEthService(accountState, eventsState, kern.Blockchain, validatorState, paramsState,
 		nodeView, kern.Transactor, store, kern.Logger)
 
 	t.Run("Web3Sha3", func(t *testing.T) {
@@ -191,6 +193,21 @@ func TestWeb3Service(t *testing.T) {
 			require.NoError(t, err)
 			contractAddress = receiptResult.Receipt.ContractAddress
 			require.NotEmpty(t, contractAddress)
+			// HelloWorld emits no events
+			require.Equal(t, x.EncodeBytes(new(exec.Bloom).Bytes()), receiptResult.Receipt.LogsBloom)
+		})
+
+		t.Run("EthGetLogs", func(t *testing.T) {
+			require.NotEmpty(t, contractAddress, "need contract address to filter logs")
+			result, err := eth.EthGetLogs(&web3.EthGetLogsParams{
+				Filter: web3.Filter{
+					FromBlock: "earliest",
+					ToBlock:   "latest",
+					Address:   contractAddress,
+				},
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Logs)
 		})
 
 		t.Run("EthCall", func(t *testing.T) {
@@ -258,6 +275,39 @@ func TestWeb3Service(t *testing.T) {
 		hashResult, err := eth.EthGetBlockByHash(&web3.EthGetBlockByHashParams{BlockHash: numberResult.GetBlockByNumberResult.Hash})
 		require.NoError(t, err)
 		require.Equal(t, numberResult.GetBlockByNumberResult, hashResult.GetBlockByHashResult)
+		bloom, err := x.DecodeToBytes(numberResult.GetBlockByNumberResult.LogsBloom)
+		require.NoError(t, err)
+		require.Len(t, bloom, exec.BloomByteLength)
+	})
+
+	t.Run("EthGasPrice", func(t *testing.T) {
+		result, err := eth.EthGasPrice()
+		require.NoError(t, err)
+		require.Equal(t, "0x0", result.GasPrice)
+	})
+
+	t.Run("EthFeeHistory", func(t *testing.T) {
+		result, err := eth.EthFeeHistory(&web3.EthFeeHistoryParams{
+			BlockCount:        x.EncodeNumber(2),
+			NewestBlock:       "latest",
+			RewardPercentiles: []float64{25, 75},
+		})
+		require.NoError(t, err)
+		history := result.FeeHistory
+		require.Equal(t, x.EncodeNumber(kern.Blockchain.LastBlockHeight()-1), history.OldestBlock)
+		require.Len(t, history.BaseFeePerGas, 3)
+		require.Len(t, history.GasUsedRatio, 2)
+		require.Len(t, history.Reward, 2)
+		for _, reward := range history.Reward {
+			require.Equal(t, []string{"0x0", "0x0"}, reward)
+		}
+
+		_, err = eth.EthFeeHistory(&web3.EthFeeHistoryParams{
+			BlockCount:        x.EncodeNumber(1),
+			NewestBlock:       "latest",
+			RewardPercentiles: []float64{75, 25},
+		})
+		require.Error(t, err)
 	})
 
 }
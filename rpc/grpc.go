@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"fmt"
+	"net/http"
 	"runtime/debug"
 
 	"github.com/hyperledger/burrow/encoding"
@@ -12,13 +13,57 @@ import (
 	"google.golang.org/grpc"
 )
 
-func NewGRPCServer(logger *logging.Logger) *grpc.Server {
-	return grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor(logger)),
-		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"))),
-		grpc.CustomCodec(&encoding.GRPCCodec{}))
+// If auth is non-nil calls are authenticated and authorized before being handled, if limiter is non-nil they are
+// then rate limited
+func NewGRPCServer(logger *logging.Logger, auth *Authorizer, limiter *RateLimiter,
+	opts ...grpc.ServerOption) *grpc.Server {
+	unary, stream := Interceptors(logger.WithScope("NewGRPCServer"), auth, limiter)
+	return grpc.NewServer(append(opts, grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream),
+		grpc.CustomCodec(&encoding.GRPCCodec{}))...)
 }
 
-func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
+// Returns the interceptors used by NewGRPCServer so they can be applied by other grpc.ServiceRegistrar implementations
+func Interceptors(logger *logging.Logger, auth *Authorizer, limiter *RateLimiter) (grpc.UnaryServerInterceptor,
+	grpc.StreamServerInterceptor) {
+	unary := UnaryInterceptor(logger)
+	stream := StreamInterceptor(logger)
+	if auth != nil {
+		unary = chainUnary(unary, auth.UnaryInterceptor())
+		stream = chainStream(stream, auth.StreamInterceptor())
+	}
+	if limiter != nil {
+		unary = chainUnary(unary, limiter.UnaryInterceptor())
+		stream = chainStream(stream, limiter.StreamInterceptor())
+	}
+	return unary, stream
+}
+
+// Wraps an HTTP handler with the same authorization and rate limiting as is applied by the GRPC interceptors, either
+// of auth or limiter may be nil
+func HTTPHandler(handler http.Handler, auth *Authorizer, limiter *RateLimiter, methods HTTPMethods) http.Handler {
+	return auth.HTTPHandler(limiter.HTTPHandler(handler), methods)
+}
+
+func chainUnary(outer, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+func chainStream(outer, inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		return outer(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return inner(srv, ss, info, handler)
+		})
+	}
+}
+
+// Logs unary calls and recovers from panics in their handlers
+func UnaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
@@ -35,7 +80,8 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
-func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
+// Logs streaming calls and recovers from panics in their handlers
+func StreamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
 		logger = logger.With("method", info.FullMethod,
@@ -33,6 +33,7 @@ type Exporter struct {
 	blockSampleSize              uint64
 	txPerBlockHistogramBuilder   HistogramBuilder
 	timePerBlockHistogramBuilder HistogramBuilder
+	rejections                   RejectionCounter
 	logger                       *logging.Logger
 }
 
@@ -45,6 +46,11 @@ type InfoService interface {
 	Stats() acmstate.AccountStatsGetter
 }
 
+// Satisfied by rpc.RateLimiter
+type RejectionCounter interface {
+	Rejections() rpc.RateLimitRejections
+}
+
 // Datum is used to store data from all the relevant endpoints
 type Datum struct {
 	LatestBlockHeight   float64
@@ -80,6 +86,12 @@ func NewExporter(service InfoService, blockSampleSize int, logger *logging.Logge
 	}, nil
 }
 
+// Export counts of RPC calls rejected by rate limiting
+func (e *Exporter) WithRejections(counter RejectionCounter) *Exporter {
+	e.rejections = counter
+	return e
+}
+
 // Describe - loops through the API metrics and passes them to prometheus.Describe
 func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
 	for _, m := range MetricDescriptions {
@@ -162,6 +174,25 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.chainID,
 		e.validatorMoniker,
 	)
+	if e.rejections != nil {
+		rejections := e.rejections.Rejections()
+		ch <- prometheus.MustNewConstMetric(
+			RejectedCalls,
+			prometheus.CounterValue,
+			float64(rejections.RateExceeded),
+			e.chainID,
+			e.validatorMoniker,
+			"rate",
+		)
+		ch <- prometheus.MustNewConstMetric(
+			RejectedCalls,
+			prometheus.CounterValue,
+			float64(rejections.StreamsExceeded),
+			e.chainID,
+			e.validatorMoniker,
+			"streams",
+		)
+	}
 
 	e.logger.InfoMsg("All Metrics successfully collected")
 }
@@ -49,6 +49,11 @@ var (
 		prometheus.BuildFQName("burrow", "accounts", "users"),
 		"Current users on the chain",
 		[]string{"chain_id", "moniker"})
+
+	RejectedCalls = newDesc(
+		prometheus.BuildFQName("burrow", "rpc", "rejected_calls"),
+		"RPC calls rejected by rate limiting",
+		[]string{"chain_id", "moniker", "reason"})
 )
 
 func newDesc(fqName, help string, variableLabels []string) *prometheus.Desc {
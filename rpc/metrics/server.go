@@ -15,14 +15,18 @@ import (
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
+// If rejections is non-nil the number of RPC calls it has rejected are also exported
 func StartServer(service *rpc.Service, pattern string, listener net.Listener, blockSampleSize int,
-	logger *logging.Logger) (*http.Server, error) {
+	rejections RejectionCounter, logger *logging.Logger) (*http.Server, error) {
 
 	// instantiate metrics and variables we do not expect to change during runtime
 	exporter, err := NewExporter(service, blockSampleSize, logger)
 	if err != nil {
 		return nil, err
 	}
+	if rejections != nil {
+		exporter.WithRejections(rejections)
+	}
 
 	// Register Metrics from each of the endpoints
 	// This invokes the Collect method through the prometheus client libraries.
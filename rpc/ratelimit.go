@@ -0,0 +1,243 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Beyond this many tracked clients we drop those that are idle
+const maxIdleRateLimitClients = 1024
+
+type RateLimit struct {
+	// Sustained number of calls per second a client may make (0 for no limit)
+	RequestsPerSecond float64
+	// Number of calls a client may make in a burst above the sustained rate
+	Burst int
+	// Maximum number of streaming calls a client may have open at once (0 for no limit)
+	MaxConcurrentStreams int
+}
+
+// RateLimitConfig limits the rate at which each client may call the Info, Web3, GRPC, and Gateway servers. Clients
+// authenticated by the Auth config are identified by name, all others by their IP address.
+type RateLimitConfig struct {
+	RateLimit
+	// Limits that override the defaults for clients identified by name or IP address
+	Clients map[string]*RateLimit `json:",omitempty" toml:",omitempty"`
+}
+
+// Counts of calls rejected by a RateLimiter
+type RateLimitRejections struct {
+	// Calls rejected for exceeding the client's request rate
+	RateExceeded uint64
+	// Streaming calls rejected for exceeding the client's concurrent stream limit
+	StreamsExceeded uint64
+}
+
+type RateLimiter struct {
+	sync.Mutex
+	conf            *RateLimitConfig
+	clients         map[string]*clientLimiter
+	rateExceeded    uint64
+	streamsExceeded uint64
+	now             func() time.Time
+}
+
+type clientLimiter struct {
+	limit   *RateLimit
+	tokens  float64
+	updated time.Time
+	streams int
+}
+
+// Returns a RateLimiter that permits everything when conf is nil
+func NewRateLimiter(conf *RateLimitConfig) *RateLimiter {
+	if conf == nil {
+		return nil
+	}
+	return &RateLimiter{
+		conf:    conf,
+		clients: make(map[string]*clientLimiter),
+		now:     time.Now,
+	}
+}
+
+// Take a token from the client's bucket if one is available
+func (rl *RateLimiter) Allow(client string) bool {
+	rl.Lock()
+	defer rl.Unlock()
+	cl := rl.client(client)
+	if cl.limit.RequestsPerSecond <= 0 {
+		return true
+	}
+	cl.refill(rl.now())
+	if cl.tokens < 1 {
+		atomic.AddUint64(&rl.rateExceeded, 1)
+		return false
+	}
+	cl.tokens--
+	return true
+}
+
+// Take a token and reserve one of the client's concurrent streams, release must be called when the stream finishes
+func (rl *RateLimiter) OpenStream(client string) (release func(), err error) {
+	if !rl.Allow(client) {
+		return nil, rateExceededError(client)
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	cl := rl.client(client)
+	if cl.limit.MaxConcurrentStreams > 0 && cl.streams >= cl.limit.MaxConcurrentStreams {
+		atomic.AddUint64(&rl.streamsExceeded, 1)
+		return nil, status.Errorf(codes.ResourceExhausted, "client %s already has %d concurrent streams open",
+			client, cl.streams)
+	}
+	cl.streams++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			rl.Lock()
+			defer rl.Unlock()
+			cl.streams--
+		})
+	}, nil
+}
+
+func (rl *RateLimiter) Rejections() RateLimitRejections {
+	if rl == nil {
+		return RateLimitRejections{}
+	}
+	return RateLimitRejections{
+		RateExceeded:    atomic.LoadUint64(&rl.rateExceeded),
+		StreamsExceeded: atomic.LoadUint64(&rl.streamsExceeded),
+	}
+}
+
+func (rl *RateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		client := clientIdentity(ctx)
+		if !rl.Allow(client) {
+			return nil, rateExceededError(client)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (rl *RateLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		release, err := rl.OpenStream(clientIdentity(ss.Context()))
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+}
+
+// Wraps an HTTP handler so that requests are rate limited, websocket connections count as streams
+func (rl *RateLimiter) HTTPHandler(handler http.Handler) http.Handler {
+	if rl == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := clientIdentity(r.Context())
+		if client == "" {
+			client = hostOf(r.RemoteAddr)
+		}
+		if r.Header.Get("Upgrade") != "" {
+			release, err := rl.OpenStream(client)
+			if err != nil {
+				writeHTTPError(w, err)
+				return
+			}
+			defer release()
+		} else if !rl.Allow(client) {
+			writeHTTPError(w, rateExceededError(client))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Must be called with lock held
+func (rl *RateLimiter) client(client string) *clientLimiter {
+	cl, ok := rl.clients[client]
+	if !ok {
+		if len(rl.clients) >= maxIdleRateLimitClients {
+			rl.dropIdle()
+		}
+		limit := &rl.conf.RateLimit
+		if override, ok := rl.conf.Clients[client]; ok {
+			limit = override
+		}
+		cl = &clientLimiter{
+			limit:   limit,
+			tokens:  float64(limit.burst()),
+			updated: rl.now(),
+		}
+		rl.clients[client] = cl
+	}
+	return cl
+}
+
+// Clients with full buckets and no open streams are indistinguishable from new ones so can be forgotten
+func (rl *RateLimiter) dropIdle() {
+	now := rl.now()
+	for name, cl := range rl.clients {
+		cl.refill(now)
+		if cl.streams == 0 && cl.tokens >= float64(cl.limit.burst()) {
+			delete(rl.clients, name)
+		}
+	}
+}
+
+func (cl *clientLimiter) refill(now time.Time) {
+	elapsed := now.Sub(cl.updated).Seconds()
+	cl.updated = now
+	cl.tokens += elapsed * cl.limit.RequestsPerSecond
+	if max := float64(cl.limit.burst()); cl.tokens > max {
+		cl.tokens = max
+	}
+}
+
+// We always allow at least one call
+func (limit *RateLimit) burst() int {
+	if limit.Burst < 1 {
+		return 1
+	}
+	return limit.Burst
+}
+
+func rateExceededError(client string) error {
+	return status.Errorf(codes.ResourceExhausted, "client %s has exceeded its request rate", client)
+}
+
+// Clients authenticated by the Authorizer are identified by name (matching the names in RateLimitConfig.Clients),
+// otherwise we fall back to the IP of the peer
+func clientIdentity(ctx context.Context) string {
+	if client := ClientFromContext(ctx); client != nil && client.Name != AnonymousClient {
+		return client.Name
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return hostOf(p.Addr.String())
+	}
+	return ""
+}
+
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
@@ -0,0 +1,141 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func testRateLimiter(now *time.Time) *RateLimiter {
+	rl := NewRateLimiter(&RateLimitConfig{
+		RateLimit: RateLimit{RequestsPerSecond: 1, Burst: 2, MaxConcurrentStreams: 1},
+		Clients: map[string]*RateLimit{
+			"reader": {RequestsPerSecond: 10, Burst: 10},
+		},
+	})
+	rl.now = func() time.Time {
+		return *now
+	}
+	return rl
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	rl := NewRateLimiter(nil)
+	assert.Nil(t, rl)
+	assert.Equal(t, RateLimitRejections{}, rl.Rejections())
+	w := httptest.NewRecorder()
+	rl.HTTPHandler(http.NotFoundHandler()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	rl := testRateLimiter(&now)
+
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.False(t, rl.Allow("10.0.0.1"))
+	// Other clients have their own buckets
+	assert.True(t, rl.Allow("10.0.0.2"))
+
+	now = now.Add(time.Second)
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.False(t, rl.Allow("10.0.0.1"))
+
+	// Refill is capped at the burst
+	now = now.Add(time.Minute)
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.False(t, rl.Allow("10.0.0.1"))
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, rl.Allow("reader"))
+	}
+	assert.False(t, rl.Allow("reader"))
+
+	assert.Equal(t, RateLimitRejections{RateExceeded: 4}, rl.Rejections())
+}
+
+func TestRateLimiter_OpenStream(t *testing.T) {
+	now := time.Now()
+	rl := testRateLimiter(&now)
+
+	release, err := rl.OpenStream("10.0.0.1")
+	require.NoError(t, err)
+	_, err = rl.OpenStream("10.0.0.1")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	release()
+	// Releasing twice must not free a second slot
+	release()
+	_, err = rl.OpenStream("10.0.0.1")
+	// Out of tokens now
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	now = now.Add(time.Second)
+	release, err = rl.OpenStream("10.0.0.1")
+	require.NoError(t, err)
+	defer release()
+
+	// No stream limit for reader
+	for i := 0; i < 3; i++ {
+		_, err = rl.OpenStream("reader")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, RateLimitRejections{RateExceeded: 1, StreamsExceeded: 1}, rl.Rejections())
+}
+
+func TestRateLimiter_UnaryInterceptor(t *testing.T) {
+	now := time.Now()
+	rl := testRateLimiter(&now)
+	unary, _ := Interceptors(logging.NewNoopLogger(), testAuthorizer(t), rl)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcquery.Query/Status"}
+	anonymous := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1)}})
+	reader := metadata.NewIncomingContext(anonymous, metadata.Pairs(AuthorizationHeader, "Bearer read-token"))
+
+	for i := 0; i < 2; i++ {
+		_, err := unary(anonymous, nil, info, handler)
+		require.NoError(t, err)
+	}
+	_, err := unary(anonymous, nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Authenticated clients are limited by name rather than address
+	_, err = unary(reader, nil, info, handler)
+	require.NoError(t, err)
+}
+
+func TestRateLimiter_HTTPHandler(t *testing.T) {
+	now := time.Now()
+	rl := testRateLimiter(&now)
+	handler := rl.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	request := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:1234"))
+	// Same host, different port
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:4321"))
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.1:1234"))
+	assert.Equal(t, http.StatusOK, request("10.0.0.2:1234"))
+}
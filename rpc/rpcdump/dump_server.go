@@ -1,6 +1,8 @@
 package rpcdump
 
 import (
+	"context"
+
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/dump"
 	"github.com/hyperledger/burrow/execution/state"
@@ -21,5 +23,9 @@ func NewDumpServer(state *state.State, blockchain bcm.BlockchainInfo, logger *lo
 }
 
 func (ds *dumpServer) GetDump(param *GetDumpParam, stream Dump_GetDumpServer) error {
-	return ds.dumper.Transmit(stream, 0, param.Height, dump.All)
+	return ds.dumper.Transmit(stream, param.StartHeight, param.Height, dump.All, param.Filter)
+}
+
+func (ds *dumpServer) GetManifest(ctx context.Context, param *GetDumpParam) (*dump.Manifest, error) {
+	return ds.dumper.Manifest(param.StartHeight, param.Height, dump.All, param.Filter)
 }
@@ -12,7 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	golang_proto "github.com/golang/protobuf/proto"
-	_ "github.com/hyperledger/burrow/dump"
+	dump "github.com/hyperledger/burrow/dump"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,10 +28,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetDumpParam struct {
-	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Only dump events from this height
+	StartHeight          uint64       `protobuf:"varint,2,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	Filter               *dump.Filter `protobuf:"bytes,3,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *GetDumpParam) Reset()         { *m = GetDumpParam{} }
@@ -70,6 +73,20 @@ func (m *GetDumpParam) GetHeight() uint64 {
 	return 0
 }
 
+func (m *GetDumpParam) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetDumpParam) GetFilter() *dump.Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 func (*GetDumpParam) XXX_MessageName() string {
 	return "rpcdump.GetDumpParam"
 }
@@ -82,20 +99,23 @@ func init() { proto.RegisterFile("rpcdump.proto", fileDescriptor_80c0fd6a8168e01
 func init() { golang_proto.RegisterFile("rpcdump.proto", fileDescriptor_80c0fd6a8168e015) }
 
 var fileDescriptor_80c0fd6a8168e015 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
+	// 255 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x2a, 0x48, 0x4e,
 	0x29, 0xcd, 0x2d, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x72, 0xa5, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x62, 0xfa, 0x20, 0x16, 0x44, 0x5a, 0x8a, 0x0b, 0xa1, 0x54, 0x49, 0x8d,
+	0xf3, 0xd3, 0xf3, 0xc1, 0x62, 0xfa, 0x20, 0x16, 0x44, 0x5a, 0x8a, 0x0b, 0xa1, 0x54, 0x29, 0x8f,
 	0x8b, 0xc7, 0x3d, 0xb5, 0xc4, 0xa5, 0x34, 0xb7, 0x20, 0x20, 0xb1, 0x28, 0x31, 0x57, 0x48, 0x8c,
 	0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x08, 0xca,
-	0x33, 0x32, 0xe3, 0x62, 0x01, 0x29, 0x12, 0xd2, 0xe3, 0x62, 0x87, 0xaa, 0x17, 0x12, 0xd5, 0x83,
-	0xd9, 0x8a, 0x6c, 0x82, 0x14, 0x97, 0x1e, 0x58, 0x0c, 0x24, 0x60, 0xc0, 0xe8, 0xe4, 0x7c, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x37, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x78,
-	0xe0, 0xb1, 0x1c, 0xe3, 0x89, 0xc7, 0x72, 0x8c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x19, 0x95, 0x05, 0xa9, 0x45, 0x39, 0xa9, 0x29, 0xe9, 0xa9, 0x45,
-	0xfa, 0x49, 0xa5, 0x45, 0x45, 0xf9, 0xe5, 0xfa, 0x45, 0x05, 0xc9, 0xfa, 0x50, 0xf3, 0x93, 0xd8,
-	0xc0, 0x6e, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x62, 0x82, 0xa1, 0xe7, 0x00, 0x00,
-	0x00,
+	0x13, 0x52, 0xe0, 0xe2, 0x0e, 0x2e, 0x49, 0x2c, 0x2a, 0xf1, 0x80, 0x48, 0x32, 0x81, 0x25, 0x91,
+	0x85, 0x84, 0x54, 0xb8, 0xd8, 0xdc, 0x32, 0x73, 0x4a, 0x52, 0x8b, 0x24, 0x98, 0x15, 0x18, 0x35,
+	0xb8, 0x8d, 0x78, 0xf4, 0xc0, 0xd6, 0x40, 0xc4, 0x82, 0xa0, 0x72, 0x46, 0x39, 0x5c, 0x2c, 0x20,
+	0xcb, 0x84, 0xf4, 0xb8, 0xd8, 0xa1, 0xf6, 0x0a, 0x89, 0xea, 0xc1, 0x5c, 0x8f, 0xec, 0x12, 0x29,
+	0x2e, 0x88, 0x7e, 0x90, 0x80, 0x01, 0xa3, 0x90, 0x09, 0x17, 0xb7, 0x7b, 0x6a, 0x89, 0x6f, 0x62,
+	0x5e, 0x66, 0x5a, 0x6a, 0x71, 0x09, 0x2e, 0x3d, 0x7c, 0x10, 0x3d, 0x30, 0x65, 0x4e, 0xce, 0x27,
+	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x78, 0xe3, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
+	0x07, 0x1e, 0xcb, 0x31, 0x9e, 0x78, 0x2c, 0xc7, 0x18, 0xa5, 0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a,
+	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x51, 0x59, 0x90, 0x5a, 0x94, 0x93, 0x9a, 0x92, 0x9e, 0x5a,
+	0xa4, 0x9f, 0x54, 0x5a, 0x54, 0x94, 0x5f, 0xae, 0x5f, 0x54, 0x90, 0xac, 0x0f, 0xb5, 0x21, 0x89,
+	0x0d, 0x1c, 0x52, 0xc6, 0x80, 0x01, 0x00, 0x40, 0xab, 0xc5, 0x59, 0x65, 0x01, 0x00, 0x00,
 }
 
 func (m *GetDumpParam) Marshal() (dAtA []byte, err error) {
@@ -122,6 +142,23 @@ func (m *GetDumpParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcdump(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRpcdump(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Height != 0 {
 		i = encodeVarintRpcdump(dAtA, i, uint64(m.Height))
 		i--
@@ -150,6 +187,13 @@ func (m *GetDumpParam) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovRpcdump(uint64(m.Height))
 	}
+	if m.StartHeight != 0 {
+		n += 1 + sovRpcdump(uint64(m.StartHeight))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovRpcdump(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -210,6 +254,61 @@ func (m *GetDumpParam) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcdump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcdump
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcdump
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcdump
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &dump.Filter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcdump(dAtA[iNdEx:])
@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DumpClient interface {
 	GetDump(ctx context.Context, in *GetDumpParam, opts ...grpc.CallOption) (Dump_GetDumpClient, error)
+	// GetManifest describes the dump GetDump would send, pass its EndHeight to GetDump to dump exactly that height
+	GetManifest(ctx context.Context, in *GetDumpParam, opts ...grpc.CallOption) (*dump.Manifest, error)
 }
 
 type dumpClient struct {
@@ -62,11 +64,22 @@ func (x *dumpGetDumpClient) Recv() (*dump.Dump, error) {
 	return m, nil
 }
 
+func (c *dumpClient) GetManifest(ctx context.Context, in *GetDumpParam, opts ...grpc.CallOption) (*dump.Manifest, error) {
+	out := new(dump.Manifest)
+	err := c.cc.Invoke(ctx, "/rpcdump.Dump/GetManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DumpServer is the server API for Dump service.
 // All implementations must embed UnimplementedDumpServer
 // for forward compatibility
 type DumpServer interface {
 	GetDump(*GetDumpParam, Dump_GetDumpServer) error
+	// GetManifest describes the dump GetDump would send, pass its EndHeight to GetDump to dump exactly that height
+	GetManifest(context.Context, *GetDumpParam) (*dump.Manifest, error)
 	mustEmbedUnimplementedDumpServer()
 }
 
@@ -77,6 +90,9 @@ type UnimplementedDumpServer struct {
 func (UnimplementedDumpServer) GetDump(*GetDumpParam, Dump_GetDumpServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetDump not implemented")
 }
+func (UnimplementedDumpServer) GetManifest(context.Context, *GetDumpParam) (*dump.Manifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManifest not implemented")
+}
 func (UnimplementedDumpServer) mustEmbedUnimplementedDumpServer() {}
 
 // UnsafeDumpServer may be embedded to opt out of forward compatibility for this service.
@@ -111,10 +127,33 @@ func (x *dumpGetDumpServer) Send(m *dump.Dump) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Dump_GetManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDumpParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DumpServer).GetManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcdump.Dump/GetManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DumpServer).GetManifest(ctx, req.(*GetDumpParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dump_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcdump.Dump",
 	HandlerType: (*DumpServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetManifest",
+			Handler:    _Dump_GetManifest_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetDump",
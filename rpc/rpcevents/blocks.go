@@ -1,7 +1,10 @@
 package rpcevents
 
 import (
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/exec"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Get bounds suitable for events.Provider
@@ -72,13 +75,34 @@ func SingleBlock(height uint64) *BlockRange {
 	return AbsoluteRange(height, height+1)
 }
 
+// Returns the cursor after which to resume or nil if the request does not resume from a cursor
+func (br *BlocksRequest) AfterCursor() (*exec.StreamCursor, error) {
+	if len(br.After) == 0 {
+		return nil, nil
+	}
+	cursor, err := exec.DecodeStreamCursor(br.After)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &cursor, nil
+}
+
 func ConsumeBlockExecutions(stream ExecutionEvents_StreamClient, consumer func(*exec.BlockExecution) error,
 	continuityOptions ...exec.ContinuityOpt) error {
+	return ConsumeBlockExecutionsWithCursor(stream, func(be *exec.BlockExecution, cursor binary.HexBytes) error {
+		return consumer(be)
+	}, continuityOptions...)
+}
+
+// ConsumeBlockExecutionsWithCursor also passes the cursor of the end of each block to consumer. Passing the cursor of
+// the last block processed as BlocksRequest.After resumes the stream with the next block.
+func ConsumeBlockExecutionsWithCursor(stream ExecutionEvents_StreamClient,
+	consumer func(be *exec.BlockExecution, cursor binary.HexBytes) error, continuityOptions ...exec.ContinuityOpt) error {
 	var be *exec.BlockExecution
 	var err error
 	ba := exec.NewBlockAccumulator(continuityOptions...)
 	for be, err = ba.ConsumeBlockExecution(stream); err == nil; be, err = ba.ConsumeBlockExecution(stream) {
-		err = consumer(be)
+		err = consumer(be, ba.Cursor())
 		if err != nil {
 			return err
 		}
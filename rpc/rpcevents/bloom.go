@@ -0,0 +1,73 @@
+package rpcevents
+
+import (
+	"encoding/hex"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Returns a predicate that is false for the logs bloom of any block that cannot contain an event matching qry, or nil
+// if qry does not require any LogEvent address or topic. Address conditions are only used when matchAddress is set
+// since other tagged values (e.g. a CallTx envelope) may also carry an Address.
+func bloomFilter(qry query.Query, matchAddress bool) func(*exec.Bloom) bool {
+	pq, ok := qry.(*query.PegQuery)
+	if !ok {
+		return nil
+	}
+	var addresses []crypto.Address
+	var topics []binary.Word256
+	for _, cond := range pq.RequiredEqualities() {
+		operand, _ := cond.Operand.(string)
+		if cond.Tag == event.AddressKey {
+			if !matchAddress {
+				continue
+			}
+			address, err := crypto.AddressFromHexString(operand)
+			if err == nil {
+				addresses = append(addresses, address)
+			}
+			continue
+		}
+		if !isLogNKey(cond.Tag) {
+			continue
+		}
+		bs, err := hex.DecodeString(operand)
+		// A LogEvent with fewer topics reports the zero word for those it is missing, which is never added to a bloom
+		if err != nil || len(bs) != binary.Word256Bytes {
+			continue
+		}
+		topic := binary.LeftPadWord256(bs)
+		if topic != binary.Zero256 {
+			topics = append(topics, topic)
+		}
+	}
+	if len(addresses) == 0 && len(topics) == 0 {
+		return nil
+	}
+	return func(bloom *exec.Bloom) bool {
+		for _, address := range addresses {
+			if !bloom.TestAddress(address) {
+				return false
+			}
+		}
+		for _, topic := range topics {
+			if !bloom.TestTopic(topic) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func isLogNKey(tag string) bool {
+	for i := 0; i <= 4; i++ {
+		if tag == exec.LogNKey(i) {
+			return true
+		}
+	}
+	return false
+}
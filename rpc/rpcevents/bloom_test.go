@@ -0,0 +1,40 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	contract := crypto.Address{1, 2, 3}
+	topic := binary.LeftPadWord256([]byte("Transfer"))
+	bloom := new(exec.Bloom)
+	bloom.AddLog(&exec.LogEvent{Address: contract, Topics: []binary.Word256{topic}})
+
+	qry := query.NewBuilder().AndEquals("Address", contract).AndEquals(exec.LogNKey(0), topic.String())
+	mayMatch := bloomFilter(mustQuery(t, qry), true)
+	require.NotNil(t, mayMatch)
+	assert.True(t, mayMatch(bloom))
+	assert.False(t, mayMatch(new(exec.Bloom)))
+
+	other := query.NewBuilder().AndEquals("Address", crypto.Address{4})
+	assert.False(t, bloomFilter(mustQuery(t, other), true)(bloom))
+	// Address alone cannot rule out a block when envelopes are also matched
+	assert.Nil(t, bloomFilter(mustQuery(t, other), false))
+	// Nor can a missing topic
+	missing := query.NewBuilder().AndEquals(exec.LogNKey(3), binary.Zero256.String())
+	assert.Nil(t, bloomFilter(mustQuery(t, missing), true))
+	assert.Nil(t, bloomFilter(query.Empty{}, true))
+}
+
+func mustQuery(t *testing.T, qb *query.Builder) query.Query {
+	qry, err := qb.Query()
+	require.NoError(t, err)
+	return qry
+}
@@ -0,0 +1,94 @@
+package rpcevents
+
+import (
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+)
+
+// Decodes LogEvents with the ABI found in the metadata of the contract that emitted them. ABIs are cached by address
+// for the lifetime of the decoder (i.e. a single request).
+type eventDecoder struct {
+	state  rpcquery.MetadataGetter
+	specs  map[crypto.Address]*abi.Spec
+	logger *logging.Logger
+}
+
+func newEventDecoder(state rpcquery.MetadataGetter, logger *logging.Logger) *eventDecoder {
+	return &eventDecoder{
+		state:  state,
+		specs:  make(map[crypto.Address]*abi.Spec),
+		logger: logger,
+	}
+}
+
+// Returns a copy of ev with the decoded log attached, or ev itself if it is not a LogEvent or cannot be decoded. We
+// must not modify ev since it may be shared with other subscribers.
+func (dec *eventDecoder) Event(ev *exec.Event) *exec.Event {
+	if dec == nil || ev.Log == nil || len(ev.Log.Topics) == 0 {
+		return ev
+	}
+	decoded := dec.decode(ev.Log)
+	if decoded == nil {
+		return ev
+	}
+	log := *ev.Log
+	log.Decoded = decoded
+	evCopy := *ev
+	evCopy.Log = &log
+	return &evCopy
+}
+
+func (dec *eventDecoder) StreamEvent(sev *exec.StreamEvent) *exec.StreamEvent {
+	if dec == nil || sev.Event == nil {
+		return sev
+	}
+	ev := dec.Event(sev.Event)
+	if ev == sev.Event {
+		return sev
+	}
+	sevCopy := *sev
+	sevCopy.Event = ev
+	return &sevCopy
+}
+
+func (dec *eventDecoder) decode(log *exec.LogEvent) *exec.DecodedLog {
+	spec, err := dec.spec(log.Address)
+	if err != nil {
+		dec.logger.TraceMsg("Could not get ABI for LogEvent", "address", log.Address, "error", err)
+		return nil
+	}
+	eventSpec, ok := spec.EventsByID[log.SolidityEventID()]
+	if !ok {
+		return nil
+	}
+	decoded, err := exec.DecodeLog(eventSpec, log)
+	if err != nil {
+		dec.logger.TraceMsg("Could not decode LogEvent", "address", log.Address, "error", err)
+		return nil
+	}
+	return decoded
+}
+
+// Contracts without metadata get an empty spec so we only look them up once
+func (dec *eventDecoder) spec(address crypto.Address) (*abi.Spec, error) {
+	if spec, ok := dec.specs[address]; ok {
+		return spec, nil
+	}
+	spec := abi.NewSpec()
+	metadata, err := rpcquery.GetContractMetadata(dec.state, address)
+	if err != nil {
+		return nil, err
+	}
+	if metadata != "" {
+		spec, err = abi.ReadSpec([]byte(metadata))
+		if err != nil {
+			dec.specs[address] = abi.NewSpec()
+			return nil, err
+		}
+	}
+	dec.specs[address] = spec
+	return spec, nil
+}
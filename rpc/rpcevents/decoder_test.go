@@ -0,0 +1,67 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferAbi = `[{"anonymous":false,"name":"Transfer","type":"event","inputs":[
+{"indexed":true,"name":"from","type":"address"},
+{"indexed":false,"name":"amount","type":"uint256"},
+{"indexed":false,"name":"memo","type":"string"}]}]`
+
+func TestEventDecoder(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	contract := crypto.Address{1}
+	codeHash := []byte{1, 2, 3}
+	metadataHash := acmstate.GetMetadataHash(transferAbi)
+	require.NoError(t, st.SetMetadata(metadataHash, transferAbi))
+	require.NoError(t, st.UpdateAccount(&acm.Account{
+		Address:      contract,
+		CodeHash:     codeHash,
+		ContractMeta: []*acm.ContractMeta{{CodeHash: codeHash, MetadataHash: metadataHash.Bytes()}},
+	}))
+	unknown := crypto.Address{2}
+	require.NoError(t, st.UpdateAccount(&acm.Account{Address: unknown}))
+
+	spec, err := abi.ReadSpec([]byte(transferAbi))
+	require.NoError(t, err)
+	from := crypto.Address{3}
+	topics, data, err := abi.PackEvent(spec.EventsByName["Transfer"], from, uint64(1000), "hello")
+	require.NoError(t, err)
+
+	dec := newEventDecoder(st, logging.NewNoopLogger())
+
+	ev := &exec.Event{Log: &exec.LogEvent{Address: contract, Topics: topics, Data: data}}
+	decoded := dec.Event(ev)
+	// Original event must be untouched
+	assert.Nil(t, ev.Log.Decoded)
+	require.NotNil(t, decoded.Log.Decoded)
+	assert.Equal(t, "Transfer", decoded.Log.Decoded.Name)
+	assert.Equal(t, []*exec.DecodedArgument{
+		{Name: "from", Type: "address", Indexed: true, JSON: `"` + from.String() + `"`},
+		{Name: "amount", Type: "uint256", JSON: `"1000"`},
+		{Name: "memo", Type: "string", JSON: `"hello"`},
+	}, decoded.Log.Decoded.Arguments)
+
+	// Falls back to the undecoded event
+	ev = &exec.Event{Log: &exec.LogEvent{Address: unknown, Topics: topics, Data: data}}
+	assert.Equal(t, ev, dec.Event(ev))
+	ev = &exec.Event{Log: &exec.LogEvent{Address: contract}}
+	assert.Equal(t, ev, dec.Event(ev))
+	ev = &exec.Event{Input: &exec.InputEvent{Address: contract}}
+	assert.Equal(t, ev, dec.Event(ev))
+
+	// Not decoding
+	dec = nil
+	sev := &exec.StreamEvent{Event: &exec.Event{Log: &exec.LogEvent{Address: contract, Topics: topics, Data: data}}}
+	assert.Equal(t, sev, dec.StreamEvent(sev))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/storage"
 )
 
@@ -19,6 +20,9 @@ type Provider interface {
 	// Get transactions
 	IterateStreamEvents(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
 		consumer func(*exec.StreamEvent) error) (err error)
+	// Get transactions from blocks whose logs bloom may match
+	IterateStreamEventsMatching(startHeight, endHeight *uint64, sortOrder storage.SortOrder,
+		mayMatch func(height uint64, bloom *exec.Bloom) bool, consumer func(*exec.StreamEvent) error) (err error)
 	// Get a particular TxExecution by hash
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
 }
@@ -26,16 +30,19 @@ type Provider interface {
 type executionEventsServer struct {
 	UnimplementedExecutionEventsServer
 	eventsProvider Provider
+	metadata       rpcquery.MetadataGetter
 	emitter        *event.Emitter
 	tip            bcm.BlockchainInfo
 	logger         *logging.Logger
 }
 
-func NewExecutionEventsServer(eventsProvider Provider, emitter *event.Emitter,
+// Contract metadata is read from metadata to decode events when requested
+func NewExecutionEventsServer(eventsProvider Provider, metadata rpcquery.MetadataGetter, emitter *event.Emitter,
 	tip bcm.BlockchainInfo, logger *logging.Logger) ExecutionEventsServer {
 
 	return &executionEventsServer{
 		eventsProvider: eventsProvider,
+		metadata:       metadata,
 		emitter:        emitter,
 		tip:            tip,
 		logger:         logger.WithScope("NewExecutionEventsServer"),
@@ -75,12 +82,23 @@ func (ees *executionEventsServer) Stream(request *BlocksRequest, stream Executio
 	if err != nil {
 		return fmt.Errorf("could not parse TxExecution query: %v", err)
 	}
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(ev *exec.StreamEvent) error {
-		if qry.Matches(ev) {
-			return stream.Send(ev)
-		}
-		return nil
-	})
+	after, err := request.AfterCursor()
+	if err != nil {
+		return err
+	}
+	decoder := ees.decoder(request)
+	// Transaction envelopes also carry an Address tag so only topics tell us a block cannot match
+	mayMatch := bloomFilter(qry, false)
+	return ees.streamEvents(stream.Context(), request.BlockRange, after, mayMatch,
+		func(ev *exec.StreamEvent, cursor exec.StreamCursor) error {
+			if after != nil && !cursor.After(*after) {
+				return nil
+			}
+			if qry.Matches(ev) {
+				return stream.Send(decoder.StreamEvent(ev.WithCursor(cursor)))
+			}
+			return nil
+		})
 }
 
 func (ees *executionEventsServer) Events(request *BlocksRequest, stream ExecutionEvents_EventsServer) error {
@@ -89,9 +107,19 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	if err != nil {
 		return fmt.Errorf("could not parse Event query: %v", err)
 	}
+	after, err := request.AfterCursor()
+	if err != nil {
+		return err
+	}
 	var response *EventsResponse
 	var stack exec.TxStack
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(sev *exec.StreamEvent) error {
+	// When resuming mid-block we must still consume the whole block to rebuild its transactions but drop the events
+	// already sent
+	sent := make(map[*exec.Event]bool)
+	decoder := ees.decoder(request)
+	mayMatch := bloomFilter(qry, true)
+	return ees.streamEvents(stream.Context(), request.BlockRange, after, mayMatch, func(sev *exec.StreamEvent,
+		cursor exec.StreamCursor) error {
 		switch {
 		case sev.BeginBlock != nil:
 			response = &EventsResponse{
@@ -99,9 +127,20 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 			}
 
 		case sev.EndBlock != nil && len(response.Events) > 0:
+			response.Cursor = cursor.Bytes()
 			return stream.Send(response)
 
 		default:
+			if sev.Event != nil && after != nil && !cursor.After(*after) {
+				sent[sev.Event] = true
+			}
+			if sev.Event != nil && stack.Length() == 0 {
+				// Events emitted by the block itself rather than by a transaction
+				if !sent[sev.Event] && qry.Matches(sev.Event) {
+					response.Events = append(response.Events, decoder.Event(sev.Event))
+				}
+				return nil
+			}
 			// We need to consume transaction to exclude events belong to an exceptional transaction
 			txe, err := stack.Consume(sev)
 			if err != nil {
@@ -109,8 +148,8 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 			}
 			if txe != nil && txe.Exception == nil {
 				for _, ev := range txe.Events {
-					if qry.Matches(ev) {
-						response.Events = append(response.Events, ev)
+					if !sent[ev] && qry.Matches(ev) {
+						response.Events = append(response.Events, decoder.Event(ev))
 					}
 				}
 			}
@@ -120,15 +159,32 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	})
 }
 
-func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange,
-	consumer func(execution *exec.StreamEvent) error) error {
+// Returns nil unless the request asks for events to be decoded
+func (ees *executionEventsServer) decoder(request *BlocksRequest) *eventDecoder {
+	if !request.DecodeEvents || ees.metadata == nil {
+		return nil
+	}
+	return newEventDecoder(ees.metadata, ees.logger)
+}
+
+// Streams whole blocks, starting from the block containing after if it is non-nil, passing each event's cursor to
+// consumer. Blocks whose logs bloom fails mayMatch (if non-nil) are skipped entirely.
+func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange, after *exec.StreamCursor,
+	mayMatch func(*exec.Bloom) bool, consumer func(ev *exec.StreamEvent, cursor exec.StreamCursor) error) error {
 
 	start, end, streaming := blockRange.Bounds(ees.tip.LastBlockHeight())
+	if after != nil {
+		start = after.Height
+	}
 	ees.logger.TraceMsg("Streaming blocks", "start", start, "end", end, "streaming", streaming)
+	var tracker exec.StreamCursorTracker
+	consume := func(ev *exec.StreamEvent) error {
+		return consumer(ev, tracker.Next(ev))
+	}
 
 	// Pull blocks from state and receive the upper bound (exclusive) on the what we were able to send
 	// Set this to start since it will be the start of next streaming batch (if needed)
-	start, err := ees.iterateStreamEvents(start, end, consumer)
+	start, err := ees.iterateStreamEvents(start, end, mayMatch, consume)
 
 	// If we are not streaming and all blocks requested were retrieved from state then we are done
 	if !streaming && start > end {
@@ -150,7 +206,7 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 			if catchupEnd > end {
 				catchupEnd = end
 			}
-			start, err = ees.iterateStreamEvents(start, catchupEnd, consumer)
+			start, err = ees.iterateStreamEvents(start, catchupEnd, mayMatch, consume)
 			if err != nil {
 				return err
 			}
@@ -159,14 +215,17 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 		if finished {
 			return io.EOF
 		}
+		// We've just streamed block so our next start marker is the next block
+		start = block.Height + 1
+		if mayMatch != nil && !mayMatch(block.Bloom()) {
+			return nil
+		}
 		for _, ev := range block.StreamEvents() {
-			err = consumer(ev)
+			err = consume(ev)
 			if err != nil {
 				return err
 			}
 		}
-		// We've just streamed block so our next start marker is the next block
-		start = block.Height + 1
 		return nil
 	})
 }
@@ -201,13 +260,24 @@ func (ees *executionEventsServer) subscribeBlockExecution(ctx context.Context,
 	return nil
 }
 
-func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64,
+func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64, mayMatch func(*exec.Bloom) bool,
 	consumer func(*exec.StreamEvent) error) (uint64, error) {
 	// Assume that we have seen the previous block before start to have ended up here
 	// NOTE: this will underflow when start is 0 (as it often will be - and needs to be for restored chains)
 	// however we at most underflow by 1 and we always add 1 back on when returning so we get away with this.
 	lastHeightSeen := startHeight - 1
-	err := ees.eventsProvider.IterateStreamEvents(&startHeight, &endHeight, storage.AscendingSort,
+	var mayMatchHeight func(height uint64, bloom *exec.Bloom) bool
+	if mayMatch != nil {
+		mayMatchHeight = func(height uint64, bloom *exec.Bloom) bool {
+			if mayMatch(bloom) {
+				return true
+			}
+			// We have still seen this block
+			lastHeightSeen = height
+			return false
+		}
+	}
+	err := ees.eventsProvider.IterateStreamEventsMatching(&startHeight, &endHeight, storage.AscendingSort, mayMatchHeight,
 		func(ev *exec.StreamEvent) error {
 			if ev.EndBlock != nil {
 				lastHeightSeen = ev.EndBlock.GetHeight()
@@ -211,10 +211,16 @@ type BlocksRequest struct {
 	//
 	// For example:
 	// EventType = 'LogEvent' AND EventID CONTAINS 'bar' AND TxHash = '020304' AND Height >= 34 AND Index < 3 AND Address = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
-	Query                string   `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Query string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Attach the event name and arguments to LogEvents, decoded with the ABI registered in the emitting contract's
+	// metadata. Events from contracts with no known ABI are sent undecoded.
+	DecodeEvents bool `protobuf:"varint,3,opt,name=DecodeEvents,proto3" json:"DecodeEvents,omitempty"`
+	// Resume after the StreamEvent with this cursor (from StreamEvent.Cursor or EventsResponse.Cursor), the block range
+	// start is ignored
+	After                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=After,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"After"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *BlocksRequest) Reset()         { *m = BlocksRequest{} }
@@ -260,16 +266,25 @@ func (m *BlocksRequest) GetQuery() string {
 	return ""
 }
 
+func (m *BlocksRequest) GetDecodeEvents() bool {
+	if m != nil {
+		return m.DecodeEvents
+	}
+	return false
+}
+
 func (*BlocksRequest) XXX_MessageName() string {
 	return "rpcevents.BlocksRequest"
 }
 
 type EventsResponse struct {
-	Height               uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Events               []*exec.Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Height uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Events []*exec.Event `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+	// Cursor of the end of this block (pass as BlocksRequest.After to resume after this block)
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
@@ -577,44 +592,46 @@ func init() { proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd6
 func init() { golang_proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
 
 var fileDescriptor_580b21d8d2fd68e4 = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6b, 0xd4, 0x40,
-	0x14, 0xed, 0xec, 0x17, 0xcd, 0xdd, 0x7e, 0xc4, 0xa1, 0xca, 0xba, 0x48, 0xba, 0x44, 0x90, 0x82,
-	0x34, 0x5b, 0x56, 0x8a, 0x4f, 0x22, 0x1b, 0x88, 0x6d, 0xa5, 0x45, 0x9c, 0x8c, 0x1f, 0x88, 0x20,
-	0xd9, 0xec, 0x90, 0x5d, 0x6c, 0x93, 0x98, 0x4c, 0x34, 0xfb, 0x53, 0xfc, 0x2b, 0x3e, 0xf9, 0xd8,
-	0x47, 0x1f, 0xc5, 0x87, 0x22, 0xdb, 0x3f, 0x22, 0x99, 0x49, 0xb2, 0xd9, 0x5a, 0xeb, 0xcb, 0x32,
-	0xf7, 0x9e, 0x73, 0xef, 0x3d, 0x7b, 0xe6, 0x4e, 0x60, 0x33, 0x0a, 0x5d, 0xf6, 0x99, 0xf9, 0x3c,
-	0x36, 0xc2, 0x28, 0xe0, 0x01, 0x56, 0xca, 0x44, 0x77, 0xcb, 0x0b, 0xbc, 0x40, 0x64, 0xfb, 0xd9,
-	0x49, 0x12, 0xba, 0xc0, 0x52, 0xe6, 0xca, 0xb3, 0xfe, 0x04, 0x36, 0x0f, 0x18, 0x37, 0x4f, 0x03,
-	0xf7, 0x23, 0x61, 0x9f, 0x12, 0x16, 0x73, 0x7c, 0x07, 0x5a, 0x87, 0x6c, 0xea, 0x4d, 0x78, 0x07,
-	0xf5, 0xd0, 0x4e, 0x83, 0xe4, 0x11, 0xc6, 0xd0, 0x78, 0xe3, 0x4c, 0x79, 0xa7, 0xd6, 0x43, 0x3b,
-	0xab, 0x44, 0x9c, 0x75, 0x1f, 0x14, 0x9a, 0x16, 0x85, 0x27, 0xd0, 0xa2, 0xe9, 0xa1, 0x13, 0x4f,
-	0x44, 0xe1, 0x9a, 0xb9, 0x7f, 0x7e, 0xb1, 0xbd, 0xf2, 0xeb, 0x62, 0x7b, 0xd7, 0x9b, 0xf2, 0x49,
-	0x32, 0x32, 0xdc, 0xe0, 0xac, 0x3f, 0x99, 0x85, 0x2c, 0x3a, 0x65, 0x63, 0x8f, 0x45, 0xfd, 0x51,
-	0x12, 0x45, 0xc1, 0x97, 0xfe, 0x68, 0xea, 0x3b, 0xd1, 0xcc, 0x38, 0x64, 0xa9, 0x39, 0xe3, 0x2c,
-	0x26, 0x79, 0x93, 0x6b, 0xe7, 0xbd, 0x87, 0x75, 0xa1, 0x35, 0x2e, 0x66, 0xee, 0x03, 0x48, 0xf1,
-	0x8e, 0xef, 0x31, 0x31, 0xb7, 0x3d, 0xb8, 0x6d, 0x2c, 0x2c, 0x59, 0x80, 0xa4, 0x42, 0xc4, 0x5b,
-	0xd0, 0x7c, 0x99, 0xb0, 0x68, 0x26, 0x9a, 0x2b, 0x44, 0x06, 0xfa, 0x09, 0x6c, 0x58, 0xa2, 0x8c,
-	0xb0, 0x38, 0x0c, 0xfc, 0x98, 0xfd, 0xd3, 0x8b, 0xfb, 0xd0, 0x92, 0xcc, 0x4e, 0xad, 0x57, 0xdf,
-	0x69, 0x0f, 0xda, 0x86, 0xf0, 0x54, 0xe4, 0x48, 0x0e, 0xe9, 0x0c, 0xd6, 0x0f, 0x18, 0xa7, 0x69,
-	0x29, 0xb6, 0x07, 0x6d, 0x9b, 0x3b, 0x11, 0x5f, 0x6a, 0x59, 0x4d, 0xe1, 0x7b, 0xa0, 0x58, 0xfe,
-	0x38, 0xc7, 0x6b, 0x02, 0x5f, 0x24, 0x16, 0xaa, 0xeb, 0x55, 0xd5, 0x1f, 0x60, 0xa3, 0x18, 0xf3,
-	0x1f, 0xd5, 0xfb, 0xb0, 0x46, 0x53, 0x2b, 0x65, 0x6e, 0xc2, 0xa7, 0x81, 0x5f, 0x68, 0xbf, 0x25,
-	0xb5, 0x57, 0x10, 0xb2, 0x44, 0xd3, 0xbf, 0x22, 0x68, 0x9a, 0x41, 0xe2, 0x8f, 0xb1, 0x01, 0x0d,
-	0x3a, 0x0b, 0xa5, 0xcf, 0x1b, 0x83, 0x6e, 0xd5, 0xe7, 0x0c, 0x97, 0xbf, 0x19, 0x83, 0x08, 0x5e,
-	0x26, 0xf8, 0xc8, 0x1f, 0xb3, 0x34, 0xff, 0x2b, 0x32, 0xd0, 0x9f, 0x83, 0x52, 0x12, 0xf1, 0x1a,
-	0xac, 0x0e, 0x4d, 0xfb, 0xc5, 0xf1, 0x2b, 0x6a, 0xa9, 0x2b, 0x59, 0x44, 0xac, 0xe3, 0x21, 0x3d,
-	0x7a, 0x6d, 0xa9, 0x08, 0x2b, 0xd0, 0x7c, 0x76, 0x44, 0x6c, 0xaa, 0xd6, 0x30, 0x40, 0xeb, 0x78,
-	0x48, 0x2d, 0x9b, 0xaa, 0xf5, 0xec, 0x6c, 0x53, 0x62, 0x0d, 0x4f, 0xd4, 0x86, 0xfe, 0xb6, 0x7a,
-	0xff, 0xf8, 0x01, 0x34, 0x85, 0x9b, 0xf9, 0x22, 0xa8, 0x57, 0x05, 0x12, 0x09, 0x63, 0x1d, 0xea,
-	0x96, 0x3f, 0x16, 0xaa, 0xae, 0x63, 0x65, 0xe0, 0xe0, 0x1b, 0x82, 0xcd, 0xd2, 0x04, 0x79, 0xa3,
-	0xf8, 0x31, 0xb4, 0x6c, 0x1e, 0x31, 0xe7, 0x0c, 0x77, 0xae, 0xee, 0x58, 0x71, 0xc9, 0xdd, 0xdc,
-	0x4e, 0xc9, 0x13, 0x75, 0x7b, 0x08, 0xef, 0x42, 0x8d, 0xa6, 0x78, 0xab, 0x52, 0x54, 0x3e, 0x9b,
-	0xee, 0xdf, 0xfe, 0xe3, 0xa7, 0xc5, 0x7a, 0xdd, 0x30, 0xe7, 0x6e, 0x05, 0x59, 0xde, 0xda, 0x3d,
-	0x64, 0x5a, 0xe7, 0x73, 0x0d, 0xfd, 0x98, 0x6b, 0xe8, 0xe7, 0x5c, 0x43, 0xbf, 0xe7, 0x1a, 0xfa,
-	0x7e, 0xa9, 0xa1, 0xf3, 0x4b, 0x0d, 0xbd, 0x7b, 0x78, 0xf3, 0x63, 0x8c, 0x42, 0xb7, 0x5f, 0xf6,
-	0x1d, 0xb5, 0xc4, 0x47, 0xe2, 0xd1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x17, 0xe1, 0x08, 0x02,
-	0x64, 0x04, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xef, 0xe4, 0x1f, 0xcd, 0x4b, 0xda, 0xc6, 0xa1, 0xca, 0x1a, 0x24, 0x0d, 0x2b, 0x48, 0x41,
+	0x9a, 0x94, 0x48, 0xf1, 0x24, 0x92, 0xe8, 0xda, 0x56, 0x5b, 0xc4, 0xc9, 0xf8, 0x07, 0x2f, 0x92,
+	0x6c, 0x9e, 0x49, 0xb0, 0xdd, 0x89, 0xb3, 0xb3, 0xba, 0xf9, 0x28, 0x82, 0x9f, 0xc4, 0x93, 0xc7,
+	0x5e, 0x04, 0x8f, 0xe2, 0xa1, 0x48, 0xfa, 0x45, 0x64, 0x67, 0x36, 0xc9, 0xa6, 0xd8, 0x7a, 0xe8,
+	0x65, 0x79, 0xef, 0xfd, 0x7e, 0xef, 0xcf, 0xfc, 0xe6, 0xcd, 0xc2, 0x9a, 0x1c, 0xb9, 0xf8, 0x09,
+	0x3d, 0xe5, 0xd7, 0x46, 0x52, 0x28, 0x41, 0xf3, 0xb3, 0x40, 0x79, 0xbd, 0x2f, 0xfa, 0x42, 0x47,
+	0xeb, 0x91, 0x65, 0x08, 0x65, 0xc0, 0x10, 0x5d, 0x63, 0xdb, 0x0f, 0x60, 0x6d, 0x17, 0x55, 0xeb,
+	0x48, 0xb8, 0x1f, 0x18, 0x7e, 0x0c, 0xd0, 0x57, 0xf4, 0x06, 0xe4, 0xf6, 0x70, 0xd8, 0x1f, 0x28,
+	0x8b, 0x54, 0xc9, 0x66, 0x86, 0xc5, 0x1e, 0xa5, 0x90, 0x79, 0xdd, 0x19, 0x2a, 0x2b, 0x55, 0x25,
+	0x9b, 0xcb, 0x4c, 0xdb, 0xb6, 0x07, 0x79, 0x1e, 0x4e, 0x13, 0x0f, 0x21, 0xc7, 0xc3, 0xbd, 0x8e,
+	0x3f, 0xd0, 0x89, 0xc5, 0xd6, 0xce, 0xc9, 0xe9, 0xc6, 0xd2, 0xef, 0xd3, 0x8d, 0xad, 0xfe, 0x50,
+	0x0d, 0x82, 0x6e, 0xcd, 0x15, 0xc7, 0xf5, 0xc1, 0x78, 0x84, 0xf2, 0x08, 0x7b, 0x7d, 0x94, 0xf5,
+	0x6e, 0x20, 0xa5, 0xf8, 0x5c, 0xef, 0x0e, 0xbd, 0x8e, 0x1c, 0xd7, 0xf6, 0x30, 0x6c, 0x8d, 0x15,
+	0xfa, 0x2c, 0x2e, 0xf2, 0xcf, 0x7e, 0x3f, 0x08, 0xac, 0xe8, 0x61, 0xfd, 0x69, 0xd3, 0x1d, 0x00,
+	0x33, 0x7d, 0xc7, 0xeb, 0xa3, 0x6e, 0x5c, 0x68, 0x5c, 0xaf, 0xcd, 0x35, 0x99, 0x83, 0x2c, 0x41,
+	0xa4, 0xeb, 0x90, 0x7d, 0x11, 0xa0, 0x1c, 0xeb, 0xea, 0x79, 0x66, 0x1c, 0x6a, 0x43, 0xf1, 0x31,
+	0xba, 0xa2, 0x87, 0x8e, 0x4e, 0xb6, 0xd2, 0xba, 0xf5, 0x42, 0x8c, 0x3e, 0x83, 0x6c, 0xf3, 0xbd,
+	0x42, 0x69, 0x65, 0xae, 0x72, 0x48, 0x53, 0xc3, 0xfe, 0x4a, 0x60, 0xd5, 0xd4, 0x65, 0xe8, 0x8f,
+	0x84, 0xe7, 0xe3, 0x85, 0xf2, 0xdf, 0x86, 0x5c, 0x3c, 0x55, 0xaa, 0x9a, 0xde, 0x2c, 0x34, 0x0a,
+	0x35, 0x7d, 0x8d, 0x3a, 0xc6, 0x62, 0x28, 0xba, 0x82, 0x47, 0x81, 0xf4, 0x85, 0xb4, 0xd2, 0x57,
+	0x99, 0x2e, 0x2e, 0x62, 0x23, 0xac, 0xec, 0xa2, 0xe2, 0xe1, 0x4c, 0xed, 0x2a, 0x14, 0xda, 0xaa,
+	0x23, 0xd5, 0xc2, 0x84, 0xc9, 0x10, 0xbd, 0x05, 0x79, 0xc7, 0xeb, 0xc5, 0x78, 0x4a, 0xe3, 0xf3,
+	0xc0, 0x5c, 0xf6, 0x74, 0x42, 0x76, 0xfb, 0x1d, 0xac, 0x4e, 0xdb, 0xfc, 0x47, 0x84, 0x1d, 0x28,
+	0xf2, 0xd0, 0x09, 0xd1, 0x0d, 0xd4, 0x50, 0x78, 0x53, 0x29, 0xae, 0x19, 0x29, 0x12, 0x08, 0x5b,
+	0xa0, 0xd9, 0x5f, 0x08, 0x64, 0x5b, 0x22, 0xf0, 0x7a, 0xb4, 0x06, 0x19, 0x3e, 0x1e, 0x99, 0x45,
+	0x59, 0x6d, 0x94, 0x93, 0x8b, 0x12, 0xe1, 0xe6, 0x1b, 0x31, 0x98, 0xe6, 0x45, 0x03, 0xef, 0x7b,
+	0x3d, 0x0c, 0xe3, 0xa3, 0x18, 0xc7, 0x7e, 0x0a, 0xf9, 0x19, 0x91, 0x16, 0x61, 0xb9, 0xd9, 0x6a,
+	0x3f, 0x3f, 0x78, 0xc9, 0x9d, 0xd2, 0x52, 0xe4, 0x31, 0xe7, 0xa0, 0xc9, 0xf7, 0x5f, 0x39, 0x25,
+	0x42, 0xf3, 0x90, 0x7d, 0xb2, 0xcf, 0xda, 0xbc, 0x94, 0xa2, 0x00, 0xb9, 0x83, 0x26, 0x77, 0xda,
+	0xbc, 0x94, 0x8e, 0xec, 0x36, 0x67, 0x4e, 0xf3, 0xb0, 0x94, 0xb1, 0xdf, 0x24, 0x17, 0x98, 0xde,
+	0x81, 0xac, 0x56, 0x33, 0xde, 0xe4, 0xd2, 0xf9, 0x01, 0x99, 0x81, 0xa9, 0x0d, 0x69, 0xc7, 0xeb,
+	0x59, 0xa9, 0x0b, 0x58, 0x11, 0xd8, 0xf8, 0x46, 0x60, 0x6d, 0x26, 0x42, 0xbc, 0x20, 0xf7, 0x21,
+	0xd7, 0x56, 0x12, 0x3b, 0xc7, 0xd4, 0x3a, 0xff, 0x48, 0xa6, 0x97, 0x5c, 0x8e, 0xe5, 0x34, 0x3c,
+	0x9d, 0xb7, 0x4d, 0xe8, 0x16, 0xa4, 0x78, 0x48, 0xd7, 0x13, 0x49, 0x3c, 0x3c, 0x97, 0x90, 0x90,
+	0x9c, 0x3e, 0x9c, 0x6e, 0xeb, 0x25, 0x7d, 0x6e, 0x26, 0x90, 0xc5, 0x47, 0xb0, 0x4d, 0x5a, 0xce,
+	0xc9, 0xa4, 0x42, 0x7e, 0x4e, 0x2a, 0xe4, 0xd7, 0xa4, 0x42, 0xfe, 0x4c, 0x2a, 0xe4, 0xfb, 0x59,
+	0x85, 0x9c, 0x9c, 0x55, 0xc8, 0xdb, 0xbb, 0x97, 0xef, 0xb2, 0x1c, 0xb9, 0xf5, 0x59, 0xdd, 0x6e,
+	0x4e, 0xff, 0xe6, 0xee, 0xfd, 0x1d, 0x00, 0x94, 0x91, 0xea, 0x89, 0x26, 0x05, 0x00, 0x00,
 }
 
 func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
@@ -730,6 +747,26 @@ func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size := m.After.Size()
+		i -= size
+		if _, err := m.After.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DecodeEvents {
+		i--
+		if m.DecodeEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Query) > 0 {
 		i -= len(m.Query)
 		copy(dAtA[i:], m.Query)
@@ -776,6 +813,16 @@ func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1036,6 +1083,11 @@ func (m *BlocksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcevents(uint64(l))
 	}
+	if m.DecodeEvents {
+		n += 2
+	}
+	l = m.After.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1057,6 +1109,8 @@ func (m *EventsResponse) Size() (n int) {
 			n += 1 + l + sovRpcevents(uint64(l))
 		}
 	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1441,6 +1495,59 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeEvents = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -1545,6 +1652,39 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -0,0 +1,351 @@
+// Package rpcgateway exposes the gRPC services over HTTP with JSON request and response bodies so that clients without
+// good gRPC support can use them.
+//
+// Every method of every registered service is mounted at its gRPC path, for example:
+//
+// curl -d '{"Address": "<hex address>"}' http://0.0.0.0:26661/rpcquery.Query/GetAccount
+//
+// Methods can be called with POST (with a JSON request body) or GET (with an empty request). Server-streaming
+// methods write each message as a line of newline-delimited JSON, or as server-sent events if the client sends
+// 'Accept: text/event-stream'. Client-streaming methods read successive JSON values from the request body.
+//
+// An OpenAPI document describing the mounted methods is served at /openapi.json.
+package rpcgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	OpenAPIPath = "/openapi.json"
+
+	contentTypeJSON   = "application/json"
+	contentTypeNDJSON = "application/x-ndjson"
+	contentTypeSSE    = "text/event-stream"
+)
+
+// A method mounted on the gateway
+type Method struct {
+	// Full gRPC method name, e.g. /rpcquery.Query/GetAccount
+	FullMethod     string
+	ServiceName    string
+	MethodName     string
+	RequestType    reflect.Type
+	ResponseType   reflect.Type
+	IsClientStream bool
+	IsServerStream bool
+	server         interface{}
+	unaryHandler   methodHandler
+	streamHandler  grpc.StreamHandler
+}
+
+// Has the same signature as the handlers generated in each grpc.MethodDesc
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// Gateway serves registered gRPC service implementations over HTTP/JSON. It implements grpc.ServiceRegistrar so the
+// generated RegisterXServer functions can be used to mount services on it.
+type Gateway struct {
+	sync.RWMutex
+	methods           map[string]*Method
+	unaryInterceptor  grpc.UnaryServerInterceptor
+	streamInterceptor grpc.StreamServerInterceptor
+	logger            *logging.Logger
+}
+
+var _ grpc.ServiceRegistrar = &Gateway{}
+var _ http.Handler = &Gateway{}
+
+// Interceptors, if non-nil, are applied to calls in the same way as they would be by a grpc.Server
+func NewGateway(unaryInterceptor grpc.UnaryServerInterceptor, streamInterceptor grpc.StreamServerInterceptor,
+	logger *logging.Logger) *Gateway {
+	return &Gateway{
+		methods:           make(map[string]*Method),
+		unaryInterceptor:  unaryInterceptor,
+		streamInterceptor: streamInterceptor,
+		logger:            logger.WithScope("NewGateway"),
+	}
+}
+
+func (gw *Gateway) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	gw.Lock()
+	defer gw.Unlock()
+	handlerType := reflect.TypeOf(desc.HandlerType).Elem()
+	if !reflect.TypeOf(impl).Implements(handlerType) {
+		panic(fmt.Errorf("rpcgateway: RegisterService found handler of type %v that does not satisfy %v",
+			reflect.TypeOf(impl), handlerType))
+	}
+	for i := range desc.Methods {
+		md := desc.Methods[i]
+		reqType, resType, err := unarySignature(handlerType, md.MethodName)
+		if err != nil {
+			panic(err)
+		}
+		gw.add(&Method{
+			ServiceName:  desc.ServiceName,
+			MethodName:   md.MethodName,
+			RequestType:  reqType,
+			ResponseType: resType,
+			server:       impl,
+			unaryHandler: md.Handler,
+		})
+	}
+	for i := range desc.Streams {
+		sd := desc.Streams[i]
+		reqType, resType, err := streamSignature(handlerType, sd)
+		if err != nil {
+			panic(err)
+		}
+		gw.add(&Method{
+			ServiceName:    desc.ServiceName,
+			MethodName:     sd.StreamName,
+			RequestType:    reqType,
+			ResponseType:   resType,
+			IsClientStream: sd.ClientStreams,
+			IsServerStream: sd.ServerStreams,
+			server:         impl,
+			streamHandler:  sd.Handler,
+		})
+	}
+}
+
+// Returns the mounted methods ordered by their full method name
+func (gw *Gateway) Methods() []*Method {
+	gw.RLock()
+	defer gw.RUnlock()
+	methods := make([]*Method, 0, len(gw.methods))
+	for _, m := range gw.methods {
+		methods = append(methods, m)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].FullMethod < methods[j].FullMethod
+	})
+	return methods
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == OpenAPIPath {
+		gw.serveOpenAPI(w, r)
+		return
+	}
+	gw.RLock()
+	method, ok := gw.methods[r.URL.Path]
+	gw.RUnlock()
+	if !ok {
+		writeError(w, status.Errorf(codes.Unimplemented, "no gRPC method mounted at %s", r.URL.Path))
+		return
+	}
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, status.Errorf(codes.Unimplemented, "HTTP method %s not supported", r.Method))
+		return
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), incomingMetadata(r))
+	ctx = peer.NewContext(ctx, newPeer(r))
+	if method.unaryHandler != nil {
+		gw.serveUnary(ctx, method, w, r)
+		return
+	}
+	gw.serveStream(ctx, method, w, r)
+}
+
+func (gw *Gateway) serveUnary(ctx context.Context, method *Method, w http.ResponseWriter, r *http.Request) {
+	ctx = grpc.NewContextWithServerTransportStream(ctx, &transportStream{method: method.FullMethod})
+	res, err := method.unaryHandler(method.server, ctx, func(req interface{}) error {
+		return decodeRequest(json.NewDecoder(r.Body), req)
+	}, gw.unaryInterceptor)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	bs, err := json.Marshal(res)
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "could not encode response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.Write(bs) // nolint: errcheck
+}
+
+func (gw *Gateway) serveStream(ctx context.Context, method *Method, w http.ResponseWriter, r *http.Request) {
+	ss := newServerStream(ctx, method, w, r)
+	info := &grpc.StreamServerInfo{
+		FullMethod:     method.FullMethod,
+		IsClientStream: method.IsClientStream,
+		IsServerStream: method.IsServerStream,
+	}
+	var err error
+	if gw.streamInterceptor != nil {
+		err = gw.streamInterceptor(method.server, ss, info, method.streamHandler)
+	} else {
+		err = method.streamHandler(method.server, ss)
+	}
+	if err != nil {
+		ss.writeError(err)
+	}
+}
+
+func (gw *Gateway) add(method *Method) {
+	method.FullMethod = fmt.Sprintf("/%s/%s", method.ServiceName, method.MethodName)
+	if _, ok := gw.methods[method.FullMethod]; ok {
+		panic(fmt.Errorf("rpcgateway: method %s registered twice", method.FullMethod))
+	}
+	gw.methods[method.FullMethod] = method
+}
+
+// Decode a single JSON request, treating an empty body as an empty request
+func decodeRequest(decoder *json.Decoder, req interface{}) error {
+	err := decoder.Decode(req)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not decode JSON request: %v", err)
+	}
+	return nil
+}
+
+// Pass HTTP headers through as gRPC metadata so they are visible to interceptors
+func incomingMetadata(r *http.Request) metadata.MD {
+	md := make(metadata.MD, len(r.Header))
+	for k, vs := range r.Header {
+		md.Append(strings.ToLower(k), vs...)
+	}
+	return md
+}
+
+// Expose the HTTP client's address and any TLS connection state as it would be by a grpc.Server
+func newPeer(r *http.Request) *peer.Peer {
+	p := &peer.Peer{Addr: remoteAddr(r.RemoteAddr)}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{
+			State:          *r.TLS,
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}
+	}
+	return p
+}
+
+// The address of the HTTP client, exposed as the gRPC peer address
+type remoteAddr string
+
+func (ra remoteAddr) Network() string {
+	return "tcp"
+}
+
+func (ra remoteAddr) String() string {
+	return string(ra)
+}
+
+type errorResponse struct {
+	Code    string
+	Message string
+}
+
+func newErrorResponse(err error) (int, *errorResponse) {
+	st := status.Convert(err)
+	return httpStatus(st.Code()), &errorResponse{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code, res := newErrorResponse(err)
+	bs, _ := json.Marshal(res)
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(code)
+	w.Write(bs) // nolint: errcheck
+}
+
+// See https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Unary methods have the signature: Method(context.Context, *Request) (*Response, error)
+func unarySignature(handlerType reflect.Type, name string) (reflect.Type, reflect.Type, error) {
+	m, ok := handlerType.MethodByName(name)
+	if !ok {
+		return nil, nil, fmt.Errorf("rpcgateway: %v has no method %s", handlerType, name)
+	}
+	mt := m.Type
+	if mt.NumIn() != 2 || mt.In(0) != contextType || mt.NumOut() != 2 || mt.Out(1) != errorType {
+		return nil, nil, fmt.Errorf("rpcgateway: unexpected signature for unary method %s: %v", name, mt)
+	}
+	return mt.In(1).Elem(), mt.Out(0).Elem(), nil
+}
+
+// Streaming methods have one of the following signatures depending on direction:
+// Method(*Request, Service_MethodServer) error
+// Method(Service_MethodServer) error
+// where Service_MethodServer has Send(*Response) error and/or Recv() (*Request, error)
+func streamSignature(handlerType reflect.Type, sd grpc.StreamDesc) (reqType, resType reflect.Type, err error) {
+	m, ok := handlerType.MethodByName(sd.StreamName)
+	if !ok {
+		return nil, nil, fmt.Errorf("rpcgateway: %v has no method %s", handlerType, sd.StreamName)
+	}
+	mt := m.Type
+	streamType := mt.In(mt.NumIn() - 1)
+	if !sd.ClientStreams {
+		reqType = mt.In(0).Elem()
+	} else if recv, ok := streamType.MethodByName("Recv"); ok {
+		reqType = recv.Type.Out(0).Elem()
+	}
+	if !sd.ServerStreams {
+		if closer, ok := streamType.MethodByName("SendAndClose"); ok {
+			resType = closer.Type.In(0).Elem()
+		}
+	} else if send, ok := streamType.MethodByName("Send"); ok {
+		resType = send.Type.In(0).Elem()
+	}
+	if reqType == nil || resType == nil {
+		return nil, nil, fmt.Errorf("rpcgateway: unexpected signature for streaming method %s: %v",
+			sd.StreamName, mt)
+	}
+	return reqType, resType, nil
+}
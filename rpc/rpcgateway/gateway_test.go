@@ -0,0 +1,152 @@
+package rpcgateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryServer struct {
+	rpcquery.UnimplementedQueryServer
+}
+
+func (qs *queryServer) Status(ctx context.Context, param *rpcquery.StatusParam) (*rpc.ResultStatus, error) {
+	return &rpc.ResultStatus{ChainID: "gateway-chain", RunID: param.BlockTimeWithin}, nil
+}
+
+func (qs *queryServer) ListNames(param *rpcquery.ListNamesParam, stream rpcquery.Query_ListNamesServer) error {
+	for i := 0; i < 3; i++ {
+		err := stream.Send(&names.Entry{Name: fmt.Sprintf("%s%d", param.Query, i)})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	gw := NewGateway(nil, nil, logging.NewNoopLogger())
+	rpcquery.RegisterQueryServer(gw, &queryServer{})
+	srv := httptest.NewServer(gw)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGateway_Unary(t *testing.T) {
+	srv := newTestServer(t)
+
+	res, err := http.Post(srv.URL+"/rpcquery.Query/Status", contentTypeJSON,
+		strings.NewReader(`{"BlockTimeWithin": "10m"}`))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	status := new(rpc.ResultStatus)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(status))
+	assert.Equal(t, "gateway-chain", status.ChainID)
+	assert.Equal(t, "10m", status.RunID)
+
+	// Empty request
+	res, err = http.Get(srv.URL + "/rpcquery.Query/Status")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestGateway_Errors(t *testing.T) {
+	srv := newTestServer(t)
+
+	res, err := http.Post(srv.URL+"/rpcquery.Query/GetAccount", contentTypeJSON, nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotImplemented, res.StatusCode)
+	errRes := new(errorResponse)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(errRes))
+	assert.Equal(t, "Unimplemented", errRes.Code)
+
+	res, err = http.Post(srv.URL+"/rpcquery.Query/Status", contentTypeJSON, strings.NewReader("{bad json"))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	res, err = http.Get(srv.URL + "/nope.Nope/Nope")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotImplemented, res.StatusCode)
+}
+
+func TestGateway_ServerStream(t *testing.T) {
+	srv := newTestServer(t)
+
+	res, err := http.Post(srv.URL+"/rpcquery.Query/ListNames", contentTypeJSON,
+		strings.NewReader(`{"Query": "foo"}`))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, contentTypeNDJSON, res.Header.Get("Content-Type"))
+	scanner := bufio.NewScanner(res.Body)
+	var entries []string
+	for scanner.Scan() {
+		entry := new(names.Entry)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), entry))
+		entries = append(entries, entry.Name)
+	}
+	assert.Equal(t, []string{"foo0", "foo1", "foo2"}, entries)
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/rpcquery.Query/ListNames",
+		strings.NewReader(`{"Query": "bar"}`))
+	require.NoError(t, err)
+	req.Header.Set("Accept", contentTypeSSE)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, contentTypeSSE, res.Header.Get("Content-Type"))
+	scanner = bufio.NewScanner(res.Body)
+	var data []string
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "data: ") {
+			data = append(data, strings.TrimPrefix(scanner.Text(), "data: "))
+		}
+	}
+	require.Len(t, data, 3)
+	assert.Contains(t, data[2], `"Name":"bar2"`)
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	srv := newTestServer(t)
+
+	res, err := http.Get(srv.URL + OpenAPIPath)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	doc := struct {
+		OpenAPI    string
+		Paths      map[string]json.RawMessage
+		Components struct {
+			Schemas map[string]*Schema
+		}
+	}{}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&doc))
+	assert.Equal(t, openAPIVersion, doc.OpenAPI)
+	assert.Contains(t, doc.Paths, "/rpcquery.Query/GetAccount")
+	assert.Contains(t, doc.Paths, "/rpcquery.Query/ListNames")
+
+	entry := doc.Components.Schemas["names.Entry"]
+	require.NotNil(t, entry)
+	assert.Equal(t, "string", entry.Properties["Owner"].Type)
+	assert.Equal(t, "int64", entry.Properties["Expires"].Format)
+	assert.NotContains(t, entry.Properties, "XXX_unrecognized")
+
+	account := doc.Components.Schemas["acm.Account"]
+	require.NotNil(t, account)
+	assert.Equal(t, "#/components/schemas/permission.AccountPermissions", account.Properties["Permissions"].Ref)
+}
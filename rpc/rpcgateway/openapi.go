@@ -0,0 +1,214 @@
+package rpcgateway
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/project"
+)
+
+const openAPIVersion = "3.0.3"
+
+// Schema is the subset of an OpenAPI schema object that we generate
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// OpenAPI builds an OpenAPI document describing the methods mounted on the gateway. Schemas are derived from the
+// Go types of the requests and responses following the rules of encoding/json, which is how they are serialised.
+func (gw *Gateway) OpenAPI() map[string]interface{} {
+	sr := newSchemaReflector()
+	paths := make(map[string]interface{})
+	for _, method := range gw.Methods() {
+		responseType := contentTypeJSON
+		description := "response"
+		if method.IsServerStream {
+			responseType = contentTypeNDJSON
+			description = "stream of newline-delimited responses (or server-sent events with Accept: text/event-stream)"
+		}
+		requestType := contentTypeJSON
+		if method.IsClientStream {
+			requestType = contentTypeNDJSON
+		}
+		paths[method.FullMethod] = map[string]interface{}{
+			"post": map[string]interface{}{
+				"operationId": strings.ReplaceAll(strings.TrimPrefix(method.FullMethod, "/"), "/", "."),
+				"tags":        []string{method.ServiceName},
+				"requestBody": map[string]interface{}{
+					"content": map[string]interface{}{
+						requestType: map[string]interface{}{"schema": sr.schema(method.RequestType)},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": description,
+						"content": map[string]interface{}{
+							responseType: map[string]interface{}{"schema": sr.schema(method.ResponseType)},
+						},
+					},
+					"default": map[string]interface{}{
+						"description": "error",
+						"content": map[string]interface{}{
+							contentTypeJSON: map[string]interface{}{"schema": sr.schema(reflect.TypeOf(errorResponse{}))},
+						},
+					},
+				},
+			},
+		}
+	}
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":   "Hyperledger Burrow",
+			"version": project.FullVersion(),
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": sr.components,
+		},
+	}
+}
+
+func (gw *Gateway) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	bs, err := json.MarshalIndent(gw.OpenAPI(), "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not generate OpenAPI document: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.Write(bs) // nolint: errcheck
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+	durationType      = reflect.TypeOf(time.Duration(0))
+)
+
+type schemaReflector struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaReflector() *schemaReflector {
+	return &schemaReflector{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+func (sr *schemaReflector) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == durationType:
+		return &Schema{Type: "integer", Format: "int64", Description: "nanoseconds"}
+	case implements(t, jsonMarshalerType):
+		// Types with custom JSON encodings that are structs tend to be objects, most others are strings
+		if t.Kind() == reflect.Struct {
+			return &Schema{Type: "object"}
+		}
+		return &Schema{Type: "string"}
+	case implements(t, textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: sr.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sr.schema(t.Elem())}
+	case reflect.Struct:
+		return sr.structRef(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (sr *schemaReflector) structRef(t reflect.Type) *Schema {
+	name, ok := sr.names[t]
+	if !ok {
+		name = sr.componentName(t)
+		sr.names[t] = name
+		// Register before descending so that recursive types terminate
+		schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		sr.components[name] = schema
+		sr.addProperties(schema, t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// Mirrors the field selection of encoding/json (without its handling of name conflicts)
+func (sr *schemaReflector) addProperties(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		ft := field.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if field.Anonymous && name == "" && ft.Kind() == reflect.Struct && !implements(ft, jsonMarshalerType) {
+			sr.addProperties(schema, ft)
+			continue
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = sr.schema(field.Type)
+	}
+}
+
+// Use the last element of the package path to qualify type names so they remain readable, disambiguating if needed
+func (sr *schemaReflector) componentName(t reflect.Type) string {
+	name := path.Base(t.PkgPath()) + "." + t.Name()
+	if t.Name() == "" {
+		name = "anonymous"
+	}
+	unique := name
+	for i := 2; sr.components[unique] != nil; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	return unique
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
@@ -0,0 +1,177 @@
+package rpcgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Adapts an HTTP request/response pair to a grpc.ServerStream
+type serverStream struct {
+	ctx     context.Context
+	method  *Method
+	w       http.ResponseWriter
+	flusher http.Flusher
+	decoder *json.Decoder
+	sse     bool
+	// Whether we have written a response header
+	started bool
+	// Number of messages received
+	received int
+	header   metadata.MD
+}
+
+var _ grpc.ServerStream = &serverStream{}
+
+func newServerStream(ctx context.Context, method *Method, w http.ResponseWriter, r *http.Request) *serverStream {
+	ss := &serverStream{
+		method:  method,
+		w:       w,
+		decoder: json.NewDecoder(r.Body),
+		sse:     strings.Contains(r.Header.Get("Accept"), contentTypeSSE),
+		header:  metadata.MD{},
+	}
+	ss.flusher, _ = w.(http.Flusher)
+	ss.ctx = grpc.NewContextWithServerTransportStream(ctx, &transportStream{method: method.FullMethod, stream: ss})
+	return ss
+}
+
+func (ss *serverStream) SetHeader(md metadata.MD) error {
+	if ss.started {
+		return fmt.Errorf("rpcgateway: cannot set header after response has started")
+	}
+	ss.header = metadata.Join(ss.header, md)
+	return nil
+}
+
+func (ss *serverStream) SendHeader(md metadata.MD) error {
+	err := ss.SetHeader(md)
+	if err != nil {
+		return err
+	}
+	ss.start()
+	return nil
+}
+
+func (ss *serverStream) SetTrailer(md metadata.MD) {
+}
+
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (ss *serverStream) SendMsg(m interface{}) error {
+	bs, err := json.Marshal(m)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not encode response: %v", err)
+	}
+	ss.start()
+	return ss.write("message", bs)
+}
+
+func (ss *serverStream) RecvMsg(m interface{}) error {
+	// Without client streaming we expect exactly one request, possibly empty
+	if !ss.method.IsClientStream {
+		if ss.received > 0 {
+			return io.EOF
+		}
+		ss.received++
+		return decodeRequest(ss.decoder, m)
+	}
+	err := ss.decoder.Decode(m)
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not decode JSON request: %v", err)
+	}
+	ss.received++
+	return nil
+}
+
+func (ss *serverStream) writeError(err error) {
+	if !ss.started {
+		writeError(ss.w, err)
+		return
+	}
+	// We are already mid-stream so can only signal the error in-band
+	_, res := newErrorResponse(err)
+	bs, _ := json.Marshal(struct{ Error *errorResponse }{res})
+	ss.write("error", bs) // nolint: errcheck
+}
+
+func (ss *serverStream) start() {
+	if ss.started {
+		return
+	}
+	ss.started = true
+	header := ss.w.Header()
+	for k, vs := range ss.header {
+		for _, v := range vs {
+			header.Add(k, v)
+		}
+	}
+	if ss.sse {
+		header.Set("Content-Type", contentTypeSSE)
+		header.Set("Cache-Control", "no-cache")
+	} else if ss.method.IsServerStream {
+		header.Set("Content-Type", contentTypeNDJSON)
+	} else {
+		header.Set("Content-Type", contentTypeJSON)
+	}
+	ss.w.WriteHeader(http.StatusOK)
+}
+
+func (ss *serverStream) write(event string, bs []byte) error {
+	var err error
+	if ss.sse {
+		_, err = fmt.Fprintf(ss.w, "event: %s\ndata: %s\n\n", event, bs)
+	} else {
+		_, err = fmt.Fprintf(ss.w, "%s\n", bs)
+	}
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not write to HTTP client: %v", err)
+	}
+	if ss.flusher != nil {
+		ss.flusher.Flush()
+	}
+	return nil
+}
+
+// Makes grpc.Method, grpc.SetHeader, and friends work for handlers called by the gateway
+type transportStream struct {
+	method string
+	stream *serverStream
+}
+
+var _ grpc.ServerTransportStream = &transportStream{}
+
+func (ts *transportStream) Method() string {
+	return ts.method
+}
+
+func (ts *transportStream) SetHeader(md metadata.MD) error {
+	if ts.stream == nil {
+		return nil
+	}
+	return ts.stream.SetHeader(md)
+}
+
+func (ts *transportStream) SendHeader(md metadata.MD) error {
+	if ts.stream == nil {
+		return nil
+	}
+	return ts.stream.SendHeader(md)
+}
+
+func (ts *transportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
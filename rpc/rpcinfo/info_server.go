@@ -13,14 +13,18 @@ import (
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
-func StartServer(service *rpc.Service, pattern string, listener net.Listener, logger *logging.Logger) (*http.Server, error) {
+// If auth is non-nil requests are authenticated and authorized against the method they call, if limiter is non-nil
+// they are rate limited
+func StartServer(service *rpc.Service, pattern string, listener net.Listener, auth *rpc.Authorizer,
+	limiter *rpc.RateLimiter, logger *logging.Logger) (*http.Server, error) {
 	logger = logger.With(structure.ComponentKey, "RPC_Info")
 	routes := GetRoutes(service)
 	mux := http.NewServeMux()
 	wm := server.NewWebsocketManager(routes, logger)
 	mux.HandleFunc(pattern, wm.WebsocketHandler)
 	server.RegisterRPCFuncs(mux, routes, logger)
-	srv, err := server.StartHTTPServer(listener, mux, logger)
+	handler := rpc.HTTPHandler(mux, auth, limiter, rpc.JSONRPCMethods(rpc.InfoMethodPrefix, true))
+	srv, err := server.StartHTTPServer(listener, handler, logger)
 	if err != nil {
 		return nil, err
 	}
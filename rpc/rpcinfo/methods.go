@@ -58,7 +58,7 @@ const maxRegexLength = 255
 // curl -X POST -d '{"method": "names", "id": "foo", "params": ["loves"]}' http://0.0.0.0:26658
 //
 func GetRoutes(service *rpc.Service) map[string]*server.RPCFunc {
-	// TODO: overhaul this with gRPC-gateway / swagger
+	// NOTE: the GRPC services are also available over HTTP/JSON via rpcgateway, which should be preferred for new uses
 	return map[string]*server.RPCFunc{
 		// Status
 		Status:          server.NewRPCFunc(service.StatusWithin, "block_time_within,block_seen_time_within"),
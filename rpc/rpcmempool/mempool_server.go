@@ -0,0 +1,180 @@
+package rpcmempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/txs"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How often StreamTxs checks the mempool for additions
+const DefaultPollInterval = 200 * time.Millisecond
+
+// Subset of tendermint.NodeView
+type MempoolView interface {
+	MempoolTxs(maxTxs int) tmtypes.Txs
+	MempoolSize() int
+	FlushMempool()
+	// Holds off CheckTx and commits
+	LockMempool()
+	UnlockMempool()
+}
+
+// Subset of execution.BatchExecutor for the executor that runs CheckTx
+type Checker interface {
+	sync.Locker
+	Reset() error
+}
+
+type mempoolServer struct {
+	UnimplementedMempoolServer
+	mempool      MempoolView
+	checker      Checker
+	txDecoder    txs.Decoder
+	pollInterval time.Duration
+	logger       *logging.Logger
+}
+
+var _ MempoolServer = &mempoolServer{}
+
+func NewMempoolServer(mempool MempoolView, checker Checker, txDecoder txs.Decoder,
+	logger *logging.Logger) *mempoolServer {
+	return &mempoolServer{
+		mempool:      mempool,
+		checker:      checker,
+		txDecoder:    txDecoder,
+		pollInterval: DefaultPollInterval,
+		logger:       logger.WithScope("NewMempoolServer"),
+	}
+}
+
+func (ms *mempoolServer) ListTxs(ctx context.Context, param *ListTxsParam) (*PendingTxs, error) {
+	maxTxs := -1
+	if param.MaxTxs > 0 && param.Address == nil {
+		maxTxs = int(param.MaxTxs)
+	}
+	pending := new(PendingTxs)
+	for _, tx := range ms.mempool.MempoolTxs(maxTxs) {
+		ptx, err := ms.pendingTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		if !ptx.HasInput(param.Address) {
+			continue
+		}
+		pending.Txs = append(pending.Txs, ptx)
+		if param.MaxTxs > 0 && uint64(len(pending.Txs)) >= param.MaxTxs {
+			break
+		}
+	}
+	return pending, nil
+}
+
+// The mempool offers no notification of additions so we poll it and send those transactions we have not yet seen
+func (ms *mempoolServer) StreamTxs(param *StreamTxsParam, stream Mempool_StreamTxsServer) error {
+	seen := make(map[[32]byte]struct{})
+	send := func(tx tmtypes.Tx) error {
+		ptx, err := ms.pendingTx(tx)
+		if err != nil {
+			return err
+		}
+		if !ptx.HasInput(param.Address) {
+			return nil
+		}
+		return stream.Send(ptx)
+	}
+	for _, tx := range ms.mempool.MempoolTxs(-1) {
+		seen[sha256.Sum256(tx)] = struct{}{}
+		if param.IncludeExisting {
+			err := send(tx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	ticker := time.NewTicker(ms.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+			// Forget transactions that have left the mempool so that they are sent again if resubmitted
+			current := make(map[[32]byte]struct{}, len(seen))
+			for _, tx := range ms.mempool.MempoolTxs(-1) {
+				key := sha256.Sum256(tx)
+				current[key] = struct{}{}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				err := send(tx)
+				if err != nil {
+					return err
+				}
+			}
+			seen = current
+		}
+	}
+}
+
+// Since flushing the mempool would let any caller drop other clients' transactions it is only available to clients
+// authenticated by RPC.Auth.
+func (ms *mempoolServer) Flush(ctx context.Context, param *FlushParam) (*FlushResult, error) {
+	client := rpc.ClientFromContext(ctx)
+	if client == nil || client.Name == rpc.AnonymousClient {
+		return nil, status.Error(codes.PermissionDenied, "flushing the mempool requires an authenticated client")
+	}
+	flushed := ms.mempool.MempoolSize()
+	ms.mempool.FlushMempool()
+	err := ms.resetChecker()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not reset check cache after flushing mempool: %v", err)
+	}
+	ms.logger.InfoMsg("Flushed mempool", "client", client.Name, "flushed", flushed)
+	return &FlushResult{Flushed: uint64(flushed)}, nil
+}
+
+// Discard the account sequences and balances the checker holds for the flushed transactions so that they may be sent
+// again. We take the locks in the order Commit does. A transaction checked between the flush and the reset stays in
+// the mempool while being dropped from the checker, but is checked again when the next block is committed.
+func (ms *mempoolServer) resetChecker() error {
+	ms.mempool.LockMempool()
+	defer ms.mempool.UnlockMempool()
+	ms.checker.Lock()
+	defer ms.checker.Unlock()
+	return ms.checker.Reset()
+}
+
+func (ms *mempoolServer) pendingTx(tx tmtypes.Tx) (*PendingTx, error) {
+	txEnv, err := ms.txDecoder.DecodeTx(tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not decode mempool transaction %X: %v", tx.Hash(), err)
+	}
+	return &PendingTx{
+		TxType:   txEnv.Tx.Type(),
+		TxHash:   txEnv.Tx.Hash(),
+		Inputs:   txEnv.Tx.GetInputs(),
+		Envelope: txEnv,
+	}, nil
+}
+
+// Returns true if address is nil or the transaction has an input from address
+func (ptx *PendingTx) HasInput(address *crypto.Address) bool {
+	if address == nil {
+		return true
+	}
+	for _, input := range ptx.Inputs {
+		if input.Address == *address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package rpcmempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	alice = crypto.Address{1}
+	bob   = crypto.Address{2}
+)
+
+func TestMempoolServer_ListTxs(t *testing.T) {
+	mempool := &testMempool{}
+	codec := txs.NewProtobufCodec()
+	mempool.add(t, codec, alice, 1)
+	mempool.add(t, codec, bob, 1)
+	mempool.add(t, codec, alice, 2)
+	ms := NewMempoolServer(mempool, &testChecker{mempool: mempool}, codec, logging.NewNoopLogger())
+
+	pending, err := ms.ListTxs(context.Background(), &ListTxsParam{})
+	require.NoError(t, err)
+	require.Len(t, pending.Txs, 3)
+	assert.Equal(t, payload.TypeSend, pending.Txs[0].TxType)
+	assert.Equal(t, pending.Txs[0].Envelope.Tx.Hash(), pending.Txs[0].TxHash)
+
+	pending, err = ms.ListTxs(context.Background(), &ListTxsParam{Address: &alice})
+	require.NoError(t, err)
+	require.Len(t, pending.Txs, 2)
+	assert.Equal(t, uint64(1), pending.Txs[0].Inputs[0].Sequence)
+	assert.Equal(t, uint64(2), pending.Txs[1].Inputs[0].Sequence)
+
+	pending, err = ms.ListTxs(context.Background(), &ListTxsParam{Address: &alice, MaxTxs: 1})
+	require.NoError(t, err)
+	require.Len(t, pending.Txs, 1)
+}
+
+func TestMempoolServer_StreamTxs(t *testing.T) {
+	mempool := &testMempool{}
+	codec := txs.NewProtobufCodec()
+	mempool.add(t, codec, alice, 1)
+	ms := NewMempoolServer(mempool, &testChecker{mempool: mempool}, codec, logging.NewNoopLogger())
+	ms.pollInterval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testStream{ctx: ctx, ch: make(chan *PendingTx, 10)}
+	errCh := make(chan error)
+	go func() {
+		errCh <- ms.StreamTxs(&StreamTxsParam{Address: &alice, IncludeExisting: true}, stream)
+	}()
+
+	ptx := <-stream.ch
+	assert.Equal(t, uint64(1), ptx.Inputs[0].Sequence)
+
+	mempool.add(t, codec, bob, 1)
+	mempool.add(t, codec, alice, 2)
+	ptx = <-stream.ch
+	assert.Equal(t, alice, ptx.Inputs[0].Address)
+	assert.Equal(t, uint64(2), ptx.Inputs[0].Sequence)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	assert.Len(t, stream.ch, 0)
+}
+
+func TestMempoolServer_Flush(t *testing.T) {
+	mempool := &testMempool{}
+	codec := txs.NewProtobufCodec()
+	mempool.add(t, codec, alice, 1)
+	mempool.add(t, codec, bob, 1)
+	checker := &testChecker{mempool: mempool}
+	ms := NewMempoolServer(mempool, checker, codec, logging.NewNoopLogger())
+
+	_, err := ms.Flush(context.Background(), &FlushParam{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, 2, mempool.MempoolSize())
+
+	// Go via the interceptor to have the client attached to the context
+	auth, err := rpc.NewAuthorizer(&rpc.AuthConfig{AnonymousAllow: []string{"*"}})
+	require.NoError(t, err)
+	_, err = auth.UnaryInterceptor()(context.Background(), &FlushParam{},
+		&grpc.UnaryServerInfo{FullMethod: "/rpcmempool.Mempool/Flush"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return ms.Flush(ctx, req.(*FlushParam))
+		})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx := rpc.ContextWithClient(context.Background(), &rpc.ClientConfig{Name: "admin"})
+	result, err := ms.Flush(ctx, &FlushParam{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), result.Flushed)
+	assert.Equal(t, 0, mempool.MempoolSize())
+	// The checker no longer holds the sequences of the flushed transactions so they can be sent again
+	assert.Equal(t, 1, checker.resets)
+	assert.False(t, mempool.locked)
+
+	checker.err = fmt.Errorf("could not reset")
+	_, err = ms.Flush(ctx, &FlushParam{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+type testMempool struct {
+	sync.Mutex
+	txs    tmtypes.Txs
+	locked bool
+}
+
+func (mp *testMempool) add(t *testing.T, codec txs.Codec, address crypto.Address, sequence uint64) {
+	txEnv := txs.Enclose("test-chain", &payload.SendTx{
+		Inputs:  []*payload.TxInput{{Address: address, Amount: 1, Sequence: sequence}},
+		Outputs: []*payload.TxOutput{{Address: crypto.Address{3}, Amount: 1}},
+	})
+	bs, err := codec.EncodeTx(txEnv)
+	require.NoError(t, err)
+	mp.Lock()
+	defer mp.Unlock()
+	mp.txs = append(mp.txs, bs)
+}
+
+func (mp *testMempool) MempoolTxs(maxTxs int) tmtypes.Txs {
+	mp.Lock()
+	defer mp.Unlock()
+	if maxTxs < 0 || maxTxs > len(mp.txs) {
+		maxTxs = len(mp.txs)
+	}
+	return append(tmtypes.Txs(nil), mp.txs[:maxTxs]...)
+}
+
+func (mp *testMempool) MempoolSize() int {
+	mp.Lock()
+	defer mp.Unlock()
+	return len(mp.txs)
+}
+
+func (mp *testMempool) FlushMempool() {
+	mp.Lock()
+	defer mp.Unlock()
+	mp.txs = nil
+}
+
+func (mp *testMempool) LockMempool() {
+	mp.Lock()
+	defer mp.Unlock()
+	mp.locked = true
+}
+
+func (mp *testMempool) UnlockMempool() {
+	mp.Lock()
+	defer mp.Unlock()
+	mp.locked = false
+}
+
+type testChecker struct {
+	sync.Mutex
+	mempool *testMempool
+	resets  int
+	err     error
+}
+
+func (tc *testChecker) Reset() error {
+	tc.mempool.Lock()
+	defer tc.mempool.Unlock()
+	if !tc.mempool.locked {
+		return fmt.Errorf("checker must only be reset with the mempool locked")
+	}
+	tc.resets++
+	return tc.err
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ch  chan *PendingTx
+}
+
+func (ts *testStream) Context() context.Context {
+	return ts.ctx
+}
+
+func (ts *testStream) Send(ptx *PendingTx) error {
+	ts.ch <- ptx
+	return nil
+}
@@ -0,0 +1,1450 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpcmempool.proto
+
+package rpcmempool
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	txs "github.com/hyperledger/burrow/txs"
+	github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
+	payload "github.com/hyperledger/burrow/txs/payload"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListTxsParam struct {
+	// Only list transactions with an input from this address
+	Address *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	// Maximum number of transactions to list (0 for all)
+	MaxTxs               uint64   `protobuf:"varint,2,opt,name=MaxTxs,proto3" json:"MaxTxs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTxsParam) Reset()         { *m = ListTxsParam{} }
+func (m *ListTxsParam) String() string { return proto.CompactTextString(m) }
+func (*ListTxsParam) ProtoMessage()    {}
+func (*ListTxsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{0}
+}
+func (m *ListTxsParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTxsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ListTxsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTxsParam.Merge(m, src)
+}
+func (m *ListTxsParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTxsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTxsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTxsParam proto.InternalMessageInfo
+
+func (m *ListTxsParam) GetMaxTxs() uint64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
+func (*ListTxsParam) XXX_MessageName() string {
+	return "rpcmempool.ListTxsParam"
+}
+
+type StreamTxsParam struct {
+	// Only stream transactions with an input from this address
+	Address *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	// Send the transactions already in the mempool before streaming additions
+	IncludeExisting      bool     `protobuf:"varint,2,opt,name=IncludeExisting,proto3" json:"IncludeExisting,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamTxsParam) Reset()         { *m = StreamTxsParam{} }
+func (m *StreamTxsParam) String() string { return proto.CompactTextString(m) }
+func (*StreamTxsParam) ProtoMessage()    {}
+func (*StreamTxsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{1}
+}
+func (m *StreamTxsParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamTxsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StreamTxsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamTxsParam.Merge(m, src)
+}
+func (m *StreamTxsParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamTxsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamTxsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamTxsParam proto.InternalMessageInfo
+
+func (m *StreamTxsParam) GetIncludeExisting() bool {
+	if m != nil {
+		return m.IncludeExisting
+	}
+	return false
+}
+
+func (*StreamTxsParam) XXX_MessageName() string {
+	return "rpcmempool.StreamTxsParam"
+}
+
+type PendingTxs struct {
+	Txs                  []*PendingTx `protobuf:"bytes,1,rep,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PendingTxs) Reset()         { *m = PendingTxs{} }
+func (m *PendingTxs) String() string { return proto.CompactTextString(m) }
+func (*PendingTxs) ProtoMessage()    {}
+func (*PendingTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{2}
+}
+func (m *PendingTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxs.Merge(m, src)
+}
+func (m *PendingTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxs proto.InternalMessageInfo
+
+func (m *PendingTxs) GetTxs() []*PendingTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (*PendingTxs) XXX_MessageName() string {
+	return "rpcmempool.PendingTxs"
+}
+
+type PendingTx struct {
+	// Transaction type
+	TxType github_com_hyperledger_burrow_txs_payload.Type `protobuf:"varint,1,opt,name=TxType,proto3,casttype=github.com/hyperledger/burrow/txs/payload.Type" json:"TxType,omitempty"`
+	// The hash of the transaction
+	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	// The inputs of the transaction with the sequence numbers they will induce
+	Inputs []*payload.TxInput `protobuf:"bytes,3,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	// The signed transaction with its decoded payload
+	Envelope             *txs.Envelope `protobuf:"bytes,4,opt,name=Envelope,proto3" json:"Envelope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{3}
+}
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetTxType() github_com_hyperledger_burrow_txs_payload.Type {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *PendingTx) GetInputs() []*payload.TxInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *PendingTx) GetEnvelope() *txs.Envelope {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+func (*PendingTx) XXX_MessageName() string {
+	return "rpcmempool.PendingTx"
+}
+
+type FlushParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FlushParam) Reset()         { *m = FlushParam{} }
+func (m *FlushParam) String() string { return proto.CompactTextString(m) }
+func (*FlushParam) ProtoMessage()    {}
+func (*FlushParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{4}
+}
+func (m *FlushParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlushParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FlushParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushParam.Merge(m, src)
+}
+func (m *FlushParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlushParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushParam proto.InternalMessageInfo
+
+func (*FlushParam) XXX_MessageName() string {
+	return "rpcmempool.FlushParam"
+}
+
+type FlushResult struct {
+	// Number of transactions removed
+	Flushed              uint64   `protobuf:"varint,1,opt,name=Flushed,proto3" json:"Flushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FlushResult) Reset()         { *m = FlushResult{} }
+func (m *FlushResult) String() string { return proto.CompactTextString(m) }
+func (*FlushResult) ProtoMessage()    {}
+func (*FlushResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c68184ea82b89b2, []int{5}
+}
+func (m *FlushResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlushResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FlushResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResult.Merge(m, src)
+}
+func (m *FlushResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlushResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushResult proto.InternalMessageInfo
+
+func (m *FlushResult) GetFlushed() uint64 {
+	if m != nil {
+		return m.Flushed
+	}
+	return 0
+}
+
+func (*FlushResult) XXX_MessageName() string {
+	return "rpcmempool.FlushResult"
+}
+func init() {
+	proto.RegisterType((*ListTxsParam)(nil), "rpcmempool.ListTxsParam")
+	golang_proto.RegisterType((*ListTxsParam)(nil), "rpcmempool.ListTxsParam")
+	proto.RegisterType((*StreamTxsParam)(nil), "rpcmempool.StreamTxsParam")
+	golang_proto.RegisterType((*StreamTxsParam)(nil), "rpcmempool.StreamTxsParam")
+	proto.RegisterType((*PendingTxs)(nil), "rpcmempool.PendingTxs")
+	golang_proto.RegisterType((*PendingTxs)(nil), "rpcmempool.PendingTxs")
+	proto.RegisterType((*PendingTx)(nil), "rpcmempool.PendingTx")
+	golang_proto.RegisterType((*PendingTx)(nil), "rpcmempool.PendingTx")
+	proto.RegisterType((*FlushParam)(nil), "rpcmempool.FlushParam")
+	golang_proto.RegisterType((*FlushParam)(nil), "rpcmempool.FlushParam")
+	proto.RegisterType((*FlushResult)(nil), "rpcmempool.FlushResult")
+	golang_proto.RegisterType((*FlushResult)(nil), "rpcmempool.FlushResult")
+}
+
+func init() { proto.RegisterFile("rpcmempool.proto", fileDescriptor_5c68184ea82b89b2) }
+func init() { golang_proto.RegisterFile("rpcmempool.proto", fileDescriptor_5c68184ea82b89b2) }
+
+var fileDescriptor_5c68184ea82b89b2 = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x65, 0x49, 0x48, 0x9a, 0x49, 0x02, 0xd5, 0x0a, 0x8a, 0xe5, 0x83, 0x13, 0xf9, 0x52, 0x23,
+	0x81, 0x5d, 0x05, 0x55, 0x42, 0xe2, 0x02, 0x91, 0x5a, 0xb5, 0x15, 0x91, 0x2a, 0x93, 0x13, 0x37,
+	0x27, 0x5e, 0x39, 0x96, 0x1c, 0xef, 0x6a, 0x77, 0x0d, 0xeb, 0x1f, 0xe0, 0xbb, 0x10, 0xa7, 0x1c,
+	0x39, 0xa2, 0x1e, 0x22, 0x94, 0xfe, 0x05, 0x5c, 0x90, 0x37, 0x8e, 0x93, 0x42, 0x55, 0x4e, 0xdc,
+	0x3c, 0x6f, 0x66, 0xdf, 0xbc, 0x99, 0x79, 0x86, 0x7d, 0xce, 0xa6, 0x73, 0x32, 0x67, 0x94, 0x26,
+	0x2e, 0xe3, 0x54, 0x52, 0x0c, 0x5b, 0xc4, 0x7c, 0x1c, 0xd1, 0x88, 0x6a, 0xd8, 0x2b, 0xbe, 0xd6,
+	0x15, 0x66, 0x4b, 0x2a, 0x51, 0x7e, 0x76, 0x59, 0x90, 0x27, 0x34, 0x08, 0xd7, 0xa1, 0xcd, 0xa1,
+	0xf3, 0x2e, 0x16, 0x72, 0xac, 0xc4, 0x65, 0xc0, 0x83, 0x39, 0xbe, 0x80, 0xe6, 0xdb, 0x30, 0xe4,
+	0x44, 0x08, 0x03, 0xf5, 0x91, 0xd3, 0x19, 0x1e, 0x5d, 0x2d, 0x7b, 0xcf, 0xa3, 0x58, 0xce, 0xb2,
+	0x89, 0x3b, 0xa5, 0x73, 0x6f, 0x96, 0x33, 0xc2, 0x13, 0x12, 0x46, 0x84, 0x7b, 0x93, 0x8c, 0x73,
+	0xfa, 0xc9, 0x9b, 0xf2, 0x9c, 0x49, 0xea, 0x96, 0xef, 0xfc, 0x0d, 0x01, 0x3e, 0x80, 0xc6, 0x28,
+	0x50, 0x63, 0x25, 0x8c, 0xfb, 0x7d, 0xe4, 0xd4, 0xfd, 0x32, 0xb2, 0x3f, 0x23, 0x78, 0xf8, 0x5e,
+	0x72, 0x12, 0xcc, 0xff, 0x4b, 0x5b, 0x07, 0x1e, 0x9d, 0xa7, 0xd3, 0x24, 0x0b, 0xc9, 0x89, 0x8a,
+	0x85, 0x8c, 0xd3, 0x48, 0xf7, 0xdf, 0xf3, 0xff, 0x84, 0xed, 0x63, 0x80, 0x4b, 0x92, 0x86, 0x71,
+	0x1a, 0x8d, 0x95, 0xc0, 0x87, 0x50, 0x2b, 0xb4, 0xa2, 0x7e, 0xcd, 0x69, 0x0f, 0x9e, 0xb8, 0x3b,
+	0x6b, 0xae, 0x8a, 0xfc, 0xa2, 0xc2, 0xfe, 0x85, 0xa0, 0x55, 0x41, 0xf8, 0x02, 0x1a, 0x63, 0x35,
+	0xce, 0x19, 0xd1, 0xca, 0xbb, 0xc3, 0xc1, 0xcf, 0x65, 0xcf, 0xbd, 0x5b, 0xb9, 0x54, 0xc2, 0xdb,
+	0x9c, 0xa1, 0x78, 0xe9, 0x97, 0x0c, 0x78, 0x54, 0x70, 0x9d, 0x05, 0x62, 0xa6, 0x15, 0x77, 0x86,
+	0xc7, 0x8b, 0x65, 0xef, 0xde, 0xd5, 0xb2, 0xf7, 0xe2, 0x6e, 0xbe, 0x49, 0x9c, 0x06, 0x3c, 0x77,
+	0xcf, 0x88, 0x1a, 0xe6, 0x92, 0x08, 0xbf, 0x24, 0xc1, 0x0e, 0x34, 0xce, 0x53, 0x96, 0x49, 0x61,
+	0xd4, 0xf4, 0x50, 0xfb, 0x6e, 0xd5, 0x55, 0xe9, 0x84, 0x5f, 0xe6, 0xf1, 0x33, 0xd8, 0x3b, 0x49,
+	0x3f, 0x92, 0x84, 0x32, 0x62, 0xd4, 0xfb, 0xc8, 0x69, 0x0f, 0xba, 0x6e, 0xe1, 0x99, 0x0d, 0xe8,
+	0x57, 0x69, 0xbb, 0x03, 0x70, 0x9a, 0x64, 0x62, 0xa6, 0x0f, 0x67, 0x1f, 0x42, 0x5b, 0x47, 0x3e,
+	0x11, 0x59, 0x22, 0xb1, 0x01, 0x4d, 0x1d, 0x92, 0x50, 0x6f, 0xa3, 0xee, 0x6f, 0xc2, 0xc1, 0x57,
+	0x04, 0xcd, 0xd1, 0x7a, 0x9f, 0xf8, 0x35, 0x34, 0x4b, 0xd3, 0x61, 0x63, 0x77, 0xcf, 0xbb, 0x4e,
+	0x34, 0x0f, 0x6e, 0xbd, 0x80, 0xc0, 0x6f, 0xa0, 0x55, 0x99, 0x07, 0x9b, 0xbb, 0x45, 0x37, 0x3d,
+	0x65, 0xde, 0x7e, 0xc2, 0x23, 0x84, 0x5f, 0xc1, 0x03, 0xad, 0x0a, 0xdf, 0x68, 0xb1, 0x1d, 0xca,
+	0x7c, 0xfa, 0x17, 0xbe, 0x1e, 0x6f, 0x78, 0xba, 0x58, 0x59, 0xe8, 0xdb, 0xca, 0x42, 0xdf, 0x57,
+	0x16, 0xfa, 0xb1, 0xb2, 0xd0, 0x97, 0x6b, 0x0b, 0x2d, 0xae, 0x2d, 0xf4, 0xe1, 0x1f, 0x5e, 0xe5,
+	0x6c, 0xea, 0x6d, 0x39, 0x27, 0x0d, 0xfd, 0xf3, 0xbd, 0xfc, 0x3d, 0x00, 0xd5, 0x52, 0xc3, 0xa4,
+	0xcc, 0x03, 0x00, 0x00,
+}
+
+func (m *ListTxsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTxsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTxsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxTxs != 0 {
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.MaxTxs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Address != nil {
+		{
+			size := m.Address.Size()
+			i -= size
+			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcmempool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamTxsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamTxsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamTxsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeExisting {
+		i--
+		if m.IncludeExisting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Address != nil {
+		{
+			size := m.Address.Size()
+			i -= size
+			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcmempool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcmempool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Envelope != nil {
+		{
+			size, err := m.Envelope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcmempool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcmempool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TxHash.Size()
+		i -= size
+		if _, err := m.TxHash.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcmempool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TxType != 0 {
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Flushed != 0 {
+		i = encodeVarintRpcmempool(dAtA, i, uint64(m.Flushed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcmempool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcmempool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListTxsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.MaxTxs != 0 {
+		n += 1 + sovRpcmempool(uint64(m.MaxTxs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamTxsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.IncludeExisting {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PendingTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovRpcmempool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PendingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovRpcmempool(uint64(m.TxType))
+	}
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcmempool(uint64(l))
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovRpcmempool(uint64(l))
+		}
+	}
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpcmempool(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Flushed != 0 {
+		n += 1 + sovRpcmempool(uint64(m.Flushed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcmempool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcmempool(x uint64) (n int) {
+	return sovRpcmempool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListTxsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTxsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTxsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			m.MaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamTxsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamTxsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamTxsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeExisting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeExisting = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTxs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTxs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTxs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &PendingTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= github_com_hyperledger_burrow_txs_payload.Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &payload.TxInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flushed", wireType)
+			}
+			m.Flushed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flushed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcmempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcmempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpcmempool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpcmempool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcmempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpcmempool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRpcmempool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRpcmempool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRpcmempool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcmempool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRpcmempool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package rpcmempool
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// MempoolClient is the client API for Mempool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MempoolClient interface {
+	// List the transactions waiting in the mempool in the order they will be proposed
+	ListTxs(ctx context.Context, in *ListTxsParam, opts ...grpc.CallOption) (*PendingTxs, error)
+	// Stream transactions as they are added to the mempool
+	StreamTxs(ctx context.Context, in *StreamTxsParam, opts ...grpc.CallOption) (Mempool_StreamTxsClient, error)
+	// Remove every transaction from the mempool (only authenticated clients may call this)
+	Flush(ctx context.Context, in *FlushParam, opts ...grpc.CallOption) (*FlushResult, error)
+}
+
+type mempoolClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMempoolClient(cc grpc.ClientConnInterface) MempoolClient {
+	return &mempoolClient{cc}
+}
+
+func (c *mempoolClient) ListTxs(ctx context.Context, in *ListTxsParam, opts ...grpc.CallOption) (*PendingTxs, error) {
+	out := new(PendingTxs)
+	err := c.cc.Invoke(ctx, "/rpcmempool.Mempool/ListTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolClient) StreamTxs(ctx context.Context, in *StreamTxsParam, opts ...grpc.CallOption) (Mempool_StreamTxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Mempool_serviceDesc.Streams[0], "/rpcmempool.Mempool/StreamTxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolStreamTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mempool_StreamTxsClient interface {
+	Recv() (*PendingTx, error)
+	grpc.ClientStream
+}
+
+type mempoolStreamTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolStreamTxsClient) Recv() (*PendingTx, error) {
+	m := new(PendingTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mempoolClient) Flush(ctx context.Context, in *FlushParam, opts ...grpc.CallOption) (*FlushResult, error) {
+	out := new(FlushResult)
+	err := c.cc.Invoke(ctx, "/rpcmempool.Mempool/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolServer is the server API for Mempool service.
+// All implementations must embed UnimplementedMempoolServer
+// for forward compatibility
+type MempoolServer interface {
+	// List the transactions waiting in the mempool in the order they will be proposed
+	ListTxs(context.Context, *ListTxsParam) (*PendingTxs, error)
+	// Stream transactions as they are added to the mempool
+	StreamTxs(*StreamTxsParam, Mempool_StreamTxsServer) error
+	// Remove every transaction from the mempool (only authenticated clients may call this)
+	Flush(context.Context, *FlushParam) (*FlushResult, error)
+	mustEmbedUnimplementedMempoolServer()
+}
+
+// UnimplementedMempoolServer must be embedded to have forward compatible implementations.
+type UnimplementedMempoolServer struct {
+}
+
+func (UnimplementedMempoolServer) ListTxs(context.Context, *ListTxsParam) (*PendingTxs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTxs not implemented")
+}
+func (UnimplementedMempoolServer) StreamTxs(*StreamTxsParam, Mempool_StreamTxsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTxs not implemented")
+}
+func (UnimplementedMempoolServer) Flush(context.Context, *FlushParam) (*FlushResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (UnimplementedMempoolServer) mustEmbedUnimplementedMempoolServer() {}
+
+// UnsafeMempoolServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MempoolServer will
+// result in compilation errors.
+type UnsafeMempoolServer interface {
+	mustEmbedUnimplementedMempoolServer()
+}
+
+func RegisterMempoolServer(s grpc.ServiceRegistrar, srv MempoolServer) {
+	s.RegisterService(&_Mempool_serviceDesc, srv)
+}
+
+func _Mempool_ListTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTxsParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).ListTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcmempool.Mempool/ListTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).ListTxs(ctx, req.(*ListTxsParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mempool_StreamTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTxsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServer).StreamTxs(m, &mempoolStreamTxsServer{stream})
+}
+
+type Mempool_StreamTxsServer interface {
+	Send(*PendingTx) error
+	grpc.ServerStream
+}
+
+type mempoolStreamTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolStreamTxsServer) Send(m *PendingTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mempool_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcmempool.Mempool/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).Flush(ctx, req.(*FlushParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Mempool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcmempool.Mempool",
+	HandlerType: (*MempoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTxs",
+			Handler:    _Mempool_ListTxs_Handler,
+		},
+		{
+			MethodName: "Flush",
+			Handler:    _Mempool_Flush_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTxs",
+			Handler:       _Mempool_StreamTxs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcmempool.proto",
+}
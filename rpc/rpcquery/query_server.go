@@ -13,9 +13,12 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/liveness"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
 	"github.com/hyperledger/burrow/execution/registry"
+	"github.com/hyperledger/burrow/execution/staking"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
@@ -42,7 +45,10 @@ type QueryState interface {
 	names.IterableReader
 	registry.IterableReader
 	proposal.IterableReader
+	liveness.IterableReader
+	staking.IterableReader
 	validator.History
+	StateDiff(height uint64) (*exec.StateDiff, error)
 }
 
 func NewQueryServer(state QueryState, blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
@@ -75,36 +81,10 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 	var contractMeta *acm.ContractMeta
 	var err error
 	if param.Address != nil {
-		acc, err := qs.state.GetAccount(*param.Address)
+		contractMeta, err = getContractMeta(qs.state, *param.Address)
 		if err != nil {
 			return metadata, err
 		}
-		if acc != nil && acc.CodeHash != nil {
-			codehash := acc.CodeHash
-			if acc.Forebear != nil {
-				acc, err = qs.state.GetAccount(*acc.Forebear)
-				if err != nil {
-					return metadata, err
-				}
-			}
-
-			for _, m := range acc.ContractMeta {
-				if bytes.Equal(m.CodeHash, codehash) {
-					contractMeta = m
-					break
-				}
-			}
-
-			if contractMeta == nil {
-				deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, *param.Address)
-				for _, m := range acc.ContractMeta {
-					if bytes.Equal(m.CodeHash, deployCodehash) {
-						contractMeta = m
-						break
-					}
-				}
-			}
-		}
 	} else if param.MetadataHash != nil {
 		contractMeta = &acm.ContractMeta{
 			MetadataHash: *param.MetadataHash,
@@ -113,15 +93,64 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 	if contractMeta == nil {
 		return metadata, nil
 	}
+	metadata.Metadata, err = readMetadata(qs.state, contractMeta)
+	return metadata, err
+}
+
+type MetadataGetter interface {
+	acmstate.AccountGetter
+	acmstate.MetadataReader
+}
+
+// GetContractMetadata returns the metadata (including ABI) registered when the contract at address was deployed or an
+// empty string if none is known
+func GetContractMetadata(st MetadataGetter, address crypto.Address) (string, error) {
+	contractMeta, err := getContractMeta(st, address)
+	if err != nil || contractMeta == nil {
+		return "", err
+	}
+	return readMetadata(st, contractMeta)
+}
+
+func getContractMeta(st acmstate.AccountGetter, address crypto.Address) (*acm.ContractMeta, error) {
+	acc, err := st.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil || acc.CodeHash == nil {
+		return nil, nil
+	}
+	codehash := acc.CodeHash
+	if acc.Forebear != nil {
+		acc, err = st.GetAccount(*acc.Forebear)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range acc.ContractMeta {
+		if bytes.Equal(m.CodeHash, codehash) {
+			return m, nil
+		}
+	}
+
+	deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, address)
+	for _, m := range acc.ContractMeta {
+		if bytes.Equal(m.CodeHash, deployCodehash) {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+func readMetadata(st acmstate.MetadataReader, contractMeta *acm.ContractMeta) (string, error) {
 	if contractMeta.Metadata != "" {
 		// Looks like the metadata is already memoised - (e.g. by native.State)
-		metadata.Metadata = contractMeta.Metadata
-	} else {
-		var metadataHash acmstate.MetadataHash
-		copy(metadataHash[:], contractMeta.MetadataHash)
-		metadata.Metadata, err = qs.state.GetMetadata(metadataHash)
+		return contractMeta.Metadata, nil
 	}
-	return metadata, err
+	var metadataHash acmstate.MetadataHash
+	copy(metadataHash[:], contractMeta.MetadataHash)
+	return st.GetMetadata(metadataHash)
 }
 
 func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
@@ -186,6 +215,60 @@ func (qs *queryServer) GetValidatorSet(ctx context.Context, param *GetValidatorS
 	}, nil
 }
 
+func (qs *queryServer) GetValidatorLiveness(ctx context.Context, param *GetValidatorLivenessParam) (*ValidatorLiveness, error) {
+	result := &ValidatorLiveness{
+		Height: qs.blockchain.LastBlockHeight(),
+	}
+	if param.Address != nil {
+		l, err := qs.state.GetLiveness(*param.Address)
+		if err != nil {
+			return nil, err
+		}
+		if l == nil {
+			return nil, status.Errorf(codes.NotFound, "no liveness recorded for validator %v", *param.Address)
+		}
+		result.Set = append(result.Set, l)
+		return result, nil
+	}
+	err := qs.state.IterateLiveness(func(l *liveness.Liveness) error {
+		result.Set = append(result.Set, l)
+		return nil
+	})
+	return result, err
+}
+
+func (qs *queryServer) ListBonds(param *ListBondsParam, stream Query_ListBondsServer) error {
+	return qs.state.IterateBonds(func(bond *staking.Bond) error {
+		if bond.IsDelegation() || !param.matches(bond.Validator, bond.Delegator) {
+			return nil
+		}
+		return stream.Send(bond)
+	})
+}
+
+func (qs *queryServer) ListDelegations(param *ListBondsParam, stream Query_ListDelegationsServer) error {
+	return qs.state.IterateBonds(func(bond *staking.Bond) error {
+		if !bond.IsDelegation() || !param.matches(bond.Validator, bond.Delegator) {
+			return nil
+		}
+		return stream.Send(bond)
+	})
+}
+
+func (qs *queryServer) ListUnbondings(param *ListBondsParam, stream Query_ListUnbondingsServer) error {
+	return qs.state.IterateUnbondings(func(unbonding *staking.Unbonding) error {
+		if !param.matches(unbonding.Validator, unbonding.Delegator) {
+			return nil
+		}
+		return stream.Send(unbonding)
+	})
+}
+
+func (param *ListBondsParam) matches(validator, delegator crypto.Address) bool {
+	return (param.Validator == nil || *param.Validator == validator) &&
+		(param.Delegator == nil || *param.Delegator == delegator)
+}
+
 func (qs *queryServer) GetValidatorSetHistory(ctx context.Context, param *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error) {
 	lookback := int(param.IncludePrevious)
 	switch {
@@ -256,6 +339,19 @@ func (qs *queryServer) GetStats(ctx context.Context, param *GetStatsParam) (*Sta
 	}, nil
 }
 
+// GetStateDiff returns the changes made to state by the block at Height, or by the latest block if Height is zero
+func (qs *queryServer) GetStateDiff(ctx context.Context, param *GetStateDiffParam) (*exec.StateDiff, error) {
+	height := param.Height
+	lastHeight := qs.blockchain.LastBlockHeight()
+	if height == 0 {
+		height = lastHeight
+	} else if height > lastHeight {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no state diff for height %d since last block height is %d",
+			height, lastHeight))
+	}
+	return qs.state.StateDiff(height)
+}
+
 // Tendermint and blocks
 
 func (qs *queryServer) GetBlockHeader(ctx context.Context, param *GetBlockParam) (*tmproto.Header, error) {
@@ -16,8 +16,11 @@ import (
 	validator "github.com/hyperledger/burrow/acm/validator"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	_ "github.com/hyperledger/burrow/execution/exec"
+	liveness "github.com/hyperledger/burrow/execution/liveness"
 	_ "github.com/hyperledger/burrow/execution/names"
 	registry "github.com/hyperledger/burrow/execution/registry"
+	_ "github.com/hyperledger/burrow/execution/staking"
 	_ "github.com/hyperledger/burrow/rpc"
 	payload "github.com/hyperledger/burrow/txs/payload"
 	_ "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -566,6 +569,145 @@ func (*GetValidatorSetHistoryParam) XXX_MessageName() string {
 	return "rpcquery.GetValidatorSetHistoryParam"
 }
 
+type GetValidatorLivenessParam struct {
+	// Only return the liveness of this validator if set
+	Address              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *GetValidatorLivenessParam) Reset()         { *m = GetValidatorLivenessParam{} }
+func (m *GetValidatorLivenessParam) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorLivenessParam) ProtoMessage()    {}
+func (*GetValidatorLivenessParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{12}
+}
+func (m *GetValidatorLivenessParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorLivenessParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GetValidatorLivenessParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorLivenessParam.Merge(m, src)
+}
+func (m *GetValidatorLivenessParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorLivenessParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorLivenessParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorLivenessParam proto.InternalMessageInfo
+
+func (*GetValidatorLivenessParam) XXX_MessageName() string {
+	return "rpcquery.GetValidatorLivenessParam"
+}
+
+type ValidatorLiveness struct {
+	Height               uint64               `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Set                  []*liveness.Liveness `protobuf:"bytes,2,rep,name=Set,proto3" json:"Set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ValidatorLiveness) Reset()         { *m = ValidatorLiveness{} }
+func (m *ValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiveness) ProtoMessage()    {}
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{13}
+}
+func (m *ValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiveness.Merge(m, src)
+}
+func (m *ValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiveness proto.InternalMessageInfo
+
+func (m *ValidatorLiveness) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorLiveness) GetSet() []*liveness.Liveness {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (*ValidatorLiveness) XXX_MessageName() string {
+	return "rpcquery.ValidatorLiveness"
+}
+
+type ListBondsParam struct {
+	// Only return those with this validator if set
+	Validator *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator,omitempty"`
+	// Only return those with this delegator if set
+	Delegator            *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Delegator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Delegator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *ListBondsParam) Reset()         { *m = ListBondsParam{} }
+func (m *ListBondsParam) String() string { return proto.CompactTextString(m) }
+func (*ListBondsParam) ProtoMessage()    {}
+func (*ListBondsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{14}
+}
+func (m *ListBondsParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBondsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ListBondsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBondsParam.Merge(m, src)
+}
+func (m *ListBondsParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBondsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBondsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBondsParam proto.InternalMessageInfo
+
+func (*ListBondsParam) XXX_MessageName() string {
+	return "rpcquery.ListBondsParam"
+}
+
 type NetworkRegistry struct {
 	Set                  []*RegisteredValidator `protobuf:"bytes,1,rep,name=Set,proto3" json:"Set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -577,7 +719,7 @@ func (m *NetworkRegistry) Reset()         { *m = NetworkRegistry{} }
 func (m *NetworkRegistry) String() string { return proto.CompactTextString(m) }
 func (*NetworkRegistry) ProtoMessage()    {}
 func (*NetworkRegistry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{12}
+	return fileDescriptor_88e25d9b99e39f02, []int{15}
 }
 func (m *NetworkRegistry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +767,7 @@ func (m *RegisteredValidator) Reset()         { *m = RegisteredValidator{} }
 func (m *RegisteredValidator) String() string { return proto.CompactTextString(m) }
 func (*RegisteredValidator) ProtoMessage()    {}
 func (*RegisteredValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{13}
+	return fileDescriptor_88e25d9b99e39f02, []int{16}
 }
 func (m *RegisteredValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +814,7 @@ func (m *ValidatorSetHistory) Reset()         { *m = ValidatorSetHistory{} }
 func (m *ValidatorSetHistory) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetHistory) ProtoMessage()    {}
 func (*ValidatorSetHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{14}
+	return fileDescriptor_88e25d9b99e39f02, []int{17}
 }
 func (m *ValidatorSetHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,7 +862,7 @@ func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()    {}
 func (*ValidatorSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{15}
+	return fileDescriptor_88e25d9b99e39f02, []int{18}
 }
 func (m *ValidatorSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +916,7 @@ func (m *GetProposalParam) Reset()         { *m = GetProposalParam{} }
 func (m *GetProposalParam) String() string { return proto.CompactTextString(m) }
 func (*GetProposalParam) ProtoMessage()    {}
 func (*GetProposalParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{16}
+	return fileDescriptor_88e25d9b99e39f02, []int{19}
 }
 func (m *GetProposalParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +963,7 @@ func (m *ListProposalsParam) Reset()         { *m = ListProposalsParam{} }
 func (m *ListProposalsParam) String() string { return proto.CompactTextString(m) }
 func (*ListProposalsParam) ProtoMessage()    {}
 func (*ListProposalsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{17}
+	return fileDescriptor_88e25d9b99e39f02, []int{20}
 }
 func (m *ListProposalsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +1011,7 @@ func (m *ProposalResult) Reset()         { *m = ProposalResult{} }
 func (m *ProposalResult) String() string { return proto.CompactTextString(m) }
 func (*ProposalResult) ProtoMessage()    {}
 func (*ProposalResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{18}
+	return fileDescriptor_88e25d9b99e39f02, []int{21}
 }
 func (m *ProposalResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1064,7 @@ func (m *GetStatsParam) Reset()         { *m = GetStatsParam{} }
 func (m *GetStatsParam) String() string { return proto.CompactTextString(m) }
 func (*GetStatsParam) ProtoMessage()    {}
 func (*GetStatsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{19}
+	return fileDescriptor_88e25d9b99e39f02, []int{22}
 }
 func (m *GetStatsParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1105,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{20}
+	return fileDescriptor_88e25d9b99e39f02, []int{23}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1159,7 @@ func (m *GetBlockParam) Reset()         { *m = GetBlockParam{} }
 func (m *GetBlockParam) String() string { return proto.CompactTextString(m) }
 func (*GetBlockParam) ProtoMessage()    {}
 func (*GetBlockParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{21}
+	return fileDescriptor_88e25d9b99e39f02, []int{24}
 }
 func (m *GetBlockParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,6 +1194,54 @@ func (m *GetBlockParam) GetHeight() uint64 {
 func (*GetBlockParam) XXX_MessageName() string {
 	return "rpcquery.GetBlockParam"
 }
+
+type GetStateDiffParam struct {
+	// Defaults to the latest height
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateDiffParam) Reset()         { *m = GetStateDiffParam{} }
+func (m *GetStateDiffParam) String() string { return proto.CompactTextString(m) }
+func (*GetStateDiffParam) ProtoMessage()    {}
+func (*GetStateDiffParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{25}
+}
+func (m *GetStateDiffParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStateDiffParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GetStateDiffParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateDiffParam.Merge(m, src)
+}
+func (m *GetStateDiffParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStateDiffParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateDiffParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateDiffParam proto.InternalMessageInfo
+
+func (m *GetStateDiffParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*GetStateDiffParam) XXX_MessageName() string {
+	return "rpcquery.GetStateDiffParam"
+}
 func init() {
 	proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
 	golang_proto.RegisterType((*StatusParam)(nil), "rpcquery.StatusParam")
@@ -1077,6 +1267,12 @@ func init() {
 	golang_proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
 	proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
 	golang_proto.RegisterType((*GetValidatorSetHistoryParam)(nil), "rpcquery.GetValidatorSetHistoryParam")
+	proto.RegisterType((*GetValidatorLivenessParam)(nil), "rpcquery.GetValidatorLivenessParam")
+	golang_proto.RegisterType((*GetValidatorLivenessParam)(nil), "rpcquery.GetValidatorLivenessParam")
+	proto.RegisterType((*ValidatorLiveness)(nil), "rpcquery.ValidatorLiveness")
+	golang_proto.RegisterType((*ValidatorLiveness)(nil), "rpcquery.ValidatorLiveness")
+	proto.RegisterType((*ListBondsParam)(nil), "rpcquery.ListBondsParam")
+	golang_proto.RegisterType((*ListBondsParam)(nil), "rpcquery.ListBondsParam")
 	proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
 	golang_proto.RegisterType((*NetworkRegistry)(nil), "rpcquery.NetworkRegistry")
 	proto.RegisterType((*RegisteredValidator)(nil), "rpcquery.RegisteredValidator")
@@ -1097,79 +1293,92 @@ func init() {
 	golang_proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
 	proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
 	golang_proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
+	proto.RegisterType((*GetStateDiffParam)(nil), "rpcquery.GetStateDiffParam")
+	golang_proto.RegisterType((*GetStateDiffParam)(nil), "rpcquery.GetStateDiffParam")
 }
 
 func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
 
 var fileDescriptor_88e25d9b99e39f02 = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xff, 0x6f, 0xf3, 0x7d, 0xec, 0xd8, 0xed, 0x24, 0x7f, 0xd7, 0xdd, 0xb6, 0x4e, 0x19, 0x89,
-	0x34, 0x44, 0x65, 0x6d, 0x4c, 0xc3, 0x05, 0x5c, 0xa0, 0x3a, 0x80, 0x1d, 0x4a, 0xa3, 0xb0, 0x86,
-	0x56, 0x02, 0x09, 0x69, 0xb2, 0x3b, 0xb2, 0x57, 0x5d, 0xef, 0x98, 0xd9, 0xd9, 0x16, 0x3f, 0x06,
-	0x2f, 0xc0, 0x3d, 0x0f, 0xc0, 0x3d, 0x97, 0xb9, 0xe4, 0x12, 0xf5, 0x22, 0x42, 0xe9, 0x8b, 0xa0,
-	0x9d, 0x0f, 0xef, 0x47, 0xdc, 0x08, 0x10, 0xdc, 0x58, 0x73, 0xce, 0x9c, 0x0f, 0x9f, 0x33, 0xe7,
-	0xf7, 0x3b, 0x0b, 0x35, 0x3e, 0xf5, 0xbe, 0x4f, 0x28, 0x9f, 0x39, 0x53, 0xce, 0x04, 0x43, 0xeb,
-	0x46, 0xb6, 0xb7, 0x47, 0x6c, 0xc4, 0xa4, 0xb2, 0x9d, 0x9e, 0xd4, 0xbd, 0x7d, 0x47, 0xd0, 0xc8,
-	0xa7, 0x7c, 0x12, 0x44, 0xa2, 0x2d, 0x66, 0x53, 0x1a, 0xab, 0x5f, 0x7d, 0x5b, 0x89, 0xc8, 0x64,
-	0x2e, 0x6c, 0x10, 0x6f, 0xa2, 0x8f, 0xf5, 0x17, 0x24, 0x0c, 0x7c, 0x22, 0x18, 0xd7, 0x8a, 0x1a,
-	0xa7, 0xa3, 0x20, 0x16, 0x26, 0xad, 0xbd, 0xc1, 0xa7, 0x9e, 0x3e, 0x6e, 0x4e, 0xc9, 0x2c, 0x64,
-	0xc4, 0x57, 0x22, 0x0e, 0xa0, 0x32, 0x14, 0x44, 0x24, 0xf1, 0x09, 0xe1, 0x64, 0x82, 0xf6, 0xa0,
-	0xde, 0x0b, 0x99, 0xf7, 0xfc, 0xab, 0x60, 0x42, 0x9f, 0x05, 0x62, 0x1c, 0x44, 0x4d, 0xeb, 0x9e,
-	0xb5, 0xb7, 0xe1, 0x96, 0xd5, 0xa8, 0x03, 0x5b, 0x52, 0x35, 0xa4, 0x34, 0xca, 0x59, 0x5f, 0x93,
-	0xd6, 0x8b, 0xae, 0x30, 0x81, 0x7a, 0x9f, 0x8a, 0x47, 0x9e, 0xc7, 0x92, 0x48, 0xa8, 0x74, 0xc7,
-	0xb0, 0xf6, 0xc8, 0xf7, 0x39, 0x8d, 0x63, 0x99, 0xa6, 0xda, 0x7b, 0x78, 0x76, 0xbe, 0xf3, 0xbf,
-	0x57, 0xe7, 0x3b, 0x0f, 0x46, 0x81, 0x18, 0x27, 0xa7, 0x8e, 0xc7, 0x26, 0xed, 0xf1, 0x6c, 0x4a,
-	0x79, 0x48, 0xfd, 0x11, 0xe5, 0xed, 0xd3, 0x84, 0x73, 0xf6, 0xb2, 0xed, 0xf1, 0xd9, 0x54, 0x30,
-	0x47, 0xfb, 0xba, 0x26, 0x08, 0xfe, 0xc5, 0x82, 0xeb, 0x7d, 0x2a, 0x9e, 0x50, 0x41, 0x7c, 0x22,
-	0x88, 0x4a, 0xf2, 0x79, 0x39, 0x49, 0xe7, 0x1f, 0x27, 0x40, 0x5f, 0x43, 0xd5, 0x04, 0x1f, 0x90,
-	0x78, 0x2c, 0xcb, 0xad, 0xf6, 0xde, 0x7b, 0x75, 0xbe, 0xf3, 0xee, 0xd5, 0x01, 0x4f, 0x83, 0x88,
-	0xf0, 0x99, 0x33, 0xa0, 0x3f, 0xf4, 0x66, 0x82, 0xc6, 0x6e, 0x21, 0x0c, 0x7e, 0x00, 0x35, 0x23,
-	0xbb, 0x34, 0x4e, 0x42, 0x81, 0x6c, 0x58, 0x37, 0x1a, 0xfd, 0x02, 0x73, 0x19, 0xff, 0x6c, 0xc9,
-	0x4e, 0x0e, 0x05, 0xe3, 0x64, 0x44, 0xff, 0x93, 0x4e, 0xa2, 0xcf, 0x60, 0xe9, 0x31, 0x9d, 0xe9,
-	0xfa, 0xfe, 0x62, 0x2c, 0x5d, 0xe3, 0x33, 0xc6, 0xfd, 0xee, 0xc1, 0x07, 0x6e, 0x1a, 0x00, 0x7f,
-	0x0b, 0x55, 0xfd, 0x3f, 0x9f, 0x92, 0x30, 0xa1, 0xe8, 0x31, 0xac, 0xc8, 0x83, 0xfe, 0x97, 0x07,
-	0x3a, 0xf2, 0xdf, 0xec, 0x9e, 0x8a, 0x81, 0xdf, 0x81, 0x1b, 0x5f, 0x04, 0xb1, 0x19, 0x29, 0x3d,
-	0xc2, 0xdb, 0xb0, 0xf2, 0x65, 0x8a, 0x30, 0xdd, 0x36, 0x25, 0x60, 0x0c, 0xd5, 0x3e, 0x15, 0xc7,
-	0x64, 0xa2, 0xfb, 0x85, 0x60, 0x39, 0x15, 0xb4, 0x91, 0x3c, 0xe3, 0x5d, 0xa8, 0xa5, 0xe1, 0xd2,
-	0xf3, 0x95, 0xb1, 0x6e, 0xc1, 0xcd, 0x34, 0x16, 0x15, 0x2f, 0x19, 0x7f, 0xee, 0x6a, 0xa4, 0x49,
-	0x07, 0xdc, 0x80, 0xed, 0x3e, 0x15, 0x4f, 0x0d, 0x1c, 0x87, 0x54, 0x0d, 0x3a, 0xee, 0xc3, 0xed,
-	0x92, 0x7e, 0x10, 0xc4, 0x82, 0x69, 0xb7, 0x14, 0x76, 0x47, 0x91, 0x17, 0x26, 0x3e, 0x3d, 0xe1,
-	0xf4, 0x45, 0xc0, 0x12, 0xf5, 0x8a, 0x4b, 0x6e, 0x59, 0x8d, 0x7b, 0x50, 0x2f, 0x25, 0x46, 0x6d,
-	0x58, 0x1a, 0x52, 0xd1, 0xb4, 0xee, 0x2d, 0xed, 0x55, 0xba, 0x77, 0x9d, 0x39, 0xe3, 0x28, 0x03,
-	0xca, 0xa9, 0x3f, 0xcf, 0xeb, 0xa6, 0x96, 0xf8, 0x47, 0x0b, 0xb6, 0x16, 0x5c, 0xfe, 0xeb, 0x33,
-	0xb4, 0x0f, 0xcb, 0xc7, 0xcc, 0xa7, 0x72, 0x88, 0x2a, 0xdd, 0x86, 0x33, 0x27, 0xa5, 0x54, 0x7b,
-	0xe4, 0xd3, 0x48, 0x04, 0x62, 0xe6, 0x4a, 0x1b, 0xdc, 0x87, 0xad, 0x05, 0xdd, 0x41, 0x1d, 0x58,
-	0xd3, 0x47, 0x5d, 0x5f, 0x23, 0xab, 0x2f, 0x6f, 0xef, 0x1a, 0x33, 0x7c, 0x0c, 0xd5, 0xfc, 0x05,
-	0x6a, 0xc0, 0xea, 0x98, 0x06, 0xa3, 0xb1, 0x90, 0x35, 0x2d, 0xbb, 0x5a, 0x42, 0xbb, 0xaa, 0x6b,
-	0xd7, 0x64, 0xd4, 0x6d, 0x27, 0x63, 0xd0, 0x52, 0xb3, 0x76, 0x25, 0xa3, 0x9c, 0x70, 0x36, 0x65,
-	0x31, 0x09, 0xe7, 0xc3, 0x23, 0xd1, 0x2f, 0xbb, 0xe4, 0xca, 0x33, 0xee, 0x00, 0x4a, 0x87, 0xc7,
-	0x18, 0xea, 0x01, 0xb2, 0x61, 0x5d, 0x69, 0xa8, 0x2f, 0xad, 0xd7, 0xdd, 0xb9, 0x8c, 0x9f, 0x40,
-	0xcd, 0x58, 0x6b, 0xd0, 0x2f, 0x88, 0x8b, 0xee, 0xc3, 0x6a, 0x8f, 0x84, 0x21, 0x13, 0xba, 0x8d,
-	0x75, 0xc7, 0x10, 0xb8, 0x52, 0xbb, 0xfa, 0x1a, 0xd7, 0x61, 0x53, 0x92, 0x02, 0xd1, 0x40, 0xc0,
-	0x14, 0x56, 0xa4, 0x84, 0xf6, 0xe1, 0xba, 0x81, 0x48, 0x4a, 0xc5, 0x87, 0xe9, 0x9b, 0xa8, 0x66,
-	0x5c, 0xd2, 0xa7, 0xb4, 0x9e, 0xd7, 0xb1, 0x44, 0x1c, 0x9a, 0x27, 0x5c, 0x76, 0x17, 0x5d, 0xe1,
-	0xfb, 0x32, 0xaf, 0x24, 0x7c, 0x55, 0x73, 0x03, 0x56, 0x07, 0x85, 0x8e, 0x2b, 0xa9, 0xfb, 0xd3,
-	0x9a, 0x46, 0x13, 0xea, 0xc2, 0xaa, 0x5a, 0x3a, 0xe8, 0xff, 0xd9, 0x73, 0xe6, 0xd6, 0x90, 0x7d,
-	0x23, 0x55, 0x3b, 0xaa, 0x2b, 0xda, 0xf2, 0x00, 0x20, 0xdb, 0x1e, 0xe8, 0x56, 0xe6, 0x57, 0xda,
-	0x29, 0x76, 0xd5, 0x49, 0x17, 0xa3, 0x31, 0x3c, 0x84, 0x4a, 0x6e, 0x21, 0x20, 0xbb, 0xe0, 0x57,
-	0xd8, 0x13, 0x76, 0x33, 0xbb, 0x2b, 0x91, 0xf1, 0xc7, 0x32, 0xb7, 0xe6, 0xb1, 0x52, 0xee, 0x3c,
-	0x0b, 0xdb, 0x8d, 0x7c, 0x39, 0x39, 0xd6, 0xfb, 0x08, 0xaa, 0x79, 0xa2, 0x42, 0xb7, 0x33, 0xbb,
-	0x4b, 0x04, 0x56, 0x2c, 0xa0, 0x63, 0xa1, 0x36, 0xac, 0x69, 0xea, 0x42, 0x8d, 0x42, 0xea, 0x39,
-	0x9b, 0xd9, 0x55, 0x47, 0x7d, 0x19, 0x7c, 0x1a, 0xa5, 0x84, 0x70, 0x00, 0x1b, 0x73, 0x1e, 0x43,
-	0xcd, 0x62, 0xaa, 0x8c, 0xdc, 0x8a, 0x4e, 0x1d, 0x0b, 0xb9, 0x80, 0x2e, 0xd3, 0x1a, 0x7a, 0xab,
-	0x98, 0x72, 0x01, 0xe9, 0xd9, 0xb9, 0x86, 0x94, 0xbd, 0x8f, 0xe4, 0xa6, 0x2a, 0x00, 0xb2, 0x55,
-	0x08, 0x78, 0x89, 0x2a, 0xed, 0x37, 0x20, 0x1c, 0x7d, 0x07, 0x8d, 0xc5, 0x14, 0x8a, 0xde, 0x7e,
-	0x63, 0xc4, 0x3c, 0xc9, 0xda, 0x77, 0x17, 0x07, 0x36, 0x51, 0x3e, 0x94, 0x93, 0x62, 0x10, 0x59,
-	0x9a, 0x94, 0x02, 0xfe, 0xed, 0x32, 0x06, 0xd1, 0x11, 0x6c, 0x16, 0xc0, 0x8f, 0xee, 0x14, 0xbb,
-	0x5e, 0x64, 0x85, 0xfc, 0xa4, 0x15, 0x19, 0xa0, 0x63, 0xa1, 0x87, 0xb0, 0x6e, 0x60, 0x8c, 0x6e,
-	0x96, 0x26, 0xcd, 0x40, 0xdb, 0xae, 0x17, 0x61, 0x13, 0xa3, 0x43, 0xa8, 0x19, 0x10, 0x0e, 0x28,
-	0xf1, 0x29, 0x2f, 0xf9, 0x66, 0xf0, 0xb4, 0x9b, 0x4e, 0xf6, 0x8d, 0xe9, 0xa8, 0xaf, 0x4b, 0xe5,
-	0xd2, 0xfb, 0xe4, 0xec, 0xa2, 0x65, 0xfd, 0x76, 0xd1, 0xb2, 0x7e, 0xbf, 0x68, 0x59, 0x7f, 0x5c,
-	0xb4, 0xac, 0x5f, 0x5f, 0xb7, 0xac, 0xb3, 0xd7, 0x2d, 0xeb, 0x9b, 0xfd, 0xab, 0x17, 0x00, 0x9f,
-	0x7a, 0x6d, 0x93, 0xed, 0x74, 0x55, 0x7e, 0x58, 0xbe, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0xd8, 0xa5, 0xb6, 0xfb, 0x0a, 0x00, 0x00,
+	// 1230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x53, 0x1b, 0xb7,
+	0x17, 0xff, 0x2e, 0x10, 0x82, 0x1f, 0xc6, 0x0e, 0x0a, 0x5f, 0xc7, 0x2c, 0x89, 0x49, 0xd5, 0x96,
+	0x50, 0x9a, 0xae, 0x5d, 0x1a, 0x72, 0x68, 0x33, 0xd3, 0xc6, 0xd0, 0x1a, 0x9a, 0x84, 0x21, 0x4b,
+	0x93, 0x74, 0xda, 0x99, 0xce, 0x08, 0xaf, 0x62, 0xef, 0xb0, 0x5e, 0xb9, 0x5a, 0x99, 0xc4, 0x7f,
+	0x46, 0xff, 0x8c, 0xde, 0x7a, 0xe9, 0xbd, 0x47, 0x8e, 0x3d, 0x76, 0x72, 0x60, 0x3a, 0xe4, 0xde,
+	0xbf, 0xa1, 0xb3, 0x5a, 0x69, 0x7f, 0xd9, 0x30, 0x03, 0x93, 0x5e, 0x3c, 0xd2, 0xd3, 0x7b, 0x9f,
+	0xb7, 0x7a, 0xd2, 0xfb, 0x7c, 0x64, 0x28, 0xf1, 0x7e, 0xfb, 0xe7, 0x01, 0xe5, 0x43, 0xab, 0xcf,
+	0x99, 0x60, 0x68, 0x46, 0xcf, 0xcd, 0x85, 0x0e, 0xeb, 0x30, 0x69, 0xac, 0x87, 0xa3, 0x68, 0xdd,
+	0xbc, 0x29, 0xa8, 0xef, 0x50, 0xde, 0x73, 0x7d, 0x51, 0x17, 0xc3, 0x3e, 0x0d, 0xa2, 0x5f, 0xb5,
+	0x3a, 0xeb, 0x93, 0x5e, 0x3c, 0x29, 0x90, 0x76, 0x4f, 0x0d, 0xcb, 0x47, 0xc4, 0x73, 0x1d, 0x22,
+	0x18, 0x57, 0x86, 0x12, 0xa7, 0x1d, 0x37, 0x10, 0x3a, 0xad, 0x59, 0xf2, 0xdc, 0x23, 0xea, 0xd3,
+	0x40, 0xc7, 0xce, 0x05, 0x82, 0x1c, 0xba, 0x7e, 0x47, 0x43, 0xf1, 0x7e, 0x5b, 0xaf, 0xf4, 0xc9,
+	0xd0, 0x63, 0xc4, 0x51, 0x53, 0xa0, 0xaf, 0xa9, 0x5a, 0xc2, 0x2e, 0xcc, 0xee, 0x0b, 0x22, 0x06,
+	0xc1, 0x1e, 0xe1, 0xa4, 0x87, 0x56, 0xa1, 0xdc, 0xf4, 0x58, 0xfb, 0xf0, 0x3b, 0xb7, 0x47, 0x5f,
+	0xb8, 0xa2, 0xeb, 0xfa, 0x55, 0xe3, 0xb6, 0xb1, 0x5a, 0xb0, 0xf3, 0x66, 0xd4, 0x80, 0xeb, 0xd2,
+	0xb4, 0x4f, 0xa9, 0x9f, 0xf2, 0x9e, 0x90, 0xde, 0xe3, 0x96, 0x30, 0x81, 0x72, 0x8b, 0x8a, 0x87,
+	0xed, 0x36, 0x1b, 0xf8, 0x22, 0x4a, 0xb7, 0x0b, 0x57, 0x1f, 0x3a, 0x0e, 0xa7, 0x41, 0x20, 0xd3,
+	0x14, 0x9b, 0xf7, 0x8e, 0x4f, 0x96, 0xff, 0xf7, 0xe6, 0x64, 0xf9, 0x6e, 0xc7, 0x15, 0xdd, 0xc1,
+	0x81, 0xd5, 0x66, 0xbd, 0x7a, 0x77, 0xd8, 0xa7, 0xdc, 0xa3, 0x4e, 0x87, 0xf2, 0xfa, 0xc1, 0x80,
+	0x73, 0xf6, 0xaa, 0xde, 0xe6, 0xc3, 0xbe, 0x60, 0x96, 0x8a, 0xb5, 0x35, 0x08, 0xfe, 0xdd, 0x80,
+	0x6b, 0x2d, 0x2a, 0x9e, 0x50, 0x41, 0x1c, 0x22, 0x48, 0x94, 0xe4, 0xdb, 0x7c, 0x92, 0xc6, 0xa5,
+	0x13, 0xa0, 0x67, 0x50, 0xd4, 0xe0, 0xdb, 0x24, 0xe8, 0xca, 0xed, 0x16, 0x9b, 0x9f, 0xbe, 0x39,
+	0x59, 0xfe, 0xe4, 0x7c, 0xc0, 0x03, 0xd7, 0x27, 0x7c, 0x68, 0x6d, 0xd3, 0xd7, 0xcd, 0xa1, 0xa0,
+	0x81, 0x9d, 0x81, 0xc1, 0x77, 0xa1, 0xa4, 0xe7, 0x36, 0x0d, 0x06, 0x9e, 0x40, 0x26, 0xcc, 0x68,
+	0x8b, 0x3a, 0x81, 0x78, 0x8e, 0x7f, 0x35, 0x64, 0x25, 0xf7, 0x05, 0xe3, 0xa4, 0x43, 0xff, 0x93,
+	0x4a, 0xa2, 0x6f, 0x60, 0xf2, 0x11, 0x1d, 0x56, 0x27, 0x2e, 0x82, 0xa5, 0xf6, 0xf8, 0x82, 0x71,
+	0x67, 0x7d, 0xe3, 0xbe, 0x1d, 0x02, 0xe0, 0x1f, 0xa1, 0xa8, 0xbe, 0xf3, 0x39, 0xf1, 0x06, 0x14,
+	0x3d, 0x82, 0x2b, 0x72, 0xa0, 0xbe, 0x72, 0x43, 0x21, 0x5f, 0xb0, 0x7a, 0x11, 0x06, 0xfe, 0x08,
+	0xe6, 0x1f, 0xbb, 0x81, 0xbe, 0x52, 0xea, 0x0a, 0x2f, 0xc0, 0x95, 0xa7, 0x61, 0x33, 0xaa, 0xb2,
+	0x45, 0x13, 0x8c, 0xa1, 0xd8, 0xa2, 0x62, 0x97, 0xf4, 0x54, 0xbd, 0x10, 0x4c, 0x85, 0x13, 0xe5,
+	0x24, 0xc7, 0x78, 0x05, 0x4a, 0x21, 0x5c, 0x38, 0x3e, 0x17, 0x6b, 0x11, 0x6e, 0x84, 0x58, 0x54,
+	0xbc, 0x62, 0xfc, 0xd0, 0x56, 0x4d, 0x29, 0x03, 0x70, 0x05, 0x16, 0x5a, 0x54, 0x3c, 0xd7, 0x9d,
+	0xbb, 0x4f, 0xa3, 0x8b, 0x8e, 0x5b, 0xb0, 0x94, 0xb3, 0x6f, 0xbb, 0x81, 0x60, 0x7c, 0x18, 0xb7,
+	0xdd, 0x8e, 0xdf, 0xf6, 0x06, 0x0e, 0xdd, 0xe3, 0xf4, 0xc8, 0x65, 0x83, 0xe8, 0x14, 0x27, 0xed,
+	0xbc, 0x19, 0x77, 0x60, 0x31, 0x0d, 0xf4, 0x58, 0x51, 0xc0, 0x3b, 0xbf, 0xe9, 0xf8, 0x29, 0xcc,
+	0x8f, 0x64, 0x41, 0x15, 0x98, 0xde, 0xa6, 0x6e, 0xa7, 0x2b, 0x24, 0xfe, 0x94, 0xad, 0x66, 0xe8,
+	0x03, 0x98, 0xdc, 0xa7, 0xa2, 0x3a, 0x71, 0x7b, 0x72, 0x75, 0x76, 0x1d, 0x59, 0x31, 0x31, 0xe9,
+	0x40, 0x3b, 0x5c, 0xc6, 0xbf, 0x19, 0x51, 0x81, 0x9b, 0xcc, 0x77, 0x02, 0x7d, 0x6d, 0x0b, 0x71,
+	0x96, 0x4b, 0x7f, 0x73, 0x02, 0x11, 0xe2, 0x6d, 0x51, 0x8f, 0x76, 0x24, 0xde, 0xc4, 0x65, 0xf1,
+	0x62, 0x08, 0xdc, 0x84, 0x72, 0xee, 0x9c, 0x51, 0x3d, 0xda, 0xab, 0x21, 0xf7, 0x7a, 0xcb, 0x8a,
+	0xb5, 0x20, 0x72, 0xa0, 0x9c, 0x3a, 0xf1, 0xe7, 0x44, 0xdb, 0xfe, 0xc5, 0x80, 0xeb, 0x63, 0x16,
+	0xdf, 0x79, 0xcb, 0xae, 0xc1, 0xd4, 0x2e, 0x73, 0xa8, 0xdc, 0xf6, 0xec, 0x7a, 0xc5, 0x8a, 0xe5,
+	0x22, 0xb4, 0xee, 0x38, 0xd4, 0x17, 0xae, 0x18, 0xda, 0xd2, 0x07, 0xb7, 0xe0, 0xfa, 0x98, 0xcb,
+	0x88, 0x1a, 0x70, 0x55, 0x0d, 0xd5, 0xfe, 0x2a, 0xc9, 0xfe, 0xd2, 0xfe, 0xb6, 0x76, 0xc3, 0xbb,
+	0x50, 0x4c, 0x2f, 0x84, 0x37, 0xa4, 0x9b, 0xb9, 0x21, 0xd1, 0x0c, 0xad, 0xa4, 0x6f, 0xc8, 0x82,
+	0x95, 0x68, 0x5b, 0xae, 0x58, 0x2b, 0x92, 0xc0, 0xf7, 0x38, 0xeb, 0xb3, 0x80, 0x78, 0x71, 0xaf,
+	0x4a, 0xb2, 0x95, 0x55, 0xb2, 0xe5, 0x18, 0x37, 0x00, 0x85, 0x57, 0x49, 0x3b, 0xaa, 0xeb, 0x64,
+	0xc2, 0x4c, 0x64, 0xa1, 0x8e, 0xf4, 0x9e, 0xb1, 0xe3, 0x39, 0x7e, 0x02, 0x25, 0xed, 0xad, 0x38,
+	0x76, 0x0c, 0x2e, 0xba, 0x03, 0xd3, 0x4d, 0xe2, 0x79, 0x4c, 0xa8, 0x32, 0x96, 0x2d, 0xad, 0x9d,
+	0x91, 0xd9, 0x56, 0xcb, 0xb8, 0x0c, 0x73, 0x92, 0x83, 0x89, 0xe2, 0x1d, 0x4c, 0xe1, 0x8a, 0x9c,
+	0xa1, 0x35, 0xb8, 0xa6, 0x19, 0x29, 0x54, 0xbe, 0xcd, 0xf0, 0x4c, 0xa2, 0x62, 0x8c, 0xd8, 0x43,
+	0x15, 0x4d, 0xdb, 0xd8, 0x40, 0x6c, 0xea, 0x23, 0x9c, 0xb2, 0xc7, 0x2d, 0xe1, 0x3b, 0x32, 0xaf,
+	0xd4, 0xd7, 0x68, 0xcf, 0x67, 0xf4, 0x24, 0xfe, 0x18, 0xe6, 0xd5, 0x07, 0xd2, 0x2d, 0xf7, 0xe5,
+	0xcb, 0x73, 0x9d, 0xd7, 0xff, 0x29, 0x28, 0xa6, 0x43, 0xeb, 0x30, 0x1d, 0x3d, 0x08, 0xd0, 0xff,
+	0x93, 0xb3, 0x4f, 0x3d, 0x11, 0xcc, 0xf9, 0xd0, 0x6c, 0x45, 0x25, 0x54, 0x9e, 0x1b, 0x00, 0x89,
+	0xb2, 0xa3, 0xc5, 0x24, 0x2e, 0xa7, 0xf7, 0x66, 0xd1, 0x0a, 0xdf, 0x37, 0xda, 0x71, 0x13, 0x66,
+	0x53, 0x62, 0x8d, 0xcc, 0x4c, 0x5c, 0x46, 0xc3, 0xcd, 0x6a, 0xb2, 0x96, 0x13, 0xca, 0x2f, 0x65,
+	0x6e, 0xa5, 0x31, 0xb9, 0xdc, 0x69, 0x85, 0x34, 0x2b, 0xe9, 0xed, 0xa4, 0x14, 0xe9, 0x0b, 0x28,
+	0xa6, 0x45, 0x04, 0x2d, 0x25, 0x7e, 0x23, 0xe2, 0x92, 0xdd, 0x40, 0xc3, 0x40, 0x75, 0xb8, 0xaa,
+	0x64, 0x05, 0x55, 0x32, 0xa9, 0x63, 0xa5, 0x31, 0x8b, 0x56, 0xf4, 0xc0, 0xfb, 0xda, 0x0f, 0xd9,
+	0x63, 0x03, 0x0a, 0xb1, 0xc6, 0xa0, 0x6a, 0x36, 0x55, 0x22, 0x3c, 0xd9, 0xa0, 0x86, 0x81, 0x6c,
+	0x40, 0xa3, 0x92, 0x83, 0xde, 0xcb, 0xa6, 0x1c, 0x23, 0x48, 0x66, 0xaa, 0x20, 0xf9, 0xe8, 0x1d,
+	0xf9, 0x8a, 0xc8, 0x74, 0x6f, 0x2d, 0x03, 0x38, 0x22, 0x63, 0xe6, 0x19, 0x74, 0x80, 0x7e, 0x82,
+	0xca, 0x78, 0x79, 0x43, 0x1f, 0x9e, 0x89, 0x98, 0x16, 0x40, 0xf3, 0xd6, 0x78, 0x60, 0x8d, 0xf2,
+	0x7d, 0x56, 0x56, 0x63, 0x3d, 0x7a, 0x7f, 0x3c, 0x7a, 0x46, 0x15, 0xcd, 0xa5, 0x31, 0xd8, 0x31,
+	0xc2, 0x7d, 0x28, 0xc4, 0x92, 0x94, 0x3f, 0x8f, 0x44, 0xa7, 0xcc, 0x39, 0x4b, 0x3f, 0xae, 0x43,
+	0x63, 0xc3, 0x40, 0x0f, 0xa0, 0x1c, 0xba, 0x28, 0xa5, 0x70, 0x99, 0x7f, 0xa1, 0xe8, 0xaf, 0x22,
+	0x21, 0x7c, 0xe6, 0x1f, 0x30, 0xdf, 0x71, 0xfd, 0xce, 0x79, 0xc1, 0x28, 0x0e, 0x8e, 0xdd, 0x1b,
+	0x06, 0xfa, 0x5c, 0xf6, 0x8e, 0x26, 0xb4, 0x5c, 0xef, 0x64, 0xe8, 0xd3, 0xcc, 0x53, 0x18, 0xda,
+	0x81, 0xb9, 0x0c, 0x77, 0xa2, 0x9b, 0xd9, 0xe4, 0x59, 0x52, 0x4d, 0xf7, 0x5e, 0x96, 0x40, 0x1b,
+	0x06, 0xba, 0x07, 0x33, 0x9a, 0x05, 0xd1, 0x8d, 0x5c, 0xef, 0x69, 0x66, 0x34, 0xcb, 0x59, 0x22,
+	0x09, 0xd0, 0x26, 0x94, 0x34, 0x87, 0x6d, 0x53, 0xe2, 0x50, 0x9e, 0x8b, 0x4d, 0xd8, 0xcd, 0xac,
+	0x5a, 0xc9, 0x9f, 0x27, 0x2b, 0xfa, 0xdb, 0xa4, 0x42, 0x1e, 0xc8, 0x17, 0x5d, 0xcc, 0x6f, 0xe9,
+	0xbe, 0x1d, 0xe1, 0x3d, 0xb3, 0x6c, 0xc9, 0xff, 0x3c, 0xb1, 0xb5, 0xb9, 0x75, 0x7c, 0x5a, 0x33,
+	0xfe, 0x3c, 0xad, 0x19, 0x7f, 0x9d, 0xd6, 0x8c, 0xbf, 0x4f, 0x6b, 0xc6, 0x1f, 0x6f, 0x6b, 0xc6,
+	0xf1, 0xdb, 0x9a, 0xf1, 0xc3, 0xda, 0xf9, 0xea, 0xcb, 0xfb, 0xed, 0xba, 0x4e, 0x74, 0x30, 0x2d,
+	0xff, 0x44, 0x7d, 0xf6, 0xef, 0x00, 0x28, 0x86, 0x6d, 0x32, 0x12, 0x0e, 0x00, 0x00,
 }
 
 func (m *StatusParam) Marshal() (dAtA []byte, err error) {
@@ -1607,6 +1816,142 @@ func (m *GetValidatorSetHistoryParam) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *GetValidatorLivenessParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorLivenessParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorLivenessParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Address != nil {
+		{
+			size := m.Address.Size()
+			i -= size
+			if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Set[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBondsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBondsParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBondsParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Delegator != nil {
+		{
+			size := m.Delegator.Size()
+			i -= size
+			if _, err := m.Delegator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Validator != nil {
+		{
+			size := m.Validator.Size()
+			i -= size
+			if _, err := m.Validator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRpcquery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NetworkRegistry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1997,13 +2342,45 @@ func (m *GetBlockParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRpcquery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRpcquery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetStateDiffParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStateDiffParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStateDiffParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcquery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcquery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -2195,6 +2572,63 @@ func (m *GetValidatorSetHistoryParam) Size() (n int) {
 	return n
 }
 
+func (m *GetValidatorLivenessParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.Size()
+			n += 1 + l + sovRpcquery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBondsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Delegator != nil {
+		l = m.Delegator.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *NetworkRegistry) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2366,6 +2800,21 @@ func (m *GetBlockParam) Size() (n int) {
 	return n
 }
 
+func (m *GetStateDiffParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRpcquery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3397,6 +3846,317 @@ func (m *GetValidatorSetHistoryParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetValidatorLivenessParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorLivenessParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorLivenessParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, &liveness.Liveness{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBondsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBondsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBondsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Validator = &v
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Delegator = &v
+			if err := m.Delegator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NetworkRegistry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4278,6 +5038,76 @@ func (m *GetBlockParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetStateDiffParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateDiffParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateDiffParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRpcquery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
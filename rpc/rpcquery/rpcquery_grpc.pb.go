@@ -6,7 +6,9 @@ import (
 	context "context"
 
 	acm "github.com/hyperledger/burrow/acm"
+	exec "github.com/hyperledger/burrow/execution/exec"
 	names "github.com/hyperledger/burrow/execution/names"
+	staking "github.com/hyperledger/burrow/execution/staking"
 	rpc "github.com/hyperledger/burrow/rpc"
 	payload "github.com/hyperledger/burrow/txs/payload"
 	types "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -34,10 +36,21 @@ type QueryClient interface {
 	GetNetworkRegistry(ctx context.Context, in *GetNetworkRegistryParam, opts ...grpc.CallOption) (*NetworkRegistry, error)
 	GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error)
 	GetValidatorSetHistory(ctx context.Context, in *GetValidatorSetHistoryParam, opts ...grpc.CallOption) (*ValidatorSetHistory, error)
+	// GetValidatorLiveness returns the missed blocks and jail status of each validator whose signatures are tracked
+	GetValidatorLiveness(ctx context.Context, in *GetValidatorLivenessParam, opts ...grpc.CallOption) (*ValidatorLiveness, error)
+	// ListBonds streams the balances validators have bonded themselves
+	ListBonds(ctx context.Context, in *ListBondsParam, opts ...grpc.CallOption) (Query_ListBondsClient, error)
+	// ListDelegations streams the balances other accounts have bonded to validators
+	ListDelegations(ctx context.Context, in *ListBondsParam, opts ...grpc.CallOption) (Query_ListDelegationsClient, error)
+	// ListUnbondings streams unbonded balances waiting to be released in order of release height
+	ListUnbondings(ctx context.Context, in *ListBondsParam, opts ...grpc.CallOption) (Query_ListUnbondingsClient, error)
 	GetProposal(ctx context.Context, in *GetProposalParam, opts ...grpc.CallOption) (*payload.Ballot, error)
 	ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error)
 	GetStats(ctx context.Context, in *GetStatsParam, opts ...grpc.CallOption) (*Stats, error)
 	GetBlockHeader(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.Header, error)
+	// GetStateDiff returns the accounts, storage, names, and validator powers changed by a block with their values
+	// before and after the block
+	GetStateDiff(ctx context.Context, in *GetStateDiffParam, opts ...grpc.CallOption) (*exec.StateDiff, error)
 }
 
 type queryClient struct {
@@ -184,6 +197,111 @@ func (c *queryClient) GetValidatorSetHistory(ctx context.Context, in *GetValidat
 	return out, nil
 }
 
+func (c *queryClient) GetValidatorLiveness(ctx context.Context, in *GetValidatorLivenessParam, opts ...grpc.CallOption) (*ValidatorLiveness, error) {
+	out := new(ValidatorLiveness)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListBonds(ctx context.Context, in *ListBondsParam, opts ...grpc.CallOption) (Query_ListBondsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/rpcquery.Query/ListBonds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListBondsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListBondsClient interface {
+	Recv() (*staking.Bond, error)
+	grpc.ClientStream
+}
+
+type queryListBondsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListBondsClient) Recv() (*staking.Bond, error) {
+	m := new(staking.Bond)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ListDelegations(ctx context.Context, in *ListBondsParam, opts ...grpc.CallOption) (Query_ListDelegationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[3], "/rpcquery.Query/ListDelegations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListDelegationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListDelegationsClient interface {
+	Recv() (*staking.Bond, error)
+	grpc.ClientStream
+}
+
+type queryListDelegationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListDelegationsClient) Recv() (*staking.Bond, error) {
+	m := new(staking.Bond)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ListUnbondings(ctx context.Context, in *ListBondsParam, opts ...grpc.CallOption) (Query_ListUnbondingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[4], "/rpcquery.Query/ListUnbondings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListUnbondingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListUnbondingsClient interface {
+	Recv() (*staking.Unbonding, error)
+	grpc.ClientStream
+}
+
+type queryListUnbondingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListUnbondingsClient) Recv() (*staking.Unbonding, error) {
+	m := new(staking.Unbonding)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetProposal(ctx context.Context, in *GetProposalParam, opts ...grpc.CallOption) (*payload.Ballot, error) {
 	out := new(payload.Ballot)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetProposal", in, out, opts...)
@@ -194,7 +312,7 @@ func (c *queryClient) GetProposal(ctx context.Context, in *GetProposalParam, opt
 }
 
 func (c *queryClient) ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/rpcquery.Query/ListProposals", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[5], "/rpcquery.Query/ListProposals", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +361,15 @@ func (c *queryClient) GetBlockHeader(ctx context.Context, in *GetBlockParam, opt
 	return out, nil
 }
 
+func (c *queryClient) GetStateDiff(ctx context.Context, in *GetStateDiffParam, opts ...grpc.CallOption) (*exec.StateDiff, error) {
+	out := new(exec.StateDiff)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -258,10 +385,21 @@ type QueryServer interface {
 	GetNetworkRegistry(context.Context, *GetNetworkRegistryParam) (*NetworkRegistry, error)
 	GetValidatorSet(context.Context, *GetValidatorSetParam) (*ValidatorSet, error)
 	GetValidatorSetHistory(context.Context, *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error)
+	// GetValidatorLiveness returns the missed blocks and jail status of each validator whose signatures are tracked
+	GetValidatorLiveness(context.Context, *GetValidatorLivenessParam) (*ValidatorLiveness, error)
+	// ListBonds streams the balances validators have bonded themselves
+	ListBonds(*ListBondsParam, Query_ListBondsServer) error
+	// ListDelegations streams the balances other accounts have bonded to validators
+	ListDelegations(*ListBondsParam, Query_ListDelegationsServer) error
+	// ListUnbondings streams unbonded balances waiting to be released in order of release height
+	ListUnbondings(*ListBondsParam, Query_ListUnbondingsServer) error
 	GetProposal(context.Context, *GetProposalParam) (*payload.Ballot, error)
 	ListProposals(*ListProposalsParam, Query_ListProposalsServer) error
 	GetStats(context.Context, *GetStatsParam) (*Stats, error)
 	GetBlockHeader(context.Context, *GetBlockParam) (*types.Header, error)
+	// GetStateDiff returns the accounts, storage, names, and validator powers changed by a block with their values
+	// before and after the block
+	GetStateDiff(context.Context, *GetStateDiffParam) (*exec.StateDiff, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -299,6 +437,18 @@ func (UnimplementedQueryServer) GetValidatorSet(context.Context, *GetValidatorSe
 func (UnimplementedQueryServer) GetValidatorSetHistory(context.Context, *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetHistory not implemented")
 }
+func (UnimplementedQueryServer) GetValidatorLiveness(context.Context, *GetValidatorLivenessParam) (*ValidatorLiveness, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorLiveness not implemented")
+}
+func (UnimplementedQueryServer) ListBonds(*ListBondsParam, Query_ListBondsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBonds not implemented")
+}
+func (UnimplementedQueryServer) ListDelegations(*ListBondsParam, Query_ListDelegationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedQueryServer) ListUnbondings(*ListBondsParam, Query_ListUnbondingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListUnbondings not implemented")
+}
 func (UnimplementedQueryServer) GetProposal(context.Context, *GetProposalParam) (*payload.Ballot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
 }
@@ -311,6 +461,9 @@ func (UnimplementedQueryServer) GetStats(context.Context, *GetStatsParam) (*Stat
 func (UnimplementedQueryServer) GetBlockHeader(context.Context, *GetBlockParam) (*types.Header, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeader not implemented")
 }
+func (UnimplementedQueryServer) GetStateDiff(context.Context, *GetStateDiffParam) (*exec.StateDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateDiff not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -510,6 +663,87 @@ func _Query_GetValidatorSetHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorLivenessParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorLiveness(ctx, req.(*GetValidatorLivenessParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListBonds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBondsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListBonds(m, &queryListBondsServer{stream})
+}
+
+type Query_ListBondsServer interface {
+	Send(*staking.Bond) error
+	grpc.ServerStream
+}
+
+type queryListBondsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListBondsServer) Send(m *staking.Bond) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ListDelegations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBondsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListDelegations(m, &queryListDelegationsServer{stream})
+}
+
+type Query_ListDelegationsServer interface {
+	Send(*staking.Bond) error
+	grpc.ServerStream
+}
+
+type queryListDelegationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListDelegationsServer) Send(m *staking.Bond) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ListUnbondings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBondsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListUnbondings(m, &queryListUnbondingsServer{stream})
+}
+
+type Query_ListUnbondingsServer interface {
+	Send(*staking.Unbonding) error
+	grpc.ServerStream
+}
+
+type queryListUnbondingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListUnbondingsServer) Send(m *staking.Unbonding) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProposalParam)
 	if err := dec(in); err != nil {
@@ -585,6 +819,24 @@ func _Query_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateDiffParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStateDiff(ctx, req.(*GetStateDiffParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcquery.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -621,6 +873,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorSetHistory",
 			Handler:    _Query_GetValidatorSetHistory_Handler,
 		},
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _Query_GetValidatorLiveness_Handler,
+		},
 		{
 			MethodName: "GetProposal",
 			Handler:    _Query_GetProposal_Handler,
@@ -633,6 +889,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockHeader",
 			Handler:    _Query_GetBlockHeader_Handler,
 		},
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _Query_GetStateDiff_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -645,6 +905,21 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_ListNames_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListBonds",
+			Handler:       _Query_ListBonds_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDelegations",
+			Handler:       _Query_ListDelegations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListUnbondings",
+			Handler:       _Query_ListUnbondings_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListProposals",
 			Handler:       _Query_ListProposals_Handler,
@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// If set, clients must present a certificate signed by one of these CAs (mutual TLS)
+	ClientCAFile string `json:",omitempty" toml:",omitempty"`
+}
+
+func (tc *TLSConfig) Config() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(tc.CertFile, tc.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load TLS certificate: %w", err)
+	}
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if tc.ClientCAFile != "" {
+		bs, err := ioutil.ReadFile(tc.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read TLS client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no certificates found in TLS client CA file %s", tc.ClientCAFile)
+		}
+		conf.ClientCAs = pool
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return conf, nil
+}
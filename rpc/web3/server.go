@@ -285,6 +285,12 @@ func (srv *Server) Do(in RPCRequest) interface{} {
 		if err == nil {
 			out, err = srv.service.EthEstimateGas(req)
 		}
+	case "eth_feeHistory":
+		req := new(EthFeeHistoryParams)
+		err = ParamsToStruct(in.Params, req)
+		if err == nil {
+			out, err = srv.service.EthFeeHistory(req)
+		}
 	case "eth_gasPrice":
 		out, err = srv.service.EthGasPrice()
 	case "eth_getBalance":
@@ -22,6 +22,8 @@ type Service interface {
 	EthCoinbase() (*EthCoinbaseResult, error)
 	// Generates and returns an estimate of how much gas is necessary to allow the transaction to complete. The transaction will not be added to the blockchain. Note that the estimate may be significantly more than the amount of gas actually used by the transaction, for a variety of reasons including EVM mechanics and node performance.
 	EthEstimateGas(*EthEstimateGasParams) (*EthEstimateGasResult, error)
+	// Returns the base fee per gas, the ratio of gas used and the priority fees paid over a range of blocks
+	EthFeeHistory(*EthFeeHistoryParams) (*EthFeeHistoryResult, error)
 	// Returns the current price per gas in wei
 	EthGasPrice() (*EthGasPriceResult, error)
 	// Returns Ether balance of a given or account or contract
@@ -196,6 +198,27 @@ type EthEstimateGasResult struct {
 	// Hex representation of the integer
 	GasUsed string `json:"gasUsed"`
 }
+type EthFeeHistoryParams struct {
+	// Hex representation of the number of blocks in the requested range
+	BlockCount string `json:"blockCount"`
+	// The highest block of the requested range
+	NewestBlock string `json:"newestBlock"`
+	// Percentiles of the priority fees per gas in each block to return, weighted by gas used
+	RewardPercentiles []float64 `json:"rewardPercentiles"`
+}
+type FeeHistory struct {
+	// Lowest block number of the returned range
+	OldestBlock string `json:"oldestBlock"`
+	// Base fee per gas of each block in the range and of the block after
+	BaseFeePerGas []string `json:"baseFeePerGas"`
+	// Ratio of gas used to the gas limit of each block in the range
+	GasUsedRatio []float64 `json:"gasUsedRatio"`
+	// Priority fees per gas at the requested percentiles of each block in the range
+	Reward [][]string `json:"reward,omitempty"`
+}
+type EthFeeHistoryResult struct {
+	FeeHistory FeeHistory `json:"feeHistory"`
+}
 type EthGasPriceResult struct {
 	// Hex representation of the integer
 	GasPrice string `json:"gasPrice"`
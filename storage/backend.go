@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The number of writes we accumulate in a batch when copying between databases
+const copyBatchSize = 1000
+
+// The pure-Go database backends that may be selected for burrow and Tendermint state. boltdb and badgerdb are only
+// registered with tm-db in binaries built with the boltdb and badgerdb build tags (as our Makefile does).
+var SupportedBackends = []dbm.BackendType{
+	dbm.GoLevelDBBackend,
+	dbm.MemDBBackend,
+	dbm.BoltDBBackend,
+	dbm.BadgerDBBackend,
+}
+
+type StorageConfig struct {
+	// The database backend used for both burrow and Tendermint state, one of: goleveldb (the default), memdb,
+	// boltdb, badgerdb
+	Backend string `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultStorageConfig() *StorageConfig {
+	return &StorageConfig{
+		Backend: string(dbm.GoLevelDBBackend),
+	}
+}
+
+// Returns the configured backend, which defaults to goleveldb if conf is nil or no backend is given
+func (conf *StorageConfig) BackendType() (dbm.BackendType, error) {
+	if conf == nil || conf.Backend == "" {
+		return dbm.GoLevelDBBackend, nil
+	}
+	return ParseBackend(conf.Backend)
+}
+
+func ParseBackend(backend string) (dbm.BackendType, error) {
+	for _, supported := range SupportedBackends {
+		if backend == string(supported) {
+			return supported, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported storage backend '%s', must be one of: %v", backend, SupportedBackends)
+}
+
+// Opens (creating if necessary) the database name in dir with backend
+func NewDB(name string, backend dbm.BackendType, dir string) (dbm.DB, error) {
+	// Not all backends create their directory
+	if backend != dbm.MemDBBackend {
+		err := os.MkdirAll(dir, 0700)
+		if err != nil {
+			return nil, err
+		}
+	}
+	db, err := dbm.NewDB(name, backend, dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s database %s in %s (this binary may not have been built with "+
+			"support for %s): %w", backend, name, dir, backend, err)
+	}
+	return db, nil
+}
+
+// Returns the file or directory in which backend keeps the database name in dir, memdb has no such path
+func DBPath(name string, backend dbm.BackendType, dir string) string {
+	switch backend {
+	case dbm.MemDBBackend:
+		return ""
+	case dbm.BadgerDBBackend:
+		return filepath.Join(dir, name)
+	default:
+		return filepath.Join(dir, name+".db")
+	}
+}
+
+// Returns true if the database name has been created in dir by backend
+func DBExists(name string, backend dbm.BackendType, dir string) bool {
+	path := DBPath(name, backend, dir)
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Copies every key in src to dst, returning the number of keys copied
+func CopyDB(src, dst dbm.DB) (int, error) {
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	batch := dst.NewBatch()
+	var count int
+	for ; it.Valid(); it.Next() {
+		err = batch.Set(it.Key(), it.Value())
+		if err != nil {
+			return count, err
+		}
+		count++
+		if count%copyBatchSize == 0 {
+			err = batch.Write()
+			if err != nil {
+				return count, err
+			}
+			err = batch.Close()
+			if err != nil {
+				return count, err
+			}
+			batch = dst.NewBatch()
+		}
+	}
+	if err = it.Error(); err != nil {
+		return count, err
+	}
+	err = batch.WriteSync()
+	if err != nil {
+		return count, err
+	}
+	return count, batch.Close()
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestStorageConfig_BackendType(t *testing.T) {
+	var conf *StorageConfig
+	backend, err := conf.BackendType()
+	require.NoError(t, err)
+	assert.Equal(t, dbm.GoLevelDBBackend, backend)
+
+	backend, err = (&StorageConfig{Backend: "boltdb"}).BackendType()
+	require.NoError(t, err)
+	assert.Equal(t, dbm.BoltDBBackend, backend)
+
+	_, err = (&StorageConfig{Backend: "cleveldb"}).BackendType()
+	require.Error(t, err)
+}
+
+func TestDBPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("data", "state.db"), DBPath("state", dbm.GoLevelDBBackend, "data"))
+	assert.Equal(t, filepath.Join("data", "state.db"), DBPath("state", dbm.BoltDBBackend, "data"))
+	assert.Equal(t, filepath.Join("data", "state"), DBPath("state", dbm.BadgerDBBackend, "data"))
+	assert.Equal(t, "", DBPath("state", dbm.MemDBBackend, "data"))
+}
+
+func TestCopyDB(t *testing.T) {
+	src := dbm.NewMemDB()
+	n := copyBatchSize*2 + 7
+	for i := 0; i < n; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key%05d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	dst := dbm.NewMemDB()
+	count, err := CopyDB(src, dst)
+	require.NoError(t, err)
+	assert.Equal(t, n, count)
+	for i := 0; i < n; i++ {
+		value, err := dst.Get([]byte(fmt.Sprintf("key%05d", i)))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("value%d", i), string(value))
+	}
+}
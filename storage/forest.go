@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/golang/protobuf/proto"
 
@@ -71,6 +73,9 @@ type MutableForest struct {
 	dirty map[string]*RWTree
 	// List of dirty prefixes in deterministic order so we may loop over them on Save() and obtain a consistent commitTree hash
 	dirtyPrefixes []string
+	// Whether trees may hold versions discarded by loading an earlier version of the forest that must be cleared
+	// before they are first saved
+	clearDiscarded bool
 }
 
 // ImmutableForest contains much of the implementation for MutableForest yet it's external API is immutable
@@ -132,6 +137,22 @@ func (muf *MutableForest) Load(version int64) error {
 	return muf.commitsTree.Load(version, true)
 }
 
+// Load mutable forest from database at a version earlier than the last one saved, as when replaying blocks, deleting
+// any later versions of it and its trees. Trees that did not exist at version are cleared of versions saved by the
+// discarded versions of the forest before they are first saved again, which would otherwise clash. Since a tree is
+// cleared of everything stored under its prefix no prefix should be a prefix of another.
+func (muf *MutableForest) LoadForReplay(version int64) error {
+	muf.clearDiscarded = true
+	return muf.Load(version)
+}
+
+// Load mutable forest from database without deleting any later versions of it or its trees as Load does, the forest
+// should not be saved
+func (muf *MutableForest) LoadReadOnly(version int64) error {
+	muf.overwriting = false
+	return muf.commitsTree.Load(version, false)
+}
+
 func (muf *MutableForest) Save() (hash []byte, version int64, _ error) {
 	// Save each tree in forest that requires save
 	for _, prefix := range muf.dirtyPrefixes {
@@ -199,12 +220,34 @@ func (muf *MutableForest) Hash() []byte {
 	return muf.commitsTree.Hash()
 }
 
+// Get the global hash for all trees in this forest as saved at version
+func (muf *MutableForest) HashAtVersion(version int64) ([]byte, error) {
+	commitsTree, err := muf.commitsTree.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("MutableForest.HashAtVersion() could not get commits tree for version %d: %v",
+			version, err)
+	}
+	return commitsTree.Hash(), nil
+}
+
 // Get the current global version for all versions of all trees in this forest
 func (muf *MutableForest) Version() int64 {
 	return muf.commitsTree.Version()
 }
 
 func (muf *MutableForest) saveTree(prefix []byte, tree *RWTree) error {
+	commitID, err := muf.commitID(prefix)
+	if err != nil {
+		return err
+	}
+	if commitID.Version == 0 && muf.clearDiscarded {
+		// A tree with no commit in this version of the forest may have been saved by a later version that has since
+		// been discarded by LoadForReplay. Those versions would clash with the first version we are about to save.
+		err = clearDB(NewPrefixDB(muf.treeDB, string(prefix)))
+		if err != nil {
+			return fmt.Errorf("MutableForest.saveTree() could not clear discarded versions of tree: %v", err)
+		}
+	}
 	hash, version, err := tree.Save()
 	if err != nil {
 		return fmt.Errorf("MutableForest.saveTree() could not save tree: %v", err)
@@ -238,6 +281,19 @@ func (imf *ImmutableForest) Iterate(start, end []byte, ascending bool, fn func(p
 	})
 }
 
+// Checks that the tree stored under each prefix loads at the version recorded in its CommitID and has the recorded
+// hash. Trees are loaded afresh from the database (bypassing the cache) and fn is called for every prefix with a non-nil
+// err describing any inconsistency found.
+func (imf *ImmutableForest) Verify(fn func(prefix []byte, commitID *CommitID, err error) error) error {
+	return imf.commitsTree.Iterate(nil, nil, true, func(prefix []byte, value []byte) error {
+		commitID, err := unmarshalCommitID(value)
+		if err != nil {
+			return fn(prefix, nil, err)
+		}
+		return fn(prefix, commitID, imf.verifyTree(prefix, commitID))
+	})
+}
+
 func (imf *ImmutableForest) Dump() string {
 	dump := treeprint.New()
 	AddTreePrintTree("Commits", dump, imf.commitsTree)
@@ -251,6 +307,50 @@ func (imf *ImmutableForest) Dump() string {
 	return dump.String()
 }
 
+// Calls fn for each key whose value differs between the trees of forests exp and act in ascending order of prefix then
+// key. A value is nil if the key is absent from that forest. Only trees whose CommitIDs differ are read.
+func DiffForests(exp, act *ImmutableForest, fn func(prefix, key, expValue, actValue []byte) error) error {
+	expCommits, err := exp.commitIDs()
+	if err != nil {
+		return err
+	}
+	actCommits, err := act.commitIDs()
+	if err != nil {
+		return err
+	}
+	prefixes := make([]string, 0, len(expCommits))
+	for prefix := range expCommits {
+		prefixes = append(prefixes, prefix)
+	}
+	for prefix := range actCommits {
+		if _, ok := expCommits[prefix]; !ok {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		expCommit, actCommit := expCommits[prefix], actCommits[prefix]
+		if expCommit != nil && actCommit != nil && bytes.Equal(expCommit.Hash, actCommit.Hash) {
+			continue
+		}
+		expTree, err := exp.tree([]byte(prefix))
+		if err != nil {
+			return err
+		}
+		actTree, err := act.tree([]byte(prefix))
+		if err != nil {
+			return err
+		}
+		err = diffTrees(expTree, actTree, func(key, expValue, actValue []byte) error {
+			return fn([]byte(prefix), key, expValue, actValue)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Shared implementation - these methods
 
 // Lazy load tree
@@ -275,6 +375,22 @@ func (imf *ImmutableForest) commitID(prefix []byte) (*CommitID, error) {
 	return commitID, nil
 }
 
+func (imf *ImmutableForest) commitIDs() (map[string]*CommitID, error) {
+	commitIDs := make(map[string]*CommitID)
+	err := imf.commitsTree.Iterate(nil, nil, true, func(prefix []byte, value []byte) error {
+		commitID, err := unmarshalCommitID(value)
+		if err != nil {
+			return err
+		}
+		commitIDs[string(prefix)] = commitID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return commitIDs, nil
+}
+
 func (imf *ImmutableForest) loadOrCreateTree(prefix []byte) (*RWTree, error) {
 	const errHeader = "ImmutableForest.loadOrCreateTree():"
 	tree, err := imf.newTree(prefix)
@@ -296,6 +412,26 @@ func (imf *ImmutableForest) loadOrCreateTree(prefix []byte) (*RWTree, error) {
 	return tree, nil
 }
 
+func (imf *ImmutableForest) verifyTree(prefix []byte, commitID *CommitID) error {
+	if commitID.Version == 0 {
+		// Never saved so nothing to load
+		return nil
+	}
+	tree, err := NewRWTree(NewPrefixDB(imf.treeDB, string(prefix)), imf.cacheSize)
+	if err != nil {
+		return err
+	}
+	err = tree.Load(commitID.Version, false)
+	if err != nil {
+		return fmt.Errorf("could not load tree at version %d: %v", commitID.Version, err)
+	}
+	if !bytes.Equal(tree.Hash(), commitID.Hash) {
+		return fmt.Errorf("tree at version %d has hash %X but CommitID records hash %X", commitID.Version,
+			tree.Hash(), commitID.Hash)
+	}
+	return nil
+}
+
 // Create a new in-memory IAVL tree
 func (imf *ImmutableForest) newTree(prefix []byte) (*RWTree, error) {
 	p := string(prefix)
@@ -351,3 +487,25 @@ func unmarshalCommitID(bs []byte) (*CommitID, error) {
 	}
 	return commitID, nil
 }
+
+func clearDB(db dbm.DB) error {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	err = it.Close()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = db.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -107,6 +108,124 @@ func TestMutableForest_Load(t *testing.T) {
 	require.Equal(t, dump, forest.Dump())
 }
 
+func TestMutableForest_Verify(t *testing.T) {
+	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	for _, prefix := range []string{"foo", "bar"} {
+		tree, err := forest.Writer([]byte(prefix))
+		require.NoError(t, err)
+		tree.Set([]byte("key"), []byte(prefix))
+	}
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+
+	verified := make(map[string]error)
+	verify := func(prefix []byte, commitID *CommitID, err error) error {
+		verified[string(prefix)] = err
+		return nil
+	}
+	require.NoError(t, forest.Verify(verify))
+	assert.Equal(t, map[string]error{"foo": nil, "bar": nil}, verified)
+
+	// Record a commit that does not match the tree
+	require.NoError(t, forest.setCommit([]byte("bar"), []byte("not the hash"), 1))
+	require.NoError(t, forest.setCommit([]byte("baz"), nil, 2))
+	_, _, err = forest.commitsTree.Save()
+	require.NoError(t, err)
+
+	require.NoError(t, forest.Verify(verify))
+	assert.NoError(t, verified["foo"])
+	assert.Error(t, verified["bar"])
+	assert.Error(t, verified["baz"])
+}
+
+func TestMutableForest_SaveNewTree(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	setForest(t, forest, "foo", "key", "foo")
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+
+	// The first save of a tree whose prefix is a prefix of another's must not touch the other
+	setForest(t, forest, "f", "key", "f")
+	_, version, err := forest.Save()
+	require.NoError(t, err)
+
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.Load(version))
+	require.NoError(t, forest.Verify(func(prefix []byte, commitID *CommitID, err error) error {
+		return err
+	}))
+	tree, err := forest.Reader([]byte("foo"))
+	require.NoError(t, err)
+	value, err := tree.Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("foo"), value)
+}
+
+func TestMutableForest_LoadForReplay(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	setForest(t, forest, "foo", "key", "1")
+	_, version, err := forest.Save()
+	require.NoError(t, err)
+	setForest(t, forest, "bar", "key", "2")
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+
+	// The tree created by the discarded version is saved afresh
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.LoadForReplay(version))
+	setForest(t, forest, "bar", "key", "3")
+	_, _, err = forest.Save()
+	require.NoError(t, err)
+	tree, err := forest.Reader([]byte("bar"))
+	require.NoError(t, err)
+	value, err := tree.Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("3"), value)
+}
+
+func TestDiffForests(t *testing.T) {
+	set := func(forest *MutableForest, prefix, key, value string) {
+		tree, err := forest.Writer([]byte(prefix))
+		require.NoError(t, err)
+		tree.Set([]byte(key), []byte(value))
+	}
+	exp, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	act, err := NewMutableForest(dbm.NewMemDB(), 100)
+	require.NoError(t, err)
+	for _, forest := range []*MutableForest{exp, act} {
+		set(forest, "a", "same", "1")
+		set(forest, "b", "same", "2")
+	}
+	set(exp, "b", "changed", "expected")
+	set(act, "b", "changed", "actual")
+	set(act, "b", "added", "3")
+	set(exp, "c", "removed", "4")
+	_, _, err = exp.Save()
+	require.NoError(t, err)
+	_, _, err = act.Save()
+	require.NoError(t, err)
+
+	var diffs []string
+	err = DiffForests(exp.ImmutableForest, act.ImmutableForest, func(prefix, key, expValue, actValue []byte) error {
+		diffs = append(diffs, fmt.Sprintf("%s/%s: %q -> %q", prefix, key, expValue, actValue))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`b/changed: "expected" -> "actual"`,
+		`b/added: "" -> "3"`,
+		`c/removed: "4" -> ""`,
+	}, diffs)
+}
+
 func TestSorted(t *testing.T) {
 	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
 	require.NoError(t, err)
@@ -115,6 +115,13 @@ func (rwt *RWTree) GetImmutable(version int64) (*ImmutableTree, error) {
 	return rwt.tree.GetImmutable(version)
 }
 
+// Get a value from the working tree including any writes since last save
+func (rwt *RWTree) GetWriteTree(key []byte) ([]byte, error) {
+	rwt.RLock()
+	defer rwt.RUnlock()
+	return rwt.tree.Get(key)
+}
+
 func (rwt *RWTree) IterateWriteTree(start, end []byte, ascending bool, fn func(key []byte, value []byte) error) error {
 	rwt.RLock()
 	defer rwt.RUnlock()
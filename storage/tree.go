@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/iavl"
@@ -98,3 +99,31 @@ func (imt *ImmutableTree) Iterate(start, end []byte, ascending bool, fn func(key
 func (mut *MutableTree) asImmutable() *ImmutableTree {
 	return &ImmutableTree{mut.MutableTree.ImmutableTree}
 }
+
+// Calls fn for each key whose value differs between exp and act, with a nil value where the key is absent. Keys in exp
+// are visited in order followed by those only in act.
+func diffTrees(exp, act KVCallbackIterableReader, fn func(key, expValue, actValue []byte) error) error {
+	err := exp.Iterate(nil, nil, true, func(key []byte, expValue []byte) error {
+		actValue, err := act.Get(key)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(expValue, actValue) {
+			return fn(key, expValue, actValue)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return act.Iterate(nil, nil, true, func(key []byte, actValue []byte) error {
+		has, err := exp.Has(key)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return fn(key, nil, actValue)
+		}
+		return nil
+	})
+}
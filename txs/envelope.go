@@ -1,6 +1,7 @@
 package txs
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 
@@ -49,8 +50,16 @@ func (s *Signatory) RealisePublicKey(getter acmstate.AccountGetter) error {
 		if err != nil {
 			return fmt.Errorf("%s: could not get account %v: %v", errPrefix, *s.Address, err)
 		}
+		if acc == nil {
+			return fmt.Errorf("%s: account %v does not exist", errPrefix, *s.Address)
+		}
 		publicKey := acc.PublicKey
 		s.PublicKey = &publicKey
+		if !s.PublicKey.IsValid() {
+			return fmt.Errorf("%s: public key %v is invalid", errPrefix, *s.PublicKey)
+		}
+		// The account's current key need not be the one its address was derived from if it has been rotated
+		return nil
 	}
 	if !s.PublicKey.IsValid() {
 		return fmt.Errorf("%s: public key %v is invalid", errPrefix, *s.PublicKey)
@@ -86,15 +95,65 @@ func (sig *Signatory) Validate() error {
 	if sig.Address == nil {
 		return fmt.Errorf("has nil Address: %v", sig)
 	}
+	if sig.MultiSig != nil {
+		for i, member := range sig.Members {
+			if member.PublicKey == nil || member.Signature == nil {
+				return fmt.Errorf("member signatory %d has nil PublicKey or Signature: %v", i, sig)
+			}
+		}
+		return nil
+	}
 	if sig.PublicKey == nil {
 		return fmt.Errorf("has nil PublicKey: %v", sig)
 	}
 	return nil
 }
 
+// Checks that the Members of a multi-signature Signatory include valid signatures from at least the threshold of
+// distinct members of its MultiSig. That MultiSig belongs to the account with Address is checked against state.
+func (sig *Signatory) verifyMultiSig(signBytes []byte) error {
+	err := sig.MultiSig.Validate()
+	if err != nil {
+		return err
+	}
+	signed := make(map[crypto.Address]bool)
+	for _, member := range sig.Members {
+		address := member.PublicKey.GetAddress()
+		if !sig.MultiSig.IsMember(*member.PublicKey) {
+			return fmt.Errorf("%v is not a member of %v", address, sig.MultiSig)
+		}
+		if signed[address] {
+			return fmt.Errorf("member %v has signed more than once", address)
+		}
+		err = member.PublicKey.Verify(signBytes, member.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature from member %v: %v", address, err)
+		}
+		signed[address] = true
+	}
+	if len(signed) < int(sig.MultiSig.Threshold) {
+		return fmt.Errorf("has %d member signatures but %v requires %d", len(signed), sig.MultiSig,
+			sig.MultiSig.Threshold)
+	}
+	return nil
+}
+
 // Verifies the validity of the Signatories' Signatures in the Envelope. The Signatories must
-// appear in the same order as the inputs as returned by Tx.GetInputs().
-func (txEnv *Envelope) Verify(chainID string) error {
+// appear in the same order as the inputs as returned by Tx.GetInputs(). Each Signatory's PublicKey must be the
+// current key of its account in accounts or, if the account has no key yet or accounts is nil, the key from which
+// its address is derived.
+func (txEnv *Envelope) Verify(chainID string, accounts acmstate.AccountGetter) error {
+	return txEnv.verify(chainID, accounts, txEnv.Tx.SignBytes)
+}
+
+// Verifies the Envelope as Verify does but against the SignBytes used once fees are paid to validators (see
+// Tx.FeeSignBytes)
+func (txEnv *Envelope) VerifyPayingFees(chainID string, accounts acmstate.AccountGetter) error {
+	return txEnv.verify(chainID, accounts, txEnv.Tx.FeeSignBytes)
+}
+
+func (txEnv *Envelope) verify(chainID string, accounts acmstate.AccountGetter,
+	signBytesFor func(Envelope_EncodingType) ([]byte, error)) error {
 	err := txEnv.Validate()
 	if err != nil {
 		return err
@@ -109,7 +168,7 @@ func (txEnv *Envelope) Verify(chainID string) error {
 		return fmt.Errorf("%s: number of inputs (= %v) should equal number of signatories (= %v)",
 			errPrefix, len(inputs), len(txEnv.Signatories))
 	}
-	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	signBytes, err := signBytesFor(txEnv.GetEncoding())
 	if err != nil {
 		return fmt.Errorf("%s: could not generate SignBytes: %v", errPrefix, err)
 	}
@@ -119,7 +178,14 @@ func (txEnv *Envelope) Verify(chainID string) error {
 			return fmt.Errorf("signatory %v has address %v but input %v has address %v",
 				i, *s.Address, i, inputs[i].Address)
 		}
-		err = s.PublicKey.Verify(signBytes, s.Signature)
+		if s.MultiSig != nil {
+			err = s.verifyMultiSig(signBytes)
+		} else {
+			err = s.verifyPublicKey(accounts)
+			if err == nil {
+				err = s.PublicKey.Verify(signBytes, s.Signature)
+			}
+		}
 		if err != nil {
 			return fmt.Errorf("invalid signature in signatory %v: %v", *s.Address, err)
 		}
@@ -127,6 +193,27 @@ func (txEnv *Envelope) Verify(chainID string) error {
 	return nil
 }
 
+// Checks the PublicKey of a Signatory is the one that currently signs for its account, which is the one from which the
+// address is derived until it is rotated
+func (sig *Signatory) verifyPublicKey(accounts acmstate.AccountGetter) error {
+	if accounts != nil {
+		acc, err := accounts.GetAccount(*sig.Address)
+		if err != nil {
+			return err
+		}
+		if acc != nil && acc.PublicKey.IsSet() {
+			if !acc.PublicKey.Equal(*sig.PublicKey) {
+				return fmt.Errorf("public key %v is not the current key of the account", *sig.PublicKey)
+			}
+			return nil
+		}
+	}
+	if sig.PublicKey.GetAddress() != *sig.Address {
+		return fmt.Errorf("address is not derived from public key %v", *sig.PublicKey)
+	}
+	return nil
+}
+
 // Sign the Tx Envelope by adding Signatories containing the signatures for each TxInput.
 // signing accounts for each input must be provided (in any order).
 func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
@@ -161,6 +248,91 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	return nil
 }
 
+// Add the signatures of signingMembers of multiSig to the Signatory for the input from the multi-signature account
+// at address, leaving the Signatories of any other inputs in place. Members may sign separately and their Envelopes be
+// combined with Merge.
+func (txEnv *Envelope) SignMultiSig(address crypto.Address, multiSig *crypto.MultiSig,
+	signingMembers ...acm.AddressableSigner) error {
+	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	if err != nil {
+		return err
+	}
+	sig, err := txEnv.signatoryFor(address)
+	if err != nil {
+		return err
+	}
+	if sig.MultiSig != nil && !sig.MultiSig.Equal(multiSig) {
+		return fmt.Errorf("signatory for %v already has members %v", address, sig.MultiSig)
+	}
+	sig.MultiSig = multiSig
+	for _, member := range signingMembers {
+		publicKey := member.GetPublicKey()
+		if !multiSig.IsMember(publicKey) {
+			return fmt.Errorf("%v is not a member of %v", member.GetAddress(), multiSig)
+		}
+		signature, err := member.Sign(signBytes)
+		if err != nil {
+			return err
+		}
+		sig.addMember(Signatory{PublicKey: &publicKey, Signature: signature})
+	}
+	return nil
+}
+
+// Merge the multi-signature member signatures of other, which must contain the same Tx, into this Envelope
+func (txEnv *Envelope) Merge(other *Envelope) error {
+	if !bytes.Equal(txEnv.Tx.Hash(), other.Tx.Hash()) {
+		return fmt.Errorf("cannot merge signatures for transaction %X into transaction %X", other.Tx.Hash(),
+			txEnv.Tx.Hash())
+	}
+	for _, otherSig := range other.Signatories {
+		if otherSig.MultiSig == nil || otherSig.Address == nil {
+			continue
+		}
+		sig, err := txEnv.signatoryFor(*otherSig.Address)
+		if err != nil {
+			return err
+		}
+		if sig.MultiSig == nil {
+			sig.MultiSig = otherSig.MultiSig
+		} else if !sig.MultiSig.Equal(otherSig.MultiSig) {
+			return fmt.Errorf("cannot merge signatures for %v from members %v into members %v", *otherSig.Address,
+				otherSig.MultiSig, sig.MultiSig)
+		}
+		for _, member := range otherSig.Members {
+			sig.addMember(member)
+		}
+	}
+	return nil
+}
+
+// Returns the Signatory for the input from address, creating Signatories for every input if there are none
+func (txEnv *Envelope) signatoryFor(address crypto.Address) (*Signatory, error) {
+	inputs := txEnv.Tx.GetInputs()
+	if len(txEnv.Signatories) == 0 {
+		txEnv.Signatories = make([]Signatory, len(inputs))
+		for i, in := range inputs {
+			inputAddress := in.Address
+			txEnv.Signatories[i].Address = &inputAddress
+		}
+	}
+	for i, in := range inputs {
+		if in.Address == address && i < len(txEnv.Signatories) {
+			return &txEnv.Signatories[i], nil
+		}
+	}
+	return nil, fmt.Errorf("transaction has no input from %v", address)
+}
+
+func (sig *Signatory) addMember(member Signatory) {
+	for _, existing := range sig.Members {
+		if existing.PublicKey.GetAddress() == member.PublicKey.GetAddress() {
+			return
+		}
+	}
+	sig.Members = append(sig.Members, member)
+}
+
 func (txEnv *Envelope) Get(key string) (interface{}, bool) {
 	if txEnv == nil {
 		return nil, false
@@ -25,6 +25,9 @@ func (tx *BondTx) GetInputs() []*TxInput {
 }
 
 func (tx *BondTx) String() string {
+	if tx.Validator != nil {
+		return fmt.Sprintf("BondTx{%v -> %v}", tx.Input, tx.Validator)
+	}
 	return fmt.Sprintf("BondTx{%v}", tx.Input)
 }
 
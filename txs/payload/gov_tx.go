@@ -5,6 +5,7 @@ import (
 
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/governance"
 	spec "github.com/hyperledger/burrow/genesis/spec"
 	permission "github.com/hyperledger/burrow/permission"
 )
@@ -21,7 +22,8 @@ func (tx *GovTx) GetInputs() []*TxInput {
 }
 
 func (tx *GovTx) String() string {
-	return fmt.Sprintf("GovTx{%v -> %v}", tx.Inputs, tx.AccountUpdates)
+	return fmt.Sprintf("GovTx{%v -> %v, %v, %v, %v}", tx.Inputs, tx.AccountUpdates, tx.ExecutionParams,
+		tx.ConsensusParams, tx.Upgrade)
 }
 
 func (tx *GovTx) Any() *Any {
@@ -35,7 +37,6 @@ func (tx *GovTx) Any() *Any {
 // - Set account amount(s)
 // - Set account permissions
 // - Set global permissions
-// - Set ConsensusParams
 // Future considerations:
 // - Handle network forks/termination/merging/replacement ?
 // - Provide transaction in stasis/sudo (voting?)
@@ -73,3 +74,37 @@ func UpdateAccountTx(inputAddress crypto.Address, updates ...*spec.TemplateAccou
 		AccountUpdates: updates,
 	}
 }
+
+// Creates a GovTx that replaces the execution parameters
+func UpdateExecutionParamsTx(inputAddress crypto.Address, params *governance.ExecutionParams) *GovTx {
+	return &GovTx{
+		Inputs: []*TxInput{{
+			Address: inputAddress,
+		}},
+		ExecutionParams: params,
+	}
+}
+
+// Creates a GovTx that changes the groups of consensus parameters set in params
+func UpdateConsensusParamsTx(inputAddress crypto.Address, params *governance.ConsensusParams) *GovTx {
+	return &GovTx{
+		Inputs: []*TxInput{{
+			Address: inputAddress,
+		}},
+		ConsensusParams: params,
+	}
+}
+
+// Creates a GovTx that schedules an upgrade to activate at height, which must be run by at least version of Burrow
+func ScheduleUpgradeTx(inputAddress crypto.Address, name string, height uint64, version string) *GovTx {
+	return &GovTx{
+		Inputs: []*TxInput{{
+			Address: inputAddress,
+		}},
+		Upgrade: &governance.Upgrade{
+			Name:    name,
+			Height:  height,
+			Version: version,
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewMultiSigTx(address crypto.Address, multiSig *crypto.MultiSig) *MultiSigTx {
+	return &MultiSigTx{
+		Input: &TxInput{
+			Address: address,
+		},
+		MultiSig: multiSig,
+	}
+}
+
+func (tx *MultiSigTx) Type() Type {
+	return TypeMultiSig
+}
+
+func (tx *MultiSigTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *MultiSigTx) String() string {
+	return fmt.Sprintf("MultiSigTx{%v -> %v}", tx.Input.Address, tx.MultiSig)
+}
+
+func (tx *MultiSigTx) Any() *Any {
+	return &Any{
+		MultiSigTx: tx,
+	}
+}
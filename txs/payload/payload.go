@@ -30,10 +30,15 @@ const (
 	TypeCall  = Type(0x02)
 	TypeName  = Type(0x03)
 	TypeBatch = Type(0x04)
+	// Changes the members of a multi-signature account
+	TypeMultiSig = Type(0x05)
+	// Replaces the key that signs for an account
+	TypeRotateKey = Type(0x06)
 
 	// Validation transactions
 	TypeBond   = Type(0x11)
 	TypeUnbond = Type(0x12)
+	TypeUnjail = Type(0x13)
 
 	// Admin transactions
 	TypePermissions = Type(0x21)
@@ -57,11 +62,14 @@ var nameFromType = map[Type]string{
 	TypeCall:        "CallTx",
 	TypeName:        "NameTx",
 	TypeBatch:       "BatchTx",
+	TypeMultiSig:    "MultiSigTx",
+	TypeRotateKey:   "RotateKeyTx",
 	TypePermissions: "PermsTx",
 	TypeGovernance:  "GovTx",
 	TypeProposal:    "ProposalTx",
 	TypeBond:        "BondTx",
 	TypeUnbond:      "UnbondTx",
+	TypeUnjail:      "UnjailTx",
 	TypeIdentify:    "IdentifyTx",
 }
 
@@ -130,6 +138,10 @@ func New(txType Type) (Payload, error) {
 		return &NameTx{}, nil
 	case TypeBatch:
 		return &BatchTx{}, nil
+	case TypeMultiSig:
+		return &MultiSigTx{}, nil
+	case TypeRotateKey:
+		return &RotateKeyTx{}, nil
 	case TypePermissions:
 		return &PermsTx{}, nil
 	case TypeGovernance:
@@ -138,6 +150,8 @@ func New(txType Type) (Payload, error) {
 		return &BondTx{}, nil
 	case TypeUnbond:
 		return &UnbondTx{}, nil
+	case TypeUnjail:
+		return &UnjailTx{}, nil
 	case TypeProposal:
 		return &ProposalTx{}, nil
 	case TypeIdentify:
@@ -13,7 +13,9 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	golang_proto "github.com/golang/protobuf/proto"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+	crypto "github.com/hyperledger/burrow/crypto"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	governance "github.com/hyperledger/burrow/execution/governance"
 	registry "github.com/hyperledger/burrow/execution/registry"
 	spec "github.com/hyperledger/burrow/genesis/spec"
 	permission "github.com/hyperledger/burrow/permission"
@@ -34,22 +36,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Ballot_ProposalState int32
 
 const (
-	// PROPOSED might be expired, if sequence number of any of the input accounts are out of date
+	// PROPOSED might be stale, if sequence number of any of the input accounts are out of date
 	Ballot_PROPOSED Ballot_ProposalState = 0
 	Ballot_EXECUTED Ballot_ProposalState = 1
 	Ballot_FAILED   Ballot_ProposalState = 2
+	// The ExpiryHeight passed before the proposal was executed
+	Ballot_EXPIRED Ballot_ProposalState = 3
 )
 
 var Ballot_ProposalState_name = map[int32]string{
 	0: "PROPOSED",
 	1: "EXECUTED",
 	2: "FAILED",
+	3: "EXPIRED",
 }
 
 var Ballot_ProposalState_value = map[string]int32{
 	"PROPOSED": 0,
 	"EXECUTED": 1,
 	"FAILED":   2,
+	"EXPIRED":  3,
 }
 
 func (x Ballot_ProposalState) String() string {
@@ -57,24 +63,27 @@ func (x Ballot_ProposalState) String() string {
 }
 
 func (Ballot_ProposalState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{16, 0}
+	return fileDescriptor_678c914f1bee6d56, []int{20, 0}
 }
 
 // Any encodes a sum type for which only one should be set
 type Any struct {
-	CallTx               *CallTx     `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
-	SendTx               *SendTx     `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
-	NameTx               *NameTx     `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
-	PermsTx              *PermsTx    `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
-	GovTx                *GovTx      `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
-	BondTx               *BondTx     `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
-	UnbondTx             *UnbondTx   `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
-	BatchTx              *BatchTx    `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
-	ProposalTx           *ProposalTx `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
-	IdentifyTx           *IdentifyTx `protobuf:"bytes,10,opt,name=IdentifyTx,proto3" json:"IdentifyTx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	CallTx               *CallTx      `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
+	SendTx               *SendTx      `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
+	NameTx               *NameTx      `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
+	PermsTx              *PermsTx     `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
+	GovTx                *GovTx       `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
+	BondTx               *BondTx      `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
+	UnbondTx             *UnbondTx    `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
+	BatchTx              *BatchTx     `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	ProposalTx           *ProposalTx  `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
+	IdentifyTx           *IdentifyTx  `protobuf:"bytes,10,opt,name=IdentifyTx,proto3" json:"IdentifyTx,omitempty"`
+	UnjailTx             *UnjailTx    `protobuf:"bytes,11,opt,name=UnjailTx,proto3" json:"UnjailTx,omitempty"`
+	MultiSigTx           *MultiSigTx  `protobuf:"bytes,12,opt,name=MultiSigTx,proto3" json:"MultiSigTx,omitempty"`
+	RotateKeyTx          *RotateKeyTx `protobuf:"bytes,13,opt,name=RotateKeyTx,proto3" json:"RotateKeyTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Any) Reset()         { *m = Any{} }
@@ -176,6 +185,27 @@ func (m *Any) GetIdentifyTx() *IdentifyTx {
 	return nil
 }
 
+func (m *Any) GetUnjailTx() *UnjailTx {
+	if m != nil {
+		return m.UnjailTx
+	}
+	return nil
+}
+
+func (m *Any) GetMultiSigTx() *MultiSigTx {
+	if m != nil {
+		return m.MultiSigTx
+	}
+	return nil
+}
+
+func (m *Any) GetRotateKeyTx() *RotateKeyTx {
+	if m != nil {
+		return m.RotateKeyTx
+	}
+	return nil
+}
+
 func (*Any) XXX_MessageName() string {
 	return "payload.Any"
 }
@@ -604,11 +634,13 @@ func (*NameTx) XXX_MessageName() string {
 }
 
 type BondTx struct {
-	// Input must be the validator that desires to bond
-	Input                *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Input must be the validator that desires to bond, or the account delegating to Validator
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The validator to which to delegate power, if not set Input bonds as a validator itself
+	Validator            *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *BondTx) Reset()      { *m = BondTx{} }
@@ -646,10 +678,12 @@ func (*BondTx) XXX_MessageName() string {
 type UnbondTx struct {
 	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
 	// Account to unbond
-	Output               *TxOutput `protobuf:"bytes,2,opt,name=Output,proto3" json:"Output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Output *TxOutput `protobuf:"bytes,2,opt,name=Output,proto3" json:"Output,omitempty"`
+	// The validator from which to withdraw delegated power, if not set Output unbonds as a validator itself
+	Validator            *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,3,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *UnbondTx) Reset()      { *m = UnbondTx{} }
@@ -684,18 +718,151 @@ func (*UnbondTx) XXX_MessageName() string {
 	return "payload.UnbondTx"
 }
 
+// Restores the power of a validator that was jailed for missing too many blocks
+type UnjailTx struct {
+	// Input must be the jailed validator
+	Input                *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnjailTx) Reset()      { *m = UnjailTx{} }
+func (*UnjailTx) ProtoMessage() {}
+func (*UnjailTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{10}
+}
+func (m *UnjailTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnjailTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UnjailTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailTx.Merge(m, src)
+}
+func (m *UnjailTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnjailTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnjailTx proto.InternalMessageInfo
+
+func (*UnjailTx) XXX_MessageName() string {
+	return "payload.UnjailTx"
+}
+
+// Changes the threshold and members of a multi-signature account
+type MultiSigTx struct {
+	// Input must be the multi-signature account, so signed by a threshold of its current members
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The new threshold and members, the address of the account is unchanged
+	MultiSig             *crypto.MultiSig `protobuf:"bytes,2,opt,name=MultiSig,proto3" json:"MultiSig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MultiSigTx) Reset()      { *m = MultiSigTx{} }
+func (*MultiSigTx) ProtoMessage() {}
+func (*MultiSigTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{11}
+}
+func (m *MultiSigTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTx.Merge(m, src)
+}
+func (m *MultiSigTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSigTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTx proto.InternalMessageInfo
+
+func (*MultiSigTx) XXX_MessageName() string {
+	return "payload.MultiSigTx"
+}
+
+// Replaces the public key that signs for an account, which may be of a different curve type
+type RotateKeyTx struct {
+	// Input must be the account, so signed by its current key
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The new key, the address of the account is unchanged
+	PublicKey            *crypto.PublicKey `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RotateKeyTx) Reset()      { *m = RotateKeyTx{} }
+func (*RotateKeyTx) ProtoMessage() {}
+func (*RotateKeyTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{12}
+}
+func (m *RotateKeyTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKeyTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RotateKeyTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeyTx.Merge(m, src)
+}
+func (m *RotateKeyTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeyTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeyTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKeyTx proto.InternalMessageInfo
+
+func (*RotateKeyTx) XXX_MessageName() string {
+	return "payload.RotateKeyTx"
+}
+
 type GovTx struct {
-	Inputs               []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
-	AccountUpdates       []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Inputs         []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+	AccountUpdates []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
+	// Replaces the execution parameters in force
+	ExecutionParams *governance.ExecutionParams `protobuf:"bytes,3,opt,name=ExecutionParams,proto3" json:"ExecutionParams,omitempty"`
+	// Changes those groups of Tendermint's consensus parameters that are set
+	ConsensusParams *governance.ConsensusParams `protobuf:"bytes,4,opt,name=ConsensusParams,proto3" json:"ConsensusParams,omitempty"`
+	// Schedules, reschedules or (with a zero height) cancels an upgrade
+	Upgrade              *governance.Upgrade `protobuf:"bytes,5,opt,name=Upgrade,proto3" json:"Upgrade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *GovTx) Reset()      { *m = GovTx{} }
 func (*GovTx) ProtoMessage() {}
 func (*GovTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{10}
+	return fileDescriptor_678c914f1bee6d56, []int{13}
 }
 func (m *GovTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,19 +892,21 @@ func (*GovTx) XXX_MessageName() string {
 }
 
 type ProposalTx struct {
-	Input                *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
-	VotingWeight         int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
-	ProposalHash         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
-	Proposal             *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	Input        *TxInput                                       `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	VotingWeight int64                                          `protobuf:"varint,2,opt,name=VotingWeight,proto3" json:"VotingWeight,omitempty"`
+	ProposalHash *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=ProposalHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"ProposalHash,omitempty"`
+	Proposal     *Proposal                                      `protobuf:"bytes,4,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
+	// Withdraw the input's previous vote for the proposal with ProposalHash rather than voting
+	Withdraw             bool     `protobuf:"varint,5,opt,name=Withdraw,proto3" json:"Withdraw,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProposalTx) Reset()      { *m = ProposalTx{} }
 func (*ProposalTx) ProtoMessage() {}
 func (*ProposalTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{11}
+	return fileDescriptor_678c914f1bee6d56, []int{14}
 }
 func (m *ProposalTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +948,7 @@ type IdentifyTx struct {
 func (m *IdentifyTx) Reset()      { *m = IdentifyTx{} }
 func (*IdentifyTx) ProtoMessage() {}
 func (*IdentifyTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{12}
+	return fileDescriptor_678c914f1bee6d56, []int{15}
 }
 func (m *IdentifyTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +988,7 @@ type BatchTx struct {
 func (m *BatchTx) Reset()      { *m = BatchTx{} }
 func (*BatchTx) ProtoMessage() {}
 func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{13}
+	return fileDescriptor_678c914f1bee6d56, []int{16}
 }
 func (m *BatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1028,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{14}
+	return fileDescriptor_678c914f1bee6d56, []int{17}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,18 +1058,27 @@ func (*Vote) XXX_MessageName() string {
 }
 
 type Proposal struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	BatchTx              *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name        string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	BatchTx     *BatchTx `protobuf:"bytes,3,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	// The last height at which the proposal may be voted on, after which it expires if not executed (zero for never)
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=ExpiryHeight,proto3" json:"ExpiryHeight,omitempty"`
+	// If set votes are weighted equally between accounts holding this role, otherwise by validator power. If none of
+	// Role, Quorum, or Threshold are set votes are counted against the ProposalThreshold execution parameter.
+	Role string `protobuf:"bytes,5,opt,name=Role,proto3" json:"Role,omitempty"`
+	// The fraction of the total voting weight that must have voted
+	Quorum *Fraction `protobuf:"bytes,6,opt,name=Quorum,proto3" json:"Quorum,omitempty"`
+	// The fraction of the voting weight cast that must be in favour
+	Threshold            *Fraction `protobuf:"bytes,7,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{15}
+	return fileDescriptor_678c914f1bee6d56, []int{18}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,6 +1107,60 @@ func (*Proposal) XXX_MessageName() string {
 	return "payload.Proposal"
 }
 
+type Fraction struct {
+	Numerator            uint64   `protobuf:"varint,1,opt,name=Numerator,proto3" json:"Numerator,omitempty"`
+	Denominator          uint64   `protobuf:"varint,2,opt,name=Denominator,proto3" json:"Denominator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Fraction) Reset()      { *m = Fraction{} }
+func (*Fraction) ProtoMessage() {}
+func (*Fraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{19}
+}
+func (m *Fraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Fraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fraction.Merge(m, src)
+}
+func (m *Fraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fraction proto.InternalMessageInfo
+
+func (m *Fraction) GetNumerator() uint64 {
+	if m != nil {
+		return m.Numerator
+	}
+	return 0
+}
+
+func (m *Fraction) GetDenominator() uint64 {
+	if m != nil {
+		return m.Denominator
+	}
+	return 0
+}
+
+func (*Fraction) XXX_MessageName() string {
+	return "payload.Fraction"
+}
+
 type Ballot struct {
 	Proposal             *Proposal                                      `protobuf:"bytes,1,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
 	FinalizingTx         *github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=FinalizingTx,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"FinalizingTx,omitempty"`
@@ -943,7 +1175,7 @@ func (m *Ballot) Reset()         { *m = Ballot{} }
 func (m *Ballot) String() string { return proto.CompactTextString(m) }
 func (*Ballot) ProtoMessage()    {}
 func (*Ballot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{16}
+	return fileDescriptor_678c914f1bee6d56, []int{20}
 }
 func (m *Ballot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,6 +1247,12 @@ func init() {
 	golang_proto.RegisterType((*BondTx)(nil), "payload.BondTx")
 	proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
 	golang_proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
+	proto.RegisterType((*UnjailTx)(nil), "payload.UnjailTx")
+	golang_proto.RegisterType((*UnjailTx)(nil), "payload.UnjailTx")
+	proto.RegisterType((*MultiSigTx)(nil), "payload.MultiSigTx")
+	golang_proto.RegisterType((*MultiSigTx)(nil), "payload.MultiSigTx")
+	proto.RegisterType((*RotateKeyTx)(nil), "payload.RotateKeyTx")
+	golang_proto.RegisterType((*RotateKeyTx)(nil), "payload.RotateKeyTx")
 	proto.RegisterType((*GovTx)(nil), "payload.GovTx")
 	golang_proto.RegisterType((*GovTx)(nil), "payload.GovTx")
 	proto.RegisterType((*ProposalTx)(nil), "payload.ProposalTx")
@@ -1027,6 +1265,8 @@ func init() {
 	golang_proto.RegisterType((*Vote)(nil), "payload.Vote")
 	proto.RegisterType((*Proposal)(nil), "payload.Proposal")
 	golang_proto.RegisterType((*Proposal)(nil), "payload.Proposal")
+	proto.RegisterType((*Fraction)(nil), "payload.Fraction")
+	golang_proto.RegisterType((*Fraction)(nil), "payload.Fraction")
 	proto.RegisterType((*Ballot)(nil), "payload.Ballot")
 	golang_proto.RegisterType((*Ballot)(nil), "payload.Ballot")
 }
@@ -1035,76 +1275,96 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d5
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
 
 var fileDescriptor_678c914f1bee6d56 = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x66, 0x37, 0xb6, 0xfb, 0xe2, 0xe4, 0xeb, 0xce, 0xb7, 0xad, 0xac, 0x48, 0xd8, 0x91,
-	0x41, 0x90, 0x96, 0xd6, 0x86, 0x94, 0x1f, 0x22, 0x37, 0xff, 0x4a, 0x1a, 0xd4, 0x26, 0x66, 0xbc,
-	0x69, 0x11, 0x88, 0xc3, 0x7a, 0x3d, 0xac, 0x57, 0xb2, 0x77, 0x96, 0xdd, 0x71, 0xd9, 0xe5, 0xc4,
-	0x81, 0x03, 0x77, 0x2e, 0x1c, 0xf3, 0x1f, 0x20, 0xfe, 0x03, 0x4e, 0x28, 0x47, 0x8e, 0x88, 0x43,
-	0x84, 0xd2, 0x0b, 0xe2, 0xaf, 0x40, 0x33, 0x3b, 0xbb, 0x1e, 0xbb, 0x55, 0xeb, 0x44, 0x88, 0xdb,
-	0xcc, 0x7b, 0x9f, 0x79, 0xef, 0xcd, 0x7b, 0x9f, 0x79, 0x6f, 0x60, 0xc3, 0xb7, 0xe2, 0x31, 0xb5,
-	0x86, 0x75, 0x3f, 0xa0, 0x8c, 0xa2, 0xbc, 0xdc, 0x6e, 0xdd, 0x70, 0xa8, 0x43, 0x85, 0xac, 0xc1,
-	0x57, 0x89, 0x7a, 0xab, 0xe4, 0x93, 0x60, 0xe2, 0x86, 0xa1, 0x4b, 0x3d, 0x29, 0xd9, 0x0c, 0x88,
-	0xe3, 0x86, 0x2c, 0x88, 0xe5, 0x1e, 0x42, 0x9f, 0xd8, 0xc9, 0xba, 0xf6, 0xab, 0x0e, 0x7a, 0xd3,
-	0x8b, 0xd1, 0x5b, 0x90, 0x6b, 0x5b, 0xe3, 0xb1, 0x19, 0x95, 0xb5, 0x6d, 0x6d, 0x67, 0x7d, 0xf7,
-	0x7f, 0xf5, 0xd4, 0x69, 0x22, 0xc6, 0x52, 0xcd, 0x81, 0x7d, 0xe2, 0x0d, 0xcd, 0xa8, 0xbc, 0xba,
-	0x00, 0x4c, 0xc4, 0x58, 0xaa, 0x39, 0xf0, 0xc8, 0x9a, 0x10, 0x33, 0x2a, 0xeb, 0x0b, 0xc0, 0x44,
-	0x8c, 0xa5, 0x1a, 0xdd, 0x81, 0x7c, 0x8f, 0x04, 0x93, 0xd0, 0x8c, 0xca, 0x86, 0x40, 0x96, 0x32,
-	0xa4, 0x94, 0xe3, 0x14, 0x80, 0xde, 0x80, 0xb5, 0x03, 0xfa, 0xd4, 0x8c, 0xca, 0x6b, 0x02, 0xb9,
-	0x99, 0x21, 0x85, 0x14, 0x27, 0x4a, 0xee, 0xba, 0x45, 0x45, 0x8c, 0xb9, 0x05, 0xd7, 0x89, 0x18,
-	0x4b, 0x35, 0xba, 0x07, 0x85, 0x13, 0x6f, 0x90, 0x40, 0xf3, 0x02, 0x7a, 0x3d, 0x83, 0xa6, 0x0a,
-	0x9c, 0x41, 0x78, 0xa4, 0x2d, 0x8b, 0xd9, 0x23, 0x33, 0x2a, 0x17, 0x16, 0x22, 0x95, 0x72, 0x9c,
-	0x02, 0xd0, 0x7d, 0x80, 0x5e, 0x40, 0x7d, 0x1a, 0x5a, 0x3c, 0xa9, 0xd7, 0x04, 0xfc, 0xff, 0xb3,
-	0x8b, 0x65, 0x2a, 0xac, 0xc0, 0xf8, 0xa1, 0xc3, 0x21, 0xf1, 0x98, 0xfb, 0x65, 0x6c, 0x46, 0x65,
-	0x58, 0x38, 0x34, 0x53, 0x61, 0x05, 0xb6, 0x67, 0x9c, 0x9d, 0x56, 0xb5, 0xda, 0x0f, 0x1a, 0xe4,
-	0xcd, 0xe8, 0xd0, 0xf3, 0xa7, 0x0c, 0x1d, 0x41, 0xbe, 0x39, 0x1c, 0x06, 0x24, 0x0c, 0x45, 0x35,
-	0x8b, 0xad, 0xf7, 0xce, 0xce, 0xab, 0x2b, 0x7f, 0x9c, 0x57, 0xef, 0x3a, 0x2e, 0x1b, 0x4d, 0x07,
-	0x75, 0x9b, 0x4e, 0x1a, 0xa3, 0xd8, 0x27, 0xc1, 0x98, 0x0c, 0x1d, 0x12, 0x34, 0x06, 0xd3, 0x20,
-	0xa0, 0x5f, 0x37, 0xec, 0x20, 0xf6, 0x19, 0xad, 0xcb, 0xb3, 0x38, 0x35, 0x82, 0x6e, 0x41, 0xae,
-	0x39, 0xa1, 0x53, 0x8f, 0x89, 0x9a, 0x1b, 0x58, 0xee, 0xd0, 0x16, 0x14, 0xfa, 0xe4, 0xab, 0x29,
-	0xf1, 0x6c, 0x22, 0x8a, 0x6c, 0xe0, 0x6c, 0xbf, 0x67, 0xfc, 0x78, 0x5a, 0x5d, 0xa9, 0x45, 0x50,
-	0x30, 0xa3, 0xe3, 0x29, 0xfb, 0x0f, 0xa3, 0x92, 0x9e, 0x7f, 0xd2, 0x53, 0x46, 0xa3, 0x37, 0x61,
-	0x4d, 0xe4, 0x45, 0x52, 0x7b, 0x56, 0x34, 0x99, 0x2f, 0x9c, 0xa8, 0xd1, 0xc7, 0xb3, 0x00, 0x57,
-	0x45, 0x80, 0xef, 0x5c, 0x3d, 0xb8, 0x2d, 0x28, 0x1c, 0x58, 0xe1, 0x43, 0x77, 0xe2, 0xb2, 0x34,
-	0x35, 0xe9, 0x1e, 0x95, 0x40, 0xdf, 0x27, 0x44, 0x90, 0xdd, 0xc0, 0x7c, 0x89, 0x0e, 0xc1, 0xe8,
-	0x58, 0xcc, 0x12, 0xac, 0x2e, 0xb6, 0xde, 0x97, 0x79, 0xb9, 0xf7, 0x72, 0xd7, 0x03, 0xd7, 0xb3,
-	0x82, 0xb8, 0xfe, 0x80, 0x44, 0xad, 0x98, 0x91, 0x10, 0x0b, 0x13, 0xe8, 0x73, 0x30, 0x9e, 0x34,
-	0xfb, 0x8f, 0x04, 0xf3, 0x8b, 0xad, 0x83, 0x2b, 0x99, 0xfa, 0xfb, 0xbc, 0xba, 0xc9, 0x2c, 0x27,
-	0xbc, 0x4b, 0x27, 0x2e, 0x23, 0x13, 0x9f, 0xc5, 0x58, 0x18, 0x45, 0x1f, 0x41, 0xb1, 0x4d, 0x3d,
-	0x16, 0x58, 0x36, 0x7b, 0x44, 0x98, 0x55, 0xce, 0x6f, 0xeb, 0x3b, 0xeb, 0xbb, 0x37, 0x67, 0xbd,
-	0x42, 0x51, 0xe2, 0x39, 0xa8, 0x4c, 0x48, 0x2f, 0x70, 0x6d, 0x22, 0x1e, 0x4f, 0x92, 0x10, 0xb1,
-	0x97, 0x15, 0x9b, 0xce, 0x1b, 0x47, 0x9f, 0x40, 0xa1, 0x4d, 0x87, 0xe4, 0x81, 0x15, 0x8e, 0x24,
-	0x61, 0xae, 0x98, 0x98, 0xcc, 0x0c, 0x42, 0x60, 0x88, 0xb8, 0x79, 0x79, 0xaf, 0x61, 0xb1, 0xae,
-	0xb9, 0x69, 0x43, 0x43, 0x3b, 0x90, 0x13, 0x44, 0xe0, 0xfc, 0xd4, 0x5f, 0x48, 0x14, 0xa9, 0x47,
-	0x6f, 0x43, 0x3e, 0x21, 0x35, 0x67, 0x8a, 0x3e, 0xd7, 0x36, 0x52, 0xba, 0xe3, 0x14, 0xb1, 0x57,
-	0xf8, 0xfe, 0xb4, 0xba, 0x22, 0x6e, 0x48, 0xb3, 0x4e, 0xb7, 0x34, 0x27, 0x3f, 0x80, 0x02, 0x3f,
-	0xd2, 0x0c, 0x9c, 0x50, 0x36, 0xdc, 0x1b, 0x75, 0xa5, 0xc1, 0xa7, 0xba, 0x96, 0xc1, 0x53, 0x83,
-	0x33, 0xac, 0x4c, 0xa9, 0x9f, 0xf6, 0xe0, 0xa5, 0xfd, 0x21, 0x30, 0xf8, 0x89, 0x34, 0x43, 0x7c,
-	0xcd, 0x65, 0x82, 0x9d, 0x7a, 0x22, 0x13, 0x34, 0x7b, 0x8e, 0xc3, 0xd2, 0xe3, 0x5e, 0xda, 0x7a,
-	0x97, 0xf5, 0xa8, 0xa4, 0xc7, 0x99, 0x75, 0xe3, 0xa5, 0xe3, 0xbd, 0x0d, 0xb9, 0x24, 0xcf, 0x32,
-	0x3b, 0x2f, 0x28, 0x84, 0x04, 0x28, 0x8e, 0xbe, 0xd5, 0xe4, 0x18, 0xb9, 0x44, 0xc9, 0xdb, 0xb0,
-	0xd9, 0xb4, 0x6d, 0xde, 0x60, 0x4e, 0xfc, 0xa1, 0xc5, 0x48, 0x5a, 0xf9, 0x9b, 0x75, 0x31, 0x4d,
-	0x4d, 0x32, 0xf1, 0xc7, 0x16, 0x23, 0x12, 0x23, 0xea, 0xa1, 0xe1, 0x85, 0x23, 0x4a, 0x08, 0x7f,
-	0x69, 0xea, 0x7c, 0x58, 0xfa, 0xba, 0x35, 0x28, 0x3e, 0xa6, 0xcc, 0xf5, 0x9c, 0x27, 0xc4, 0x75,
-	0x46, 0xc9, 0xa5, 0x75, 0x3c, 0x27, 0x43, 0x27, 0x50, 0x4c, 0x2d, 0x8b, 0xb7, 0xa3, 0x8b, 0xb7,
-	0xf3, 0xee, 0xe5, 0xdf, 0xcd, 0x9c, 0x19, 0x3e, 0x2b, 0xd3, 0xbd, 0x9c, 0xd3, 0xd7, 0x9f, 0x1b,
-	0x67, 0x38, 0x83, 0x28, 0x57, 0x1d, 0xab, 0x43, 0xed, 0x12, 0x19, 0xbf, 0x03, 0xc6, 0x11, 0x1d,
-	0x12, 0x59, 0xd8, 0x5b, 0xf5, 0xec, 0x17, 0xc3, 0xa5, 0x89, 0x45, 0xde, 0x98, 0xf8, 0x4e, 0xf1,
-	0xf6, 0x45, 0x36, 0xa3, 0x2f, 0xe1, 0xaa, 0x02, 0xba, 0x19, 0xa5, 0x15, 0x2d, 0x66, 0xb0, 0xa6,
-	0x17, 0x63, 0xae, 0x50, 0xcc, 0x7f, 0xa7, 0x81, 0xf1, 0x98, 0x32, 0xf2, 0xaf, 0x4f, 0xb3, 0x25,
-	0x2a, 0xab, 0x84, 0xf1, 0x74, 0x56, 0x8c, 0xec, 0xc9, 0x6a, 0xca, 0x93, 0xdd, 0x86, 0xf5, 0x0e,
-	0x09, 0xed, 0xc0, 0xf5, 0x99, 0x4b, 0x3d, 0xf9, 0x9a, 0x55, 0x91, 0xfa, 0x97, 0xd1, 0x5f, 0xf1,
-	0x97, 0x51, 0xfc, 0xfe, 0xbc, 0x0a, 0xb9, 0x96, 0x35, 0x1e, 0x53, 0x36, 0xc7, 0x07, 0xed, 0x95,
-	0x7c, 0xe0, 0xac, 0xdc, 0x77, 0x3d, 0x6b, 0xec, 0x7e, 0xe3, 0x7a, 0x8e, 0xfc, 0x3d, 0x5e, 0x8d,
-	0x95, 0xaa, 0x19, 0xd4, 0x86, 0x0d, 0x5f, 0xba, 0xe8, 0x33, 0x8b, 0x25, 0x1d, 0x69, 0x73, 0xf7,
-	0x35, 0xe5, 0x32, 0x3c, 0xda, 0x2c, 0x22, 0x01, 0xc2, 0xf3, 0x67, 0xd0, 0xeb, 0xb0, 0xc6, 0x6b,
-	0x1a, 0x96, 0xd7, 0x04, 0x01, 0x36, 0xb2, 0xc3, 0x5c, 0x8a, 0x13, 0x5d, 0xed, 0x43, 0xd8, 0x98,
-	0x33, 0x82, 0x8a, 0x50, 0xe8, 0xe1, 0xe3, 0xde, 0x71, 0xbf, 0xdb, 0x29, 0xad, 0xf0, 0x5d, 0xf7,
-	0xd3, 0x6e, 0xfb, 0xc4, 0xec, 0x76, 0x4a, 0x1a, 0x02, 0xc8, 0xed, 0x37, 0x0f, 0x1f, 0x76, 0x3b,
-	0xa5, 0xd5, 0x56, 0xfb, 0xec, 0xa2, 0xa2, 0xfd, 0x76, 0x51, 0xd1, 0x7e, 0xbf, 0xa8, 0x68, 0x7f,
-	0x5e, 0x54, 0xb4, 0x5f, 0x9e, 0x55, 0xb4, 0xb3, 0x67, 0x15, 0xed, 0xb3, 0xdb, 0x2f, 0xbf, 0x39,
-	0x8b, 0xc2, 0x86, 0x8c, 0x64, 0x90, 0x13, 0xdf, 0xf5, 0xfb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x94, 0xcd, 0x56, 0xb9, 0x0c, 0x0c, 0x00, 0x00,
+	// 1414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x73, 0x1b, 0xc5,
+	0x12, 0xf7, 0x4a, 0x6b, 0x49, 0x6e, 0xcb, 0x8e, 0xde, 0xe4, 0xa3, 0xb6, 0xfc, 0xde, 0x93, 0x53,
+	0x7a, 0xaf, 0x20, 0x09, 0x89, 0x0c, 0x09, 0xa4, 0x8a, 0x14, 0x17, 0x7d, 0x39, 0x31, 0x49, 0x1c,
+	0x65, 0xbc, 0x4e, 0x52, 0x50, 0x1c, 0xd6, 0xd2, 0x20, 0x0d, 0xb5, 0xda, 0x59, 0x76, 0x67, 0x93,
+	0x15, 0x67, 0x0e, 0x54, 0xae, 0x5c, 0x38, 0xe6, 0x3f, 0xe0, 0xc8, 0x09, 0x8a, 0xa3, 0x8f, 0x1c,
+	0x29, 0x0e, 0x29, 0xca, 0xb9, 0xf1, 0x57, 0x50, 0x33, 0x3b, 0xbb, 0x1a, 0x6d, 0x42, 0xa2, 0x98,
+	0x14, 0xb7, 0xe9, 0xee, 0x5f, 0x7f, 0x6c, 0x4f, 0xf7, 0x74, 0x2f, 0xac, 0xf9, 0xce, 0xd4, 0x65,
+	0xce, 0xb0, 0xe9, 0x07, 0x8c, 0x33, 0x54, 0x56, 0xe4, 0xc6, 0xa9, 0x11, 0x1b, 0x31, 0xc9, 0xdb,
+	0x12, 0xa7, 0x44, 0xbc, 0x51, 0x1d, 0x04, 0x53, 0x9f, 0xa7, 0x54, 0x6d, 0xc4, 0x1e, 0x92, 0xc0,
+	0x73, 0xbc, 0x01, 0x49, 0x39, 0x3e, 0x09, 0x26, 0x34, 0x0c, 0x29, 0xf3, 0x14, 0x67, 0x3d, 0x20,
+	0x23, 0x1a, 0xf2, 0x60, 0xaa, 0x68, 0x08, 0x7d, 0x32, 0x48, 0xce, 0x8d, 0x23, 0x13, 0x8a, 0x2d,
+	0x6f, 0x8a, 0xde, 0x86, 0x52, 0xc7, 0x71, 0x5d, 0x3b, 0xb6, 0x8c, 0xb3, 0xc6, 0xb9, 0xd5, 0xcb,
+	0x27, 0x9a, 0x69, 0x50, 0x09, 0x1b, 0x2b, 0xb1, 0x00, 0xee, 0x11, 0x6f, 0x68, 0xc7, 0x56, 0x21,
+	0x07, 0x4c, 0xd8, 0x58, 0x89, 0x05, 0x70, 0xd7, 0x99, 0x10, 0x3b, 0xb6, 0x8a, 0x39, 0x60, 0xc2,
+	0xc6, 0x4a, 0x8c, 0x2e, 0x40, 0xb9, 0x4f, 0x82, 0x49, 0x68, 0xc7, 0x96, 0x29, 0x91, 0xb5, 0x0c,
+	0xa9, 0xf8, 0x38, 0x05, 0xa0, 0xff, 0xc3, 0xf2, 0x75, 0xf6, 0xd0, 0x8e, 0xad, 0x65, 0x89, 0x5c,
+	0xcf, 0x90, 0x92, 0x8b, 0x13, 0xa1, 0x70, 0xdd, 0x66, 0x32, 0xc6, 0x52, 0xce, 0x75, 0xc2, 0xc6,
+	0x4a, 0x8c, 0x2e, 0x41, 0x65, 0xdf, 0x3b, 0x48, 0xa0, 0x65, 0x09, 0xfd, 0x57, 0x06, 0x4d, 0x05,
+	0x38, 0x83, 0x88, 0x48, 0xdb, 0x0e, 0x1f, 0x8c, 0xed, 0xd8, 0xaa, 0xe4, 0x22, 0x55, 0x7c, 0x9c,
+	0x02, 0xd0, 0x15, 0x80, 0x7e, 0xc0, 0x7c, 0x16, 0x3a, 0x22, 0xa9, 0x2b, 0x12, 0x7e, 0x72, 0xf6,
+	0x61, 0x99, 0x08, 0x6b, 0x30, 0xa1, 0xb4, 0x33, 0x24, 0x1e, 0xa7, 0x9f, 0x4f, 0xed, 0xd8, 0x82,
+	0x9c, 0xd2, 0x4c, 0x84, 0x35, 0x58, 0xf2, 0x11, 0x5f, 0x38, 0x54, 0xf8, 0x59, 0x7d, 0xee, 0x23,
+	0x12, 0x01, 0xce, 0x20, 0xc2, 0xc7, 0xed, 0xc8, 0xe5, 0x74, 0x8f, 0x8e, 0xec, 0xd8, 0xaa, 0xe6,
+	0x7c, 0xcc, 0x44, 0x58, 0x83, 0xa1, 0xab, 0xb0, 0x8a, 0x19, 0x77, 0x38, 0xb9, 0x49, 0x44, 0x64,
+	0x6b, 0x52, 0xeb, 0x54, 0xa6, 0xa5, 0xc9, 0xb0, 0x0e, 0xbc, 0x66, 0x1e, 0x3e, 0xd9, 0x34, 0x1a,
+	0xdf, 0x1a, 0x50, 0xb6, 0xe3, 0x1d, 0xcf, 0x8f, 0x38, 0xda, 0x85, 0x72, 0x6b, 0x38, 0x0c, 0x48,
+	0x18, 0xca, 0x4a, 0xab, 0xb6, 0xdf, 0x3f, 0x7c, 0xba, 0xb9, 0xf4, 0xdb, 0xd3, 0xcd, 0x8b, 0x23,
+	0xca, 0xc7, 0xd1, 0x41, 0x73, 0xc0, 0x26, 0x5b, 0xe3, 0xa9, 0x4f, 0x02, 0x97, 0x0c, 0x47, 0x24,
+	0xd8, 0x3a, 0x88, 0x82, 0x80, 0x3d, 0xda, 0x52, 0x55, 0xaf, 0x74, 0x71, 0x6a, 0x04, 0x9d, 0x81,
+	0x52, 0x6b, 0xc2, 0x22, 0x8f, 0xcb, 0x7a, 0x34, 0xb1, 0xa2, 0xd0, 0x06, 0x54, 0xf6, 0xc8, 0x97,
+	0x11, 0xf1, 0x06, 0x44, 0x16, 0xa0, 0x89, 0x33, 0xfa, 0x9a, 0xf9, 0xdd, 0x93, 0xcd, 0xa5, 0x46,
+	0x0c, 0x15, 0x3b, 0xbe, 0x13, 0xf1, 0x7f, 0x30, 0x2a, 0xe5, 0xf9, 0xfb, 0x62, 0xda, 0x6d, 0xe8,
+	0x2d, 0x58, 0x96, 0x79, 0xb1, 0x8c, 0x5c, 0x41, 0xa9, 0x7c, 0xe1, 0x44, 0x8c, 0x3e, 0x9e, 0x05,
+	0x58, 0x90, 0x01, 0xbe, 0x7b, 0xfc, 0xe0, 0x36, 0xa0, 0x72, 0xdd, 0x09, 0x6f, 0xd1, 0x09, 0xe5,
+	0x69, 0x6a, 0x52, 0x1a, 0xd5, 0xa0, 0xb8, 0x4d, 0x88, 0x6c, 0x44, 0x13, 0x8b, 0x23, 0xda, 0x01,
+	0xb3, 0xeb, 0x70, 0x47, 0x76, 0x5c, 0xb5, 0xfd, 0x81, 0xca, 0xcb, 0xa5, 0x97, 0xbb, 0x3e, 0xa0,
+	0x9e, 0x13, 0x4c, 0x9b, 0x37, 0x48, 0xdc, 0x9e, 0x72, 0x12, 0x62, 0x69, 0x02, 0x7d, 0x0a, 0xe6,
+	0xfd, 0xd6, 0xde, 0x6d, 0xd9, 0x95, 0xd5, 0xf6, 0xf5, 0x63, 0x99, 0xfa, 0xe3, 0xe9, 0xe6, 0x3a,
+	0x77, 0x46, 0xe1, 0x45, 0x36, 0xa1, 0x9c, 0x4c, 0x7c, 0x3e, 0xc5, 0xd2, 0x28, 0xfa, 0x10, 0xaa,
+	0x1d, 0xe6, 0xf1, 0xc0, 0x19, 0xf0, 0xdb, 0x84, 0x3b, 0x56, 0xf9, 0x6c, 0xf1, 0xdc, 0xea, 0xe5,
+	0xd3, 0xb3, 0x77, 0x4c, 0x13, 0xe2, 0x39, 0xa8, 0x4a, 0x48, 0x3f, 0xa0, 0x03, 0x62, 0x55, 0xb2,
+	0x84, 0x48, 0x5a, 0xdd, 0x58, 0x34, 0x6f, 0x1c, 0xdd, 0x85, 0x4a, 0x87, 0x0d, 0xc9, 0x0d, 0x27,
+	0x1c, 0x5b, 0xc6, 0xdf, 0x49, 0x4c, 0x66, 0x06, 0x21, 0x30, 0x65, 0xdc, 0xe2, 0x7a, 0x57, 0xb0,
+	0x3c, 0x37, 0x68, 0xfa, 0xd8, 0xa2, 0x73, 0x50, 0x92, 0x85, 0x20, 0xea, 0xb3, 0xf8, 0xc2, 0x42,
+	0x51, 0x72, 0xf4, 0x0e, 0x94, 0x93, 0xa2, 0x16, 0x95, 0x52, 0x9c, 0x7b, 0x0d, 0xd2, 0x72, 0xc7,
+	0x29, 0xe2, 0x5a, 0xe5, 0x9b, 0x27, 0x9b, 0x4b, 0xf2, 0x0b, 0x59, 0xf6, 0x0a, 0x2f, 0x5c, 0x93,
+	0x57, 0xa1, 0x22, 0x54, 0x5a, 0xc1, 0x28, 0x54, 0xc3, 0xe0, 0x54, 0x53, 0x1b, 0x3e, 0xa9, 0xac,
+	0x6d, 0x8a, 0xd4, 0xe0, 0x0c, 0xab, 0x52, 0xea, 0xa7, 0xf3, 0x61, 0x61, 0x7f, 0x08, 0x4c, 0xa1,
+	0x91, 0x66, 0x48, 0x9c, 0x05, 0x4f, 0x56, 0x67, 0x31, 0xe1, 0x89, 0xf3, 0xf3, 0x35, 0xac, 0x3c,
+	0x3e, 0x36, 0xd2, 0xb9, 0xb0, 0xb0, 0xcb, 0x5d, 0x58, 0xb9, 0xe7, 0xb8, 0x74, 0xe8, 0x70, 0x16,
+	0x1c, 0xbb, 0xf1, 0x66, 0x26, 0xb4, 0x7c, 0xff, 0x60, 0xcc, 0x66, 0xcf, 0xc2, 0xe1, 0x9c, 0x87,
+	0x52, 0x72, 0x73, 0x2a, 0xdf, 0x2f, 0xb8, 0xda, 0x52, 0xf6, 0xa2, 0x69, 0x91, 0x17, 0xdf, 0x64,
+	0xe4, 0x1f, 0xcd, 0xe6, 0xcd, 0xa2, 0x81, 0x6b, 0xda, 0xae, 0x3e, 0x7e, 0x16, 0xfe, 0xf0, 0x8b,
+	0x50, 0x49, 0xb5, 0xd4, 0xa7, 0xd7, 0x9a, 0x2a, 0xd6, 0x94, 0x8f, 0x33, 0x84, 0xe6, 0xcd, 0x9f,
+	0x9b, 0x5b, 0x0b, 0xbb, 0xdb, 0x82, 0x95, 0x7e, 0x74, 0xe0, 0xd2, 0xc1, 0x4d, 0x32, 0xcd, 0x52,
+	0xad, 0xfc, 0x65, 0x02, 0x3c, 0xc3, 0x68, 0x1e, 0x7f, 0x2c, 0xa8, 0x15, 0xe5, 0x35, 0x5a, 0xb6,
+	0x03, 0xeb, 0xad, 0xc1, 0x40, 0x0c, 0x88, 0x7d, 0x7f, 0xe8, 0x70, 0x92, 0x76, 0xee, 0xe9, 0xa6,
+	0xdc, 0xd4, 0x6c, 0x32, 0xf1, 0x5d, 0x87, 0x13, 0x85, 0x91, 0xfd, 0x64, 0xe0, 0x9c, 0x0a, 0xea,
+	0xc1, 0x89, 0x5e, 0x4c, 0x06, 0x11, 0xa7, 0xcc, 0xeb, 0x3b, 0x81, 0x33, 0x09, 0xd5, 0xe2, 0xf5,
+	0xef, 0xa6, 0xb6, 0x23, 0xe6, 0x20, 0x38, 0xaf, 0x23, 0xcc, 0x74, 0x98, 0x17, 0x12, 0x2f, 0x8c,
+	0x42, 0x65, 0xc6, 0x7c, 0xde, 0x4c, 0x0e, 0x82, 0xf3, 0x3a, 0xe8, 0x12, 0x94, 0xf7, 0xfd, 0x51,
+	0xe0, 0x0c, 0x89, 0x5a, 0xd5, 0x4e, 0xea, 0xea, 0x4a, 0x84, 0x53, 0x8c, 0x96, 0xbf, 0xaf, 0x0b,
+	0xfa, 0xe2, 0xb4, 0xf0, 0x8d, 0x35, 0xa0, 0x7a, 0x8f, 0x71, 0xea, 0x8d, 0xee, 0x13, 0x3a, 0x1a,
+	0x27, 0xfd, 0x51, 0xc4, 0x73, 0x3c, 0xb4, 0x0f, 0xd5, 0xd4, 0xb2, 0x7c, 0xb8, 0x93, 0xae, 0x78,
+	0xef, 0xf5, 0x1f, 0xed, 0x39, 0x33, 0x62, 0xff, 0x4a, 0x69, 0xcb, 0xcc, 0xb5, 0x65, 0x2a, 0xc0,
+	0x19, 0x44, 0x0c, 0x9b, 0xfb, 0x94, 0x8f, 0x87, 0x81, 0xf3, 0x48, 0xa6, 0xa6, 0x82, 0x33, 0x7a,
+	0xbe, 0x4d, 0xb4, 0x15, 0x6f, 0xf1, 0x52, 0xba, 0x00, 0xe6, 0x2e, 0x1b, 0x12, 0x55, 0xb4, 0x67,
+	0x9a, 0xd9, 0xea, 0x2f, 0xb8, 0x89, 0x45, 0x31, 0x31, 0x05, 0xa5, 0x79, 0xfb, 0x2c, 0x5b, 0x6c,
+	0x5f, 0xc3, 0x55, 0x1d, 0x8a, 0x76, 0x9c, 0x96, 0x6a, 0x35, 0x83, 0xb5, 0xbc, 0x29, 0x16, 0x02,
+	0xfd, 0x4e, 0x0d, 0x30, 0xef, 0x31, 0x4e, 0xde, 0xf8, 0x9a, 0xb5, 0xc0, 0xad, 0x6b, 0x61, 0x3c,
+	0x2e, 0xcc, 0x6e, 0x2a, 0x1b, 0x26, 0x86, 0x36, 0x4c, 0xce, 0xc2, 0x6a, 0x97, 0x84, 0x83, 0x80,
+	0xfa, 0xa2, 0x21, 0xd4, 0x9c, 0xd1, 0x59, 0xfa, 0x1f, 0x40, 0xf1, 0x55, 0x7f, 0x00, 0x0d, 0xa8,
+	0xf6, 0x62, 0x9f, 0x06, 0xd3, 0x1b, 0x49, 0x70, 0xc9, 0x3c, 0x9a, 0xe3, 0x89, 0x28, 0x30, 0x73,
+	0x93, 0x1e, 0x59, 0xc1, 0xf2, 0x2c, 0x1e, 0xf9, 0xbb, 0x11, 0x0b, 0xa2, 0x89, 0x55, 0xca, 0x55,
+	0xd3, 0xb6, 0xd8, 0x3f, 0x28, 0xf3, 0xb0, 0x02, 0x88, 0x77, 0xca, 0x1e, 0x07, 0x24, 0x1c, 0x33,
+	0x77, 0x68, 0x95, 0xff, 0x0a, 0x3d, 0xc3, 0x68, 0xc9, 0xe8, 0x43, 0x25, 0x05, 0xa0, 0xff, 0xc0,
+	0xca, 0x6e, 0x34, 0x21, 0x81, 0x9c, 0x15, 0x86, 0x0c, 0x73, 0xc6, 0x48, 0xb2, 0xe2, 0xb1, 0x09,
+	0xf5, 0xb2, 0x29, 0x68, 0x62, 0x9d, 0xa5, 0xc6, 0xeb, 0x4f, 0x05, 0x28, 0xb5, 0x1d, 0xd7, 0x65,
+	0x7c, 0xae, 0x25, 0x8c, 0x57, 0xb7, 0xc4, 0x3e, 0x54, 0xb7, 0xa9, 0xe7, 0xb8, 0xf4, 0x2b, 0xea,
+	0x8d, 0xd4, 0x9f, 0xe5, 0xf1, 0x1a, 0x53, 0x37, 0x83, 0x3a, 0xb0, 0xe6, 0x2b, 0x17, 0x7b, 0x62,
+	0x06, 0xc8, 0x1b, 0x58, 0xbf, 0xfc, 0x5f, 0xed, 0xca, 0x44, 0xb4, 0xcd, 0xbe, 0x0e, 0xc2, 0xf3,
+	0x3a, 0xe8, 0x7f, 0xb0, 0x2c, 0x4a, 0x37, 0xb4, 0x96, 0x65, 0x9d, 0xaf, 0x65, 0xca, 0x82, 0x8b,
+	0x13, 0x59, 0xa3, 0x0b, 0x6b, 0x73, 0x46, 0x50, 0x15, 0x2a, 0x7d, 0x7c, 0xa7, 0x7f, 0x67, 0xaf,
+	0xd7, 0xad, 0x2d, 0x09, 0xaa, 0xf7, 0xa0, 0xd7, 0xd9, 0xb7, 0x7b, 0xdd, 0x9a, 0x81, 0x00, 0x4a,
+	0xdb, 0xad, 0x9d, 0x5b, 0xbd, 0x6e, 0xad, 0x80, 0x56, 0xa1, 0xdc, 0x7b, 0xd0, 0xdf, 0xc1, 0xbd,
+	0x6e, 0xad, 0xd8, 0xee, 0x1c, 0x1e, 0xd5, 0x8d, 0x5f, 0x8e, 0xea, 0xc6, 0xaf, 0x47, 0x75, 0xe3,
+	0xf7, 0xa3, 0xba, 0xf1, 0xf3, 0xb3, 0xba, 0x71, 0xf8, 0xac, 0x6e, 0x7c, 0x72, 0xfe, 0xe5, 0x69,
+	0xe0, 0x71, 0xb8, 0xa5, 0xc2, 0x3a, 0x28, 0xc9, 0xff, 0xfa, 0x2b, 0x7f, 0x0e, 0x00, 0x26, 0x9d,
+	0x00, 0xed, 0x55, 0x10, 0x00, 0x00,
 }
 
 func (m *Any) Marshal() (dAtA []byte, err error) {
@@ -1131,6 +1391,42 @@ func (m *Any) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RotateKeyTx != nil {
+		{
+			size, err := m.RotateKeyTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MultiSigTx != nil {
+		{
+			size, err := m.MultiSigTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnjailTx != nil {
+		{
+			size, err := m.UnjailTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.IdentifyTx != nil {
 		{
 			size, err := m.IdentifyTx.MarshalToSizedBuffer(dAtA[:i])
@@ -1673,6 +1969,18 @@ func (m *BondTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Validator != nil {
+		{
+			size := m.Validator.Size()
+			i -= size
+			if _, err := m.Validator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Input != nil {
 		{
 			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
@@ -1712,6 +2020,18 @@ func (m *UnbondTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Validator != nil {
+		{
+			size := m.Validator.Size()
+			i -= size
+			if _, err := m.Validator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Output != nil {
 		{
 			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
@@ -1739,7 +2059,7 @@ func (m *UnbondTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GovTx) Marshal() (dAtA []byte, err error) {
+func (m *UnjailTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1749,12 +2069,12 @@ func (m *GovTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GovTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnjailTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnjailTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1763,38 +2083,22 @@ func (m *GovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AccountUpdates) > 0 {
-		for iNdEx := len(m.AccountUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccountUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPayload(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPayload(dAtA, i, uint64(size))
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProposalTx) Marshal() (dAtA []byte, err error) {
+func (m *MultiSigTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1804,12 +2108,12 @@ func (m *ProposalTx) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiSigTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiSigTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1818,9 +2122,9 @@ func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Proposal != nil {
+	if m.MultiSig != nil {
 		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MultiSig.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1828,13 +2132,216 @@ func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPayload(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if m.ProposalHash != nil {
+	if m.Input != nil {
 		{
-			size := m.ProposalHash.Size()
-			i -= size
-			if _, err := m.ProposalHash.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateKeyTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeyTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateKeyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ConsensusParams != nil {
+		{
+			size, err := m.ConsensusParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionParams != nil {
+		{
+			size, err := m.ExecutionParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountUpdates) > 0 {
+		for iNdEx := len(m.AccountUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Withdraw {
+		i--
+		if m.Withdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProposalHash != nil {
+		{
+			size := m.ProposalHash.Size()
+			i -= size
+			if _, err := m.ProposalHash.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintPayload(dAtA, i, uint64(size))
@@ -2036,6 +2543,42 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Quorum != nil {
+		{
+			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPayload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BatchTx != nil {
 		{
 			size, err := m.BatchTx.MarshalToSizedBuffer(dAtA[:i])
@@ -2065,6 +2608,43 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Fraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Denominator != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintPayload(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2192,6 +2772,18 @@ func (m *Any) Size() (n int) {
 		l = m.IdentifyTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.UnjailTx != nil {
+		l = m.UnjailTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.MultiSigTx != nil {
+		l = m.MultiSigTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.RotateKeyTx != nil {
+		l = m.RotateKeyTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2371,6 +2963,10 @@ func (m *BondTx) Size() (n int) {
 		l = m.Input.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2391,21 +2987,81 @@ func (m *UnbondTx) Size() (n int) {
 		l = m.Output.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GovTx) Size() (n int) {
+func (m *UnjailTx) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MultiSigTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.MultiSig != nil {
+		l = m.MultiSig.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateKeyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GovTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
 			n += 1 + l + sovPayload(uint64(l))
 		}
 	}
@@ -2415,6 +3071,18 @@ func (m *GovTx) Size() (n int) {
 			n += 1 + l + sovPayload(uint64(l))
 		}
 	}
+	if m.ExecutionParams != nil {
+		l = m.ExecutionParams.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.ConsensusParams != nil {
+		l = m.ConsensusParams.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2442,6 +3110,9 @@ func (m *ProposalTx) Size() (n int) {
 		l = m.Proposal.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.Withdraw {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2529,6 +3200,39 @@ func (m *Proposal) Size() (n int) {
 		l = m.BatchTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovPayload(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.Threshold != nil {
+		l = m.Threshold.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovPayload(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovPayload(uint64(m.Denominator))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2601,6 +3305,15 @@ func (this *Any) GetValue() interface{} {
 	if this.IdentifyTx != nil {
 		return this.IdentifyTx
 	}
+	if this.UnjailTx != nil {
+		return this.UnjailTx
+	}
+	if this.MultiSigTx != nil {
+		return this.MultiSigTx
+	}
+	if this.RotateKeyTx != nil {
+		return this.RotateKeyTx
+	}
 	return nil
 }
 
@@ -2626,6 +3339,12 @@ func (this *Any) SetValue(value interface{}) bool {
 		this.ProposalTx = vt
 	case *IdentifyTx:
 		this.IdentifyTx = vt
+	case *UnjailTx:
+		this.UnjailTx = vt
+	case *MultiSigTx:
+		this.MultiSigTx = vt
+	case *RotateKeyTx:
+		this.RotateKeyTx = vt
 	default:
 		return false
 	}
@@ -3020,6 +3739,114 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnjailTx == nil {
+				m.UnjailTx = &UnjailTx{}
+			}
+			if err := m.UnjailTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSigTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiSigTx == nil {
+				m.MultiSigTx = &MultiSigTx{}
+			}
+			if err := m.MultiSigTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateKeyTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotateKeyTx == nil {
+				m.RotateKeyTx = &RotateKeyTx{}
+			}
+			if err := m.RotateKeyTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -4136,6 +4963,41 @@ func (m *BondTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Validator = &v
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -4259,6 +5121,41 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Validator = &v
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -4281,7 +5178,7 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovTx) Unmarshal(dAtA []byte) error {
+func (m *UnjailTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4291,28 +5188,465 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPayload
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnjailTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnjailTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSigTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSigTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSigTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiSig == nil {
+				m.MultiSig = &crypto.MultiSig{}
+			}
+			if err := m.MultiSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateKeyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &crypto.PublicKey{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &TxInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountUpdates = append(m.AccountUpdates, &spec.TemplateAccount{})
+			if err := m.AccountUpdates[len(m.AccountUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ExecutionParams == nil {
+				m.ExecutionParams = &governance.ExecutionParams{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GovTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ExecutionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4339,14 +5673,16 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, &TxInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsensusParams == nil {
+				m.ConsensusParams = &governance.ConsensusParams{}
+			}
+			if err := m.ConsensusParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4373,8 +5709,10 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountUpdates = append(m.AccountUpdates, &spec.TemplateAccount{})
-			if err := m.AccountUpdates[len(m.AccountUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Upgrade == nil {
+				m.Upgrade = &governance.Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4555,6 +5893,26 @@ func (m *ProposalTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdraw = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -5049,6 +6407,218 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quorum == nil {
+				m.Quorum = &Fraction{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &Fraction{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
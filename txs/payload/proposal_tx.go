@@ -2,6 +2,7 @@ package payload
 
 import (
 	"fmt"
+	"math/big"
 )
 
 func NewProposalTx(propsal *Proposal) *ProposalTx {
@@ -35,3 +36,24 @@ func (p *Proposal) String() string {
 func (v *Vote) String() string {
 	return v.Address.String()
 }
+
+// Returns true if part/total is at least this fraction
+func (f *Fraction) Reached(part, total *big.Int) bool {
+	lhs := new(big.Int).Mul(part, new(big.Int).SetUint64(f.Denominator))
+	rhs := new(big.Int).Mul(total, new(big.Int).SetUint64(f.Numerator))
+	return lhs.Cmp(rhs) >= 0
+}
+
+func (f *Fraction) Validate() error {
+	if f.Denominator == 0 || f.Numerator > f.Denominator {
+		return fmt.Errorf("fraction %v must have non-zero denominator and be at most one", f)
+	}
+	return nil
+}
+
+func (f *Fraction) String() string {
+	if f == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%d/%d", f.Numerator, f.Denominator)
+}
@@ -0,0 +1,34 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewRotateKeyTx(address crypto.Address, publicKey crypto.PublicKey) *RotateKeyTx {
+	return &RotateKeyTx{
+		Input: &TxInput{
+			Address: address,
+		},
+		PublicKey: &publicKey,
+	}
+}
+
+func (tx *RotateKeyTx) Type() Type {
+	return TypeRotateKey
+}
+
+func (tx *RotateKeyTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *RotateKeyTx) String() string {
+	return fmt.Sprintf("RotateKeyTx{%v -> %v}", tx.Input.Address, tx.PublicKey)
+}
+
+func (tx *RotateKeyTx) Any() *Any {
+	return &Any{
+		RotateKeyTx: tx,
+	}
+}
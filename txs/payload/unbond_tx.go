@@ -27,6 +27,9 @@ func (tx *UnbondTx) GetInputs() []*TxInput {
 }
 
 func (tx *UnbondTx) String() string {
+	if tx.Validator != nil {
+		return fmt.Sprintf("UnbondTx{%v <- %v}", tx.Input.Address, tx.Validator)
+	}
 	return fmt.Sprintf("UnbondTx{%v}", tx.Input.Address)
 }
 
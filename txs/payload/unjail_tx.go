@@ -0,0 +1,33 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewUnjailTx(address crypto.Address) *UnjailTx {
+	return &UnjailTx{
+		Input: &TxInput{
+			Address: address,
+		},
+	}
+}
+
+func (tx *UnjailTx) Type() Type {
+	return TypeUnjail
+}
+
+func (tx *UnjailTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *UnjailTx) String() string {
+	return fmt.Sprintf("UnjailTx{%v}", tx.Input.Address)
+}
+
+func (tx *UnjailTx) Any() *Any {
+	return &Any{
+		UnjailTx: tx,
+	}
+}
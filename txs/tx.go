@@ -7,6 +7,8 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 
 	"github.com/hyperledger/burrow/acm"
@@ -67,6 +69,16 @@ func (tx *Tx) MustSignBytes() []byte {
 
 // Produces the canonical SignBytes (the Tx message that will be signed) for a Tx
 func (tx *Tx) SignBytes(enc Envelope_EncodingType) ([]byte, error) {
+	return tx.signBytes(enc, false)
+}
+
+// Produces the SignBytes for a Tx once fees are paid to validators (see governance.FeesUpgrade), when an RLP encoded
+// CallTx must pay the fee implied by its gas price and signs for the value it transfers net of that fee
+func (tx *Tx) FeeSignBytes(enc Envelope_EncodingType) ([]byte, error) {
+	return tx.signBytes(enc, true)
+}
+
+func (tx *Tx) signBytes(enc Envelope_EncodingType, payingFees bool) ([]byte, error) {
 	switch enc {
 	case Envelope_JSON:
 		bs, err := json.Marshal(tx)
@@ -78,12 +90,24 @@ func (tx *Tx) SignBytes(enc Envelope_EncodingType) ([]byte, error) {
 		switch pay := tx.Payload.(type) {
 		case *payload.CallTx:
 			input := pay.Input
+			amount := input.Amount
+			if payingFees {
+				// The fee is not part of an Ethereum transaction so it must be the one implied by its gas price
+				if fee := EthFee(pay.GasPrice, pay.GasLimit); pay.Fee != fee {
+					return nil, fmt.Errorf("fee %d does not match fee %d implied by gas price %d and gas limit %d",
+						pay.Fee, fee, pay.GasPrice, pay.GasLimit)
+				}
+				if amount < pay.Fee {
+					return nil, fmt.Errorf("input amount %d does not cover fee %d", amount, pay.Fee)
+				}
+				amount -= pay.Fee
+			}
 			return RLPEncode(
 				input.Sequence-1,
 				pay.GasPrice,
 				pay.GasLimit,
 				pay.Address.Bytes(),
-				balance.NativeToWei(input.Amount).Bytes(),
+				balance.NativeToWei(amount).Bytes(),
 				pay.Data.Bytes(),
 			)
 		default:
@@ -94,6 +118,16 @@ func (tx *Tx) SignBytes(enc Envelope_EncodingType) ([]byte, error) {
 	}
 }
 
+// EthFee returns the native fee paid by an Ethereum transaction with gasPrice (in wei) and gasLimit
+func EthFee(gasPrice, gasLimit uint64) uint64 {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(gasPrice), new(big.Int).SetUint64(gasLimit))
+	native := balance.WeiToNative(fee.Bytes())
+	if !native.IsUint64() {
+		return math.MaxUint64
+	}
+	return native.Uint64()
+}
+
 func RLPEncode(seq, gasPrice, gasLimit uint64, address, amount, data []byte) ([]byte, error) {
 	return rlp.Encode([]interface{}{
 		seq,       // nonce
@@ -272,8 +306,17 @@ func EnvelopeFromAny(chainID string, p *payload.Any) *Envelope {
 	if p.UnbondTx != nil {
 		return Enclose(chainID, p.UnbondTx)
 	}
+	if p.UnjailTx != nil {
+		return Enclose(chainID, p.UnjailTx)
+	}
 	if p.IdentifyTx != nil {
 		return Enclose(chainID, p.IdentifyTx)
 	}
+	if p.MultiSigTx != nil {
+		return Enclose(chainID, p.MultiSigTx)
+	}
+	if p.RotateKeyTx != nil {
+		return Enclose(chainID, p.RotateKeyTx)
+	}
 	return nil
 }
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -105,6 +107,43 @@ func TestCallTxSignable(t *testing.T) {
 	testTxSignVerify(t, callTx)
 }
 
+func TestCallTxRLPFee(t *testing.T) {
+	toAddress := makePrivateAccount("contract1").GetAddress()
+	callTx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  makePrivateAccount("input1").GetAddress(),
+			Amount:   3,
+			Sequence: 1,
+		},
+		Address:  &toAddress,
+		GasLimit: 2,
+		GasPrice: balance.NativeToWei(1).Uint64(),
+		Data:     []byte("data1"),
+	}
+	tx := NewTx(callTx)
+	// Until fees are paid the whole input amount is signed
+	signBytes, err := tx.SignBytes(Envelope_RLP)
+	require.NoError(t, err)
+	expected, err := RLPEncode(0, callTx.GasPrice, callTx.GasLimit, toAddress.Bytes(),
+		balance.NativeToWei(3).Bytes(), callTx.Data.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, expected, signBytes)
+
+	// After which the fee must be the one implied by the gas price
+	_, err = tx.FeeSignBytes(Envelope_RLP)
+	require.Error(t, err)
+
+	callTx.Fee = EthFee(callTx.GasPrice, callTx.GasLimit)
+	assert.Equal(t, uint64(2), callTx.Fee)
+	signBytes, err = tx.FeeSignBytes(Envelope_RLP)
+	require.NoError(t, err)
+	// And only the value transferred is signed as the amount
+	expected, err = RLPEncode(0, callTx.GasPrice, callTx.GasLimit, toAddress.Bytes(),
+		balance.NativeToWei(1).Bytes(), callTx.Data.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, expected, signBytes)
+}
+
 func TestNameTxSignable(t *testing.T) {
 	nameTx := &payload.NameTx{
 		Input: &payload.TxInput{
@@ -208,5 +247,75 @@ func testTxSignVerify(t *testing.T, tx payload.Payload) {
 	}
 	txEnv := Enclose(chainID, tx)
 	require.NoError(t, txEnv.Sign(signers...), "Error signing tx: %s", debug.Stack())
-	require.NoError(t, txEnv.Verify(chainID), "Error verifying tx: %s", debug.Stack())
+	require.NoError(t, txEnv.Verify(chainID, nil), "Error verifying tx: %s", debug.Stack())
+}
+
+func TestMultiSig(t *testing.T) {
+	members := []*acm.PrivateAccount{makePrivateAccount("member1"), makePrivateAccount("member2"),
+		makePrivateAccount("member3")}
+	multiSig, err := crypto.NewMultiSig(2, members[0].GetPublicKey(), members[1].GetPublicKey(),
+		members[2].GetPublicKey())
+	require.NoError(t, err)
+
+	tx := &payload.SendTx{
+		Inputs:  []*payload.TxInput{{Address: multiSig.Address(), Amount: 12345, Sequence: 1}},
+		Outputs: []*payload.TxOutput{{Address: makePrivateAccount("output1").GetAddress(), Amount: 12345}},
+	}
+	txEnv := Enclose(chainID, tx)
+	require.NoError(t, txEnv.SignMultiSig(multiSig.Address(), multiSig, members[0]))
+	require.Error(t, txEnv.Verify(chainID, nil), "one member is below the threshold")
+
+	// Another member signs a separate copy
+	otherEnv := Enclose(chainID, tx)
+	require.NoError(t, otherEnv.SignMultiSig(multiSig.Address(), multiSig, members[2]))
+	require.NoError(t, txEnv.Merge(otherEnv))
+	require.NoError(t, txEnv.Verify(chainID, nil))
+	assert.Len(t, txEnv.Signatories[0].Members, 2)
+
+	bs, err := json.Marshal(txEnv)
+	require.NoError(t, err)
+	txEnvOut := new(Envelope)
+	require.NoError(t, json.Unmarshal(bs, txEnvOut))
+	require.NoError(t, txEnvOut.Verify(chainID, nil))
+
+	err = txEnv.SignMultiSig(multiSig.Address(), multiSig, makePrivateAccount("outsider"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a member")
+
+	// A member's signature does not count twice
+	txEnv = Enclose(chainID, tx)
+	require.NoError(t, txEnv.SignMultiSig(multiSig.Address(), multiSig, members[1]))
+	txEnv.Signatories[0].Members = append(txEnv.Signatories[0].Members, txEnv.Signatories[0].Members[0])
+	require.Error(t, txEnv.Verify(chainID, nil))
+}
+
+func TestVerifyRotatedKey(t *testing.T) {
+	original := makePrivateAccount("original")
+	rotated := makePrivateAccount("rotated")
+	address := original.GetAddress()
+	tx := &payload.SendTx{
+		Inputs:  []*payload.TxInput{{Address: address, Amount: 12345, Sequence: 1}},
+		Outputs: []*payload.TxOutput{{Address: makePrivateAccount("output1").GetAddress(), Amount: 12345}},
+	}
+	st := acmstate.NewMemoryState()
+	require.NoError(t, st.UpdateAccount(&acm.Account{Address: address, PublicKey: rotated.GetPublicKey()}))
+
+	txEnv := Enclose(chainID, tx)
+	require.NoError(t, txEnv.Sign(original))
+	require.NoError(t, txEnv.Verify(chainID, nil))
+	err := txEnv.Verify(chainID, st)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not the current key of the account")
+
+	// Sign for the address with the rotated key
+	signBytes, err := txEnv.Tx.SignBytes(txEnv.GetEncoding())
+	require.NoError(t, err)
+	signature, err := rotated.Sign(signBytes)
+	require.NoError(t, err)
+	publicKey := rotated.GetPublicKey()
+	txEnv.Signatories = []Signatory{{Address: &address, PublicKey: &publicKey, Signature: signature}}
+	require.NoError(t, txEnv.Verify(chainID, st))
+	err = txEnv.Verify(chainID, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "address is not derived from public key")
 }
@@ -114,12 +114,16 @@ func (*Envelope) XXX_MessageName() string {
 
 // Signatory contains signature and one or both of Address and PublicKey to identify the signer
 type Signatory struct {
-	Address              *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
-	PublicKey            *crypto.PublicKey                             `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
-	Signature            *crypto.Signature                             `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+	Address   *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+	PublicKey *crypto.PublicKey                             `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Signature *crypto.Signature                             `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	// Set instead of PublicKey and Signature when signing for a multi-signature account
+	MultiSig *crypto.MultiSig `protobuf:"bytes,5,opt,name=MultiSig,proto3" json:"MultiSig,omitempty"`
+	// Signatures of members of MultiSig, which may be collected separately
+	Members              []Signatory `protobuf:"bytes,6,rep,name=Members,proto3" json:"Members"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Signatory) Reset()         { *m = Signatory{} }
@@ -165,6 +169,20 @@ func (m *Signatory) GetSignature() *crypto.Signature {
 	return nil
 }
 
+func (m *Signatory) GetMultiSig() *crypto.MultiSig {
+	if m != nil {
+		return m.MultiSig
+	}
+	return nil
+}
+
+func (m *Signatory) GetMembers() []Signatory {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 func (*Signatory) XXX_MessageName() string {
 	return "txs.Signatory"
 }
@@ -245,37 +263,38 @@ func init() { proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
 func init() { golang_proto.RegisterFile("txs.proto", fileDescriptor_372ebcf753025bdc) }
 
 var fileDescriptor_372ebcf753025bdc = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc6, 0x73, 0x8e, 0x95, 0x97, 0x4b, 0x68, 0xcb, 0x09, 0xa1, 0x28, 0x83, 0x9d, 0x66, 0xca,
-	0x00, 0x36, 0x0a, 0xd0, 0x01, 0x26, 0x5c, 0x21, 0x55, 0xe1, 0xad, 0xba, 0x7a, 0x62, 0x40, 0xf2,
-	0xcb, 0x5f, 0x8e, 0xa5, 0xe0, 0xb3, 0xce, 0x17, 0xb0, 0xbf, 0x09, 0x23, 0x9f, 0x80, 0x1d, 0xb1,
-	0x30, 0x66, 0x64, 0x44, 0x19, 0x2c, 0x94, 0x7e, 0x0b, 0x26, 0x74, 0xee, 0xd9, 0x2d, 0x1d, 0x8a,
-	0xd8, 0xee, 0xee, 0x79, 0xee, 0xe7, 0xe7, 0xfe, 0x8f, 0x71, 0x5f, 0xe4, 0x99, 0x95, 0x72, 0x26,
-	0x18, 0x69, 0x8b, 0x3c, 0x1b, 0xdf, 0x89, 0x58, 0xc4, 0xaa, 0xbd, 0x2d, 0x57, 0x17, 0xd2, 0x78,
-	0x18, 0xf0, 0x22, 0x15, 0x6a, 0x37, 0xfd, 0x86, 0x70, 0xef, 0x79, 0xf2, 0x01, 0x56, 0x2c, 0x05,
-	0x72, 0x84, 0x07, 0x67, 0x71, 0x94, 0x78, 0x82, 0xf1, 0x18, 0xb2, 0x11, 0x9a, 0xb4, 0x67, 0x83,
-	0xf9, 0x9e, 0x25, 0xb1, 0xf5, 0x79, 0xe1, 0xe8, 0x9b, 0xd2, 0x6c, 0xd1, 0xab, 0x46, 0x72, 0x17,
-	0x6b, 0x6e, 0x3e, 0xd2, 0x26, 0x68, 0x36, 0x74, 0x3a, 0xdb, 0xd2, 0xd4, 0xdc, 0x9c, 0x6a, 0x6e,
-	0x4e, 0x8e, 0x24, 0x3b, 0x60, 0x61, 0x9c, 0x44, 0xa3, 0xf6, 0x04, 0xcd, 0xf6, 0xe6, 0xe3, 0x0a,
-	0x56, 0x7f, 0xd0, 0xaa, 0x55, 0xb7, 0x48, 0x81, 0x36, 0xde, 0xe9, 0x21, 0x1e, 0x5e, 0x55, 0x48,
-	0x0f, 0xeb, 0x8b, 0xb3, 0x37, 0xaf, 0x0f, 0x5a, 0xa4, 0x8b, 0xdb, 0xf4, 0xe5, 0xe9, 0x01, 0x7a,
-	0xa2, 0x7f, 0xfa, 0x6c, 0xb6, 0xa6, 0x5f, 0x11, 0xee, 0x37, 0xc9, 0xc8, 0x02, 0x77, 0x9f, 0x85,
-	0x21, 0x87, 0x4c, 0x46, 0x97, 0x59, 0x1e, 0x6c, 0x4b, 0xf3, 0x5e, 0x14, 0x8b, 0xe5, 0xda, 0xb7,
-	0x02, 0xf6, 0xde, 0x5e, 0x16, 0x29, 0xf0, 0x15, 0x84, 0x11, 0x70, 0xdb, 0x5f, 0x73, 0xce, 0x3e,
-	0xda, 0x6a, 0x18, 0xea, 0x1e, 0xad, 0x01, 0xc4, 0xc6, 0xfd, 0xd3, 0xb5, 0xbf, 0x8a, 0x83, 0x17,
-	0x50, 0x54, 0x2f, 0x1b, 0xcc, 0x6f, 0x5b, 0xca, 0xdc, 0x08, 0xf4, 0xd2, 0x23, 0x2f, 0x5c, 0x24,
-	0x59, 0x73, 0x18, 0xe9, 0x7f, 0x5f, 0x68, 0x04, 0x7a, 0xe9, 0x99, 0x7e, 0xd1, 0x70, 0x97, 0x42,
-	0x00, 0x71, 0x2a, 0xc8, 0x02, 0x77, 0xdc, 0x5c, 0x3e, 0xb5, 0x0a, 0x7e, 0xcb, 0x99, 0xff, 0x2e,
-	0x4d, 0xeb, 0xe6, 0xe0, 0x22, 0xcf, 0xec, 0xd4, 0x2b, 0x56, 0xcc, 0x0b, 0xad, 0x6a, 0x7c, 0x8a,
-	0x40, 0x5e, 0x49, 0xd6, 0x89, 0x97, 0x2d, 0x55, 0x21, 0x8f, 0x65, 0x5f, 0xdb, 0xd2, 0xbc, 0x7f,
-	0x33, 0xcf, 0x8f, 0x13, 0x8f, 0x17, 0xd6, 0x09, 0xe4, 0x4e, 0x21, 0x20, 0xa3, 0x0a, 0x42, 0x66,
-	0x78, 0xff, 0x98, 0x83, 0x27, 0x20, 0x3b, 0x66, 0x89, 0xe0, 0x5e, 0x20, 0xaa, 0x2a, 0x7b, 0xf4,
-	0xfa, 0x31, 0x79, 0x87, 0xf7, 0xeb, 0x75, 0x5d, 0x83, 0x5e, 0x25, 0x78, 0xa4, 0x12, 0xfc, 0x5f,
-	0x15, 0xd7, 0x61, 0xce, 0xd3, 0xcd, 0xce, 0x40, 0x3f, 0x76, 0x06, 0xfa, 0xb9, 0x33, 0xd0, 0xaf,
-	0x9d, 0x81, 0xbe, 0x9f, 0x1b, 0x68, 0x73, 0x6e, 0xa0, 0xb7, 0x87, 0xff, 0x1c, 0x95, 0xdf, 0xa9,
-	0x7e, 0xf7, 0x87, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x9a, 0xbd, 0xcb, 0x24, 0x03, 0x00,
-	0x00,
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0xcd, 0x39, 0x26, 0x7f, 0x2e, 0xa1, 0x0d, 0x27, 0x84, 0xac, 0x0c, 0x76, 0x9a, 0x29, 0x43,
+	0xb1, 0x51, 0x80, 0x0e, 0x30, 0xe1, 0x0a, 0xa9, 0x0a, 0x04, 0xaa, 0x8b, 0x27, 0x06, 0x24, 0xff,
+	0x39, 0x39, 0x96, 0x5c, 0x9f, 0x75, 0x3e, 0x83, 0xfd, 0x49, 0x60, 0xe4, 0x13, 0xf0, 0x05, 0x58,
+	0x18, 0x33, 0x32, 0xa2, 0x0c, 0x16, 0x4a, 0xbf, 0x05, 0x13, 0xb2, 0xf1, 0xb9, 0xa5, 0x43, 0x50,
+	0xb7, 0xbb, 0xdf, 0x7b, 0xf7, 0xee, 0xf9, 0x3d, 0x1f, 0xec, 0xf3, 0x2c, 0xd1, 0x63, 0x46, 0x39,
+	0x45, 0x6d, 0x9e, 0x25, 0xe3, 0xfb, 0x3e, 0xf5, 0x69, 0xb5, 0x37, 0xca, 0xd5, 0x5f, 0x68, 0x3c,
+	0x74, 0x59, 0x1e, 0xf3, 0x7a, 0x37, 0xfd, 0x06, 0x60, 0xef, 0x65, 0xf4, 0x81, 0x84, 0x34, 0x26,
+	0xe8, 0x04, 0x0e, 0x56, 0x81, 0x1f, 0xd9, 0x9c, 0xb2, 0x80, 0x24, 0x0a, 0x98, 0xb4, 0x67, 0x83,
+	0xf9, 0x81, 0x5e, 0xca, 0x8a, 0x79, 0x6e, 0xca, 0x9b, 0x42, 0x6b, 0xe1, 0xeb, 0x44, 0xf4, 0x00,
+	0x4a, 0x56, 0xa6, 0x48, 0x13, 0x30, 0x1b, 0x9a, 0x9d, 0x6d, 0xa1, 0x49, 0x56, 0x86, 0x25, 0x2b,
+	0x43, 0x27, 0xa5, 0xb6, 0x4b, 0xbd, 0x20, 0xf2, 0x95, 0xf6, 0x04, 0xcc, 0x0e, 0xe6, 0xe3, 0x4a,
+	0x4c, 0x5c, 0xa8, 0x0b, 0xd4, 0xca, 0x63, 0x82, 0x1b, 0xee, 0xf4, 0x08, 0x0e, 0xaf, 0x23, 0xa8,
+	0x07, 0xe5, 0xc5, 0xea, 0xed, 0x9b, 0x51, 0x0b, 0x75, 0x61, 0x1b, 0xbf, 0x3e, 0x1f, 0x81, 0x67,
+	0xf2, 0xe7, 0x2f, 0x5a, 0x6b, 0xfa, 0x49, 0x82, 0xfd, 0xc6, 0x19, 0x5a, 0xc0, 0xee, 0x0b, 0xcf,
+	0x63, 0x24, 0x29, 0xad, 0x97, 0x5e, 0x1e, 0x6d, 0x0b, 0xed, 0xd8, 0x0f, 0xf8, 0x3a, 0x75, 0x74,
+	0x97, 0x5e, 0x18, 0xeb, 0x3c, 0x26, 0x2c, 0x24, 0x9e, 0x4f, 0x98, 0xe1, 0xa4, 0x8c, 0xd1, 0x8f,
+	0x46, 0x1d, 0x46, 0x7d, 0x0e, 0x0b, 0x01, 0x64, 0xc0, 0xfe, 0x79, 0xea, 0x84, 0x81, 0xfb, 0x8a,
+	0xe4, 0xd5, 0x97, 0x0d, 0xe6, 0xf7, 0xf4, 0x9a, 0xdc, 0x00, 0xf8, 0x8a, 0x83, 0x0c, 0xe1, 0x24,
+	0x65, 0x44, 0x91, 0xff, 0x3d, 0xd0, 0x00, 0xf8, 0x8a, 0x83, 0x8e, 0x61, 0x6f, 0x99, 0x86, 0x3c,
+	0x58, 0x05, 0xbe, 0x72, 0xa7, 0xe2, 0x8f, 0x04, 0x5f, 0xcc, 0x71, 0xc3, 0x40, 0x3a, 0xec, 0x2e,
+	0xc9, 0x85, 0x43, 0x58, 0xa2, 0x74, 0xf6, 0xd4, 0x22, 0x48, 0xd3, 0xaf, 0x12, 0xec, 0x62, 0xe2,
+	0x92, 0x20, 0xe6, 0x68, 0x01, 0x3b, 0x56, 0x56, 0x06, 0x59, 0xc5, 0x72, 0xd7, 0x9c, 0xff, 0x2e,
+	0x34, 0x7d, 0x7f, 0x2c, 0x3c, 0x4b, 0x8c, 0xd8, 0xce, 0x43, 0x6a, 0x7b, 0x7a, 0x55, 0x4e, 0xad,
+	0x80, 0x96, 0xa5, 0xd6, 0x99, 0x9d, 0xac, 0xeb, 0xba, 0x9f, 0x96, 0xd7, 0x6e, 0x0b, 0xed, 0xe1,
+	0x7e, 0x3d, 0x27, 0x88, 0x6c, 0x96, 0xeb, 0x67, 0x24, 0x33, 0x73, 0x4e, 0x12, 0x5c, 0x8b, 0xa0,
+	0x19, 0x3c, 0x3c, 0x65, 0xc4, 0xe6, 0x24, 0x39, 0xa5, 0x11, 0x67, 0xb6, 0xcb, 0xab, 0x1f, 0xa5,
+	0x87, 0x6f, 0x8e, 0xd1, 0x7b, 0x78, 0x28, 0xd6, 0xa2, 0x64, 0xb9, 0x72, 0xf0, 0xa4, 0x76, 0x70,
+	0xbb, 0xa2, 0x6f, 0x8a, 0x99, 0xcf, 0x37, 0x3b, 0x15, 0xfc, 0xd8, 0xa9, 0xe0, 0xe7, 0x4e, 0x05,
+	0xbf, 0x76, 0x2a, 0xf8, 0x7e, 0xa9, 0x82, 0xcd, 0xa5, 0x0a, 0xde, 0x1d, 0xfd, 0x37, 0x2a, 0xa7,
+	0x53, 0x3d, 0xa6, 0xc7, 0x7f, 0x06, 0x00, 0xd8, 0xb3, 0x64, 0xdd, 0x82, 0x03, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -360,6 +379,32 @@ func (m *Signatory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MultiSig != nil {
+		{
+			size, err := m.MultiSig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Signature != nil {
 		{
 			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
@@ -515,6 +560,16 @@ func (m *Signatory) Size() (n int) {
 		l = m.Signature.Size()
 		n += 1 + l + sovTxs(uint64(l))
 	}
+	if m.MultiSig != nil {
+		l = m.MultiSig.Size()
+		n += 1 + l + sovTxs(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTxs(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -824,6 +879,76 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiSig == nil {
+				m.MultiSig = &crypto.MultiSig{}
+			}
+			if err := m.MultiSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Signatory{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxs(dAtA[iNdEx:])